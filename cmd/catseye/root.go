@@ -5,6 +5,8 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/elastic/elasticat/internal/config"
@@ -19,6 +21,9 @@ var (
 	esIndex         string
 	pingTimeoutFlag time.Duration
 	profileFlag     string // Profile name override
+	viewFlag        string // Saved view to open
+	freshFlag       bool   // Don't restore the last session
+	themeFlag       string // Colour theme
 )
 
 var rootCmd = &cobra.Command{
@@ -28,6 +33,11 @@ var rootCmd = &cobra.Command{
 
 Signal can be: logs (default), metrics, traces, or chat.
 Use 'chat' to start directly in AI chat mode powered by Elastic Agent Builder.
+Use --view to open a saved view; its signal is used unless one is given.
+
+CatsEye restores the signal, filters, columns, time display, sort order and
+traces level it was left in with the same profile, unless --fresh or --view
+is given or another signal is asked for.
 
 For CLI commands, use 'elasticat'.`,
 	Args: cobra.MaximumNArgs(1),
@@ -58,7 +68,31 @@ For CLI commands, use 'elasticat'.`,
 				return fmt.Errorf("unknown signal %q (expected logs, metrics, traces, chat)", args[0])
 			}
 		}
-		return runTUI(cmd.Context(), sig)
+
+		var view *config.View
+		if viewFlag != "" {
+			v, err := config.FindView(viewFlag)
+			if err != nil {
+				return err
+			}
+			if len(args) > 0 && v.Signal != "" && v.Signal != args[0] {
+				return fmt.Errorf("view %q is a %s view, not %s", v.Name, v.Signal, args[0])
+			}
+			view = &v
+		}
+
+		var session *config.Session
+		if cfg, ok := config.FromContext(cmd.Context()); ok && !freshFlag && view == nil {
+			// A broken sessions file only loses the last session
+			s, err := config.LoadSession(cfg.ProfileName)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+			if s != nil && (len(args) == 0 || s.Signal == args[0]) {
+				session = s
+			}
+		}
+		return runTUI(cmd.Context(), sig, view, session)
 	},
 }
 
@@ -69,4 +103,7 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&esIndex, "index", "i", config.DefaultIndex, "Elasticsearch index/data stream pattern (env: ELASTICAT_ES_INDEX)")
 	pingTimeoutFlag = config.DefaultPingTimeout
 	rootCmd.PersistentFlags().DurationVar(&pingTimeoutFlag, "ping-timeout", config.DefaultPingTimeout, "Elasticsearch ping timeout (env: ELASTICAT_ES_PING_TIMEOUT)")
+	rootCmd.Flags().StringVar(&viewFlag, "view", "", "Open a saved view by name")
+	rootCmd.Flags().BoolVar(&freshFlag, "fresh", false, "Start with default filters instead of restoring the last session")
+	rootCmd.Flags().StringVar(&themeFlag, "theme", config.DefaultTheme, "Colour theme: "+strings.Join(tui.ThemeNames(), ", ")+" or a user theme (env: ELASTICAT_TUI_THEME)")
 }
@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	osSignal "os/signal"
@@ -20,7 +21,7 @@ import (
 	"github.com/elastic/elasticat/internal/tui"
 )
 
-func runTUI(parentCtx context.Context, sig tui.SignalType) (returnErr error) {
+func runTUI(parentCtx context.Context, sig tui.SignalType, view *config.View, session *config.Session) (returnErr error) {
 	// Top-level panic handler - logs to file for debugging
 	defer func() {
 		if r := recover(); r != nil {
@@ -35,6 +36,24 @@ func runTUI(parentCtx context.Context, sig tui.SignalType) (returnErr error) {
 		return fmt.Errorf("configuration not loaded")
 	}
 
+	keymap, err := tui.NewKeymap(cfg.TUI.Keymap)
+	if err != nil {
+		return err
+	}
+
+	// Detecting the background queries the terminal, so do it before the TUI takes over
+	theme, err := tui.LoadTheme(cfg.TUI.Theme)
+	if err != nil {
+		return err
+	}
+	tui.ApplyTheme(theme)
+
+	// Settings saved from within catseye; a broken file only loses them
+	prefs, err := config.LoadPrefs()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
 	notifyCtx, stop := osSignal.NotifyContext(parentCtx, os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
@@ -58,10 +77,23 @@ func runTUI(parentCtx context.Context, sig tui.SignalType) (returnErr error) {
 		ESUsername:  cfg.ES.Username,
 		ESPassword:  cfg.ES.Password,
 		ProfileName: cfg.ProfileName,
+		View:        view,
+		Keymap:      keymap,
+		Prefs:       prefs,
+		Session:     session,
 	})
 	p := tea.NewProgram(model, tea.WithAltScreen(), tea.WithContext(notifyCtx))
 
-	if _, err := p.Run(); err != nil {
+	final, err := p.Run()
+	// Keep where we were for the next run, unless the TUI crashed
+	if m, ok := final.(tui.Model); ok && !errors.Is(err, tea.ErrProgramPanic) {
+		s := m.Session()
+		s.Profile = cfg.ProfileName
+		if err := config.SaveSession(s); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: session not saved: %v\n", err)
+		}
+	}
+	if err != nil {
 		// Check if this was a panic caught by bubbletea
 		errStr := err.Error()
 		if strings.Contains(errStr, "panic") || strings.Contains(errStr, "killed") {
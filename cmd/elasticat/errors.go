@@ -0,0 +1,192 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/elastic/elasticat/internal/es"
+	"github.com/elastic/elasticat/internal/es/shared"
+	"github.com/elastic/elasticat/internal/index"
+	"github.com/spf13/cobra"
+)
+
+var (
+	errorsLookback   string
+	errorsBy         string
+	errorsLimit      int
+	errorsJSONOutput bool
+)
+
+// errorsTrendWidth is the number of time buckets in the TREND column.
+const errorsTrendWidth = 20
+
+var errorsCmd = &cobra.Command{
+	Use:   "errors [service]",
+	Short: "Group exceptions by type and message",
+	Long: `Group the OTel exceptions recorded in logs (exception.type,
+exception.message and exception.stacktrace) and list the most frequent, with
+their count, affected services, first and last occurrence and a trend line.
+
+Exceptions of one type are told apart by their message with numbers, hex
+values and UUIDs masked, or with --by frame by the top frame of their stack
+trace.
+
+For an interactive view with drill-down to occurrences and their traces,
+press E in 'catseye logs'.
+
+Examples:
+  elasticat errors
+  elasticat errors checkout --lookback now-1h
+  elasticat errors --by frame --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runErrors,
+}
+
+func init() {
+	errorsCmd.Flags().StringVar(&errorsLookback, "lookback", "now-24h", "Time range (e.g., now-1h, now-24h)")
+	errorsCmd.Flags().StringVar(&errorsBy, "by", "message", "Group exceptions of a type by: message or frame")
+	errorsCmd.Flags().IntVar(&errorsLimit, "limit", es.DefaultExceptionGroups, "Maximum number of groups")
+	errorsCmd.Flags().BoolVar(&errorsJSONOutput, "json", false, "Output groups as NDJSON")
+	rootCmd.AddCommand(errorsCmd)
+}
+
+type errorGroupJSON struct {
+	Type           string    `json:"type"`
+	Key            string    `json:"key"`
+	By             string    `json:"by"`
+	Count          int64     `json:"count"`
+	Services       []string  `json:"services"`
+	FirstSeen      time.Time `json:"first_seen"`
+	LastSeen       time.Time `json:"last_seen"`
+	BucketStart    time.Time `json:"bucket_start"`
+	BucketInterval string    `json:"bucket_interval"`
+	Buckets        []int64   `json:"buckets"`
+}
+
+func runErrors(cmd *cobra.Command, args []string) error {
+	appCfg, ok := config.FromContext(cmd.Context())
+	if !ok {
+		return fmt.Errorf("configuration not loaded")
+	}
+
+	var by es.ExceptionGroupBy
+	switch errorsBy {
+	case "message":
+		by = es.ExceptionsByMessage
+	case "frame":
+		by = es.ExceptionsByFrame
+	default:
+		return fmt.Errorf("invalid --by %q (expected message or frame)", errorsBy)
+	}
+
+	client, err := es.NewFromConfig(appCfg.ES.URL, effectiveIndex(appCfg, index.Logs), appCfg.ES.APIKey, appCfg.ES.Username, appCfg.ES.Password)
+	if err != nil {
+		return fmt.Errorf("failed to create ES client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), appCfg.ES.Timeout)
+	defer cancel()
+
+	service := ""
+	if len(args) > 0 {
+		service = args[0]
+	}
+	to := time.Now()
+	result, _, err := client.Exceptions(ctx, "", es.SearchOptions{
+		Service: service,
+		From:    to.Add(-shared.LookbackToDuration(errorsLookback)),
+		To:      to,
+		Size:    errorsLimit,
+	}, by, errorsTrendWidth)
+	if err != nil {
+		return fmt.Errorf("errors query failed: %w", err)
+	}
+
+	if errorsJSONOutput {
+		for _, g := range result.Groups {
+			data, err := json.Marshal(errorGroupJSON{
+				Type:           g.Type,
+				Key:            g.Key,
+				By:             g.By.String(),
+				Count:          g.Count,
+				Services:       g.Services,
+				FirstSeen:      g.FirstSeen,
+				LastSeen:       g.LastSeen,
+				BucketStart:    result.Start,
+				BucketInterval: result.Interval.String(),
+				Buckets:        g.Buckets,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to marshal error group: %w", err)
+			}
+			fmt.Println(string(data))
+		}
+		return nil
+	}
+
+	renderErrorGroupTable(result.Groups, detectTerminalWidth())
+	return nil
+}
+
+func renderErrorGroupTable(groups []es.ExceptionGroup, totalWidth int) {
+	const (
+		countWidth    = 8
+		servicesWidth = 20
+		seenWidth     = 19
+	)
+	exceptionWidth := totalWidth - countWidth - errorsTrendWidth - servicesWidth - seenWidth*2 - 5
+	if exceptionWidth < 20 {
+		exceptionWidth = 20
+	}
+
+	fmt.Println(strings.Join([]string{
+		padOrTruncate("COUNT", countWidth),
+		padOrTruncate("TREND", errorsTrendWidth),
+		padOrTruncate("SERVICES", servicesWidth),
+		padOrTruncate("FIRST SEEN", seenWidth),
+		padOrTruncate("LAST SEEN", seenWidth),
+		"EXCEPTION",
+	}, " "))
+
+	for _, g := range groups {
+		fmt.Println(strings.Join([]string{
+			padOrTruncate(fmt.Sprintf("%d", g.Count), countWidth),
+			trendLine(g.Buckets, errorsTrendWidth),
+			padOrTruncate(strings.Join(g.Services, ","), servicesWidth),
+			padOrTruncate(formatPatternTime(g.FirstSeen), seenWidth),
+			padOrTruncate(formatPatternTime(g.LastSeen), seenWidth),
+			padOrTruncate(strings.Join(strings.Fields(g.String()), " "), exceptionWidth),
+		}, " "))
+	}
+}
+
+// trendLine draws counts as block characters scaled from zero, right-aligned
+// to width.
+func trendLine(counts []int64, width int) string {
+	levels := []rune("▁▂▃▄▅▆▇█")
+	if len(counts) > width {
+		counts = counts[len(counts)-width:]
+	}
+	var maxCount int64
+	for _, c := range counts {
+		maxCount = max(maxCount, c)
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Repeat(" ", width-len(counts)))
+	for _, c := range counts {
+		if c <= 0 {
+			b.WriteByte(' ')
+			continue
+		}
+		b.WriteRune(levels[c*int64(len(levels)-1)/maxCount])
+	}
+	return b.String()
+}
@@ -5,18 +5,31 @@ package main
 
 import "github.com/spf13/cobra"
 
+var (
+	patternsFlag bool
+	lookbackFlag string
+)
+
 var logsCmd = &cobra.Command{
 	Use:   "logs",
 	Short: "Query and display logs (CLI)",
 	Long: `Query logs from Elasticsearch and display in the terminal.
 
+Use --patterns to group messages into their most frequent patterns
+(ES|QL CATEGORIZE) instead of listing individual documents.
+
 For the interactive TUI, use 'catseye logs'.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if patternsFlag {
+			return runLogPatterns(cmd, args)
+		}
 		return runSignalCommand(cmd, signalKindLogs, args)
 	},
 }
 
 func init() {
 	registerSignalFlags(logsCmd)
+	logsCmd.Flags().BoolVar(&patternsFlag, "patterns", false, "Show the most frequent message patterns instead of documents")
+	logsCmd.Flags().StringVar(&lookbackFlag, "lookback", "now-24h", "Time range for --patterns (e.g., now-1h, now-24h)")
 	rootCmd.AddCommand(logsCmd)
 }
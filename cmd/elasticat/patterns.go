@@ -0,0 +1,123 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/elastic/elasticat/internal/es"
+	"github.com/elastic/elasticat/internal/es/patterns"
+	"github.com/elastic/elasticat/internal/index"
+	"github.com/spf13/cobra"
+)
+
+type patternJSON struct {
+	Pattern   string               `json:"pattern"`
+	Template  string               `json:"template"`
+	Count     int64                `json:"count"`
+	FirstSeen time.Time            `json:"first_seen"`
+	LastSeen  time.Time            `json:"last_seen"`
+	Levels    patterns.LevelCounts `json:"levels"`
+}
+
+func runLogPatterns(cmd *cobra.Command, args []string) error {
+	appCfg, ok := config.FromContext(cmd.Context())
+	if !ok {
+		return fmt.Errorf("configuration not loaded")
+	}
+
+	client, err := es.NewFromConfig(appCfg.ES.URL, effectiveIndex(appCfg, index.Logs), appCfg.ES.APIKey, appCfg.ES.Username, appCfg.ES.Password)
+	if err != nil {
+		return fmt.Errorf("failed to create ES client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), appCfg.ES.Timeout)
+	defer cancel()
+
+	preArgs, _ := fieldsForRun(cmd, args)
+	serviceOverride := ""
+	if len(preArgs) > 0 {
+		serviceOverride = preArgs[0]
+	}
+
+	limit := patterns.DefaultLimit
+	if cmd.Flags().Changed("limit") && limitFlag > 0 {
+		limit = limitFlag
+	}
+
+	result, err := client.GetLogPatterns(ctx, patterns.Options{
+		Lookback: lookbackFlag,
+		Service:  serviceForRun(serviceOverride),
+		Level:    levelFlag,
+		Limit:    limit,
+	})
+	if err != nil {
+		return fmt.Errorf("pattern query failed: %w", err)
+	}
+
+	if signalJSONOutput {
+		for _, p := range result.Patterns {
+			data, err := json.Marshal(patternJSON{
+				Pattern:   p.Pattern,
+				Template:  p.Template(),
+				Count:     p.Count,
+				FirstSeen: p.FirstSeen,
+				LastSeen:  p.LastSeen,
+				Levels:    p.Levels,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to marshal pattern: %w", err)
+			}
+			fmt.Println(string(data))
+		}
+		return nil
+	}
+
+	renderPatternTable(result.Patterns, detectTerminalWidth())
+	return nil
+}
+
+func renderPatternTable(items []patterns.PatternAgg, totalWidth int) {
+	const (
+		countWidth = 8
+		levelWidth = 6
+		seenWidth  = 19
+	)
+	patternWidth := totalWidth - countWidth - levelWidth*2 - seenWidth*2 - 5
+	if patternWidth < 20 {
+		patternWidth = 20
+	}
+
+	fmt.Println(strings.Join([]string{
+		padOrTruncate("COUNT", countWidth),
+		padOrTruncate("ERR", levelWidth),
+		padOrTruncate("WARN", levelWidth),
+		padOrTruncate("FIRST SEEN", seenWidth),
+		padOrTruncate("LAST SEEN", seenWidth),
+		"PATTERN",
+	}, " "))
+
+	for _, p := range items {
+		fmt.Println(strings.Join([]string{
+			padOrTruncate(fmt.Sprintf("%d", p.Count), countWidth),
+			padOrTruncate(fmt.Sprintf("%d", p.Levels.Error), levelWidth),
+			padOrTruncate(fmt.Sprintf("%d", p.Levels.Warn), levelWidth),
+			padOrTruncate(formatPatternTime(p.FirstSeen), seenWidth),
+			padOrTruncate(formatPatternTime(p.LastSeen), seenWidth),
+			padOrTruncate(p.Template(), patternWidth),
+		}, " "))
+	}
+}
+
+func formatPatternTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04:05")
+}
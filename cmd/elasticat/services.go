@@ -0,0 +1,125 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/elastic/elasticat/internal/es"
+	"github.com/elastic/elasticat/internal/es/traces"
+	"github.com/elastic/elasticat/internal/index"
+	"github.com/spf13/cobra"
+)
+
+var (
+	servicesGraph      bool
+	servicesFormat     string
+	servicesLookback   string
+	servicesJSONOutput bool
+)
+
+var servicesCmd = &cobra.Command{
+	Use:   "services",
+	Short: "List services, or print their dependency graph",
+	Long: `List services seen in logs, traces and metrics with their document counts.
+
+With --graph, derive the service dependency map from trace data instead and
+print it as Graphviz DOT or Mermaid. Edges carry call counts, error rate and
+average latency. Calls to uninstrumented resources (databases, caches,
+external APIs) are drawn as cylinders.
+
+Examples:
+  elasticat services
+  elasticat services --graph | dot -Tsvg > services.svg
+  elasticat services --graph --format mermaid --lookback now-1h`,
+	RunE: runServices,
+}
+
+func init() {
+	servicesCmd.Flags().BoolVar(&servicesGraph, "graph", false, "Print the service dependency graph derived from traces")
+	servicesCmd.Flags().StringVar(&servicesFormat, "format", "dot", "Graph output format: dot or mermaid")
+	servicesCmd.Flags().StringVar(&servicesLookback, "lookback", "now-24h", "Time range (e.g., now-1h, now-24h)")
+	servicesCmd.Flags().BoolVar(&servicesJSONOutput, "json", false, "Output services as NDJSON")
+	rootCmd.AddCommand(servicesCmd)
+}
+
+type serviceJSON struct {
+	Name    string `json:"name"`
+	Logs    int64  `json:"logs"`
+	Traces  int64  `json:"traces"`
+	Metrics int64  `json:"metrics"`
+}
+
+func runServices(cmd *cobra.Command, args []string) error {
+	appCfg, ok := config.FromContext(cmd.Context())
+	if !ok {
+		return fmt.Errorf("configuration not loaded")
+	}
+
+	if servicesGraph && servicesFormat != "dot" && servicesFormat != "mermaid" {
+		return fmt.Errorf("invalid --format %q (expected dot or mermaid)", servicesFormat)
+	}
+
+	client, err := es.NewFromConfig(appCfg.ES.URL, effectiveIndex(appCfg, index.Traces), appCfg.ES.APIKey, appCfg.ES.Username, appCfg.ES.Password)
+	if err != nil {
+		return fmt.Errorf("failed to create ES client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), appCfg.ES.Timeout)
+	defer cancel()
+
+	if servicesGraph {
+		result, err := client.GetServiceMap(ctx, traces.ServiceMapOptions{Lookback: servicesLookback})
+		if err != nil {
+			return fmt.Errorf("service map query failed: %w", err)
+		}
+		if servicesFormat == "mermaid" {
+			fmt.Print(traces.FormatMermaid(result))
+		} else {
+			fmt.Print(traces.FormatDOT(result))
+		}
+		return nil
+	}
+
+	services, err := client.GetServices(ctx, servicesLookback)
+	if err != nil {
+		return fmt.Errorf("services query failed: %w", err)
+	}
+
+	if servicesJSONOutput {
+		for _, s := range services {
+			data, err := json.Marshal(serviceJSON{Name: s.Name, Logs: s.LogCount, Traces: s.TraceCount, Metrics: s.MetricCount})
+			if err != nil {
+				return fmt.Errorf("failed to marshal service: %w", err)
+			}
+			fmt.Println(string(data))
+		}
+		return nil
+	}
+
+	const countWidth = 10
+	nameWidth := detectTerminalWidth() - countWidth*3 - 3
+	if nameWidth < 20 {
+		nameWidth = 20
+	}
+	fmt.Println(strings.Join([]string{
+		padOrTruncate("SERVICE", nameWidth),
+		padOrTruncate("LOGS", countWidth),
+		padOrTruncate("TRACES", countWidth),
+		padOrTruncate("METRICS", countWidth),
+	}, " "))
+	for _, s := range services {
+		fmt.Println(strings.Join([]string{
+			padOrTruncate(s.Name, nameWidth),
+			padOrTruncate(fmt.Sprintf("%d", s.LogCount), countWidth),
+			padOrTruncate(fmt.Sprintf("%d", s.TraceCount), countWidth),
+			padOrTruncate(fmt.Sprintf("%d", s.MetricCount), countWidth),
+		}, " "))
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -29,6 +30,7 @@ var (
 	refreshMs        int
 	limitFlag        int
 	signalJSONOutput bool
+	viewFlag         string
 )
 
 // Shared flag registration with a customizable default follow value.
@@ -37,6 +39,7 @@ func registerSignalFlagsWithDefaults(cmd *cobra.Command, followDefault bool) {
 	cmd.Flags().IntVar(&refreshMs, "refresh", defaultRefreshMs, "Follow refresh interval in milliseconds")
 	cmd.Flags().IntVar(&limitFlag, "limit", defaultLimit, "Documents fetched per request")
 	cmd.Flags().BoolVar(&signalJSONOutput, "json", false, "Output raw JSON documents (NDJSON)")
+	cmd.Flags().StringVar(&viewFlag, "view", "", "Apply the filters, lookback and columns of a saved view")
 }
 
 func registerSignalFlags(cmd *cobra.Command) {
@@ -56,6 +59,7 @@ type signalRunConfig struct {
 	serviceOverride string
 	fieldsOverride  []string
 	defaultFollow   bool
+	view            *config.View // Saved view applied with --view
 }
 
 func (k signalKind) signalType() fields.SignalType {
@@ -107,6 +111,13 @@ func runSignalCommandWithConfig(cmd *cobra.Command, cfg signalRunConfig, args []
 	if len(preArgs) > 0 {
 		cfg.serviceOverride = preArgs[0]
 	}
+	if viewFlag != "" {
+		view, err := viewForRun(cfg.kind, viewFlag)
+		if err != nil {
+			return err
+		}
+		cfg.view = &view
+	}
 	effective := effectiveIndex(appCfg, cfg.kind.defaultIndex())
 	useFollow := followFlag
 	if !cmd.Flags().Changed("follow") {
@@ -126,6 +137,22 @@ func effectiveIndex(appCfg config.Config, defaultIndex string) string {
 	return defaultIndex
 }
 
+// viewForRun loads a saved view, checking it can be run by a command of kind.
+func viewForRun(kind signalKind, name string) (config.View, error) {
+	view, err := config.FindView(name)
+	if err != nil {
+		return config.View{}, err
+	}
+	if view.ESQL != "" {
+		return config.View{}, fmt.Errorf("view %q is an ES|QL query; open it with 'catseye --view %s'", view.Name, view.Name)
+	}
+	signal := strings.ToLower(kind.signalType().String())
+	if view.Signal != "" && view.Signal != signal {
+		return config.View{}, fmt.Errorf("view %q is a %s view; run it with 'elasticat %s --view %s'", view.Name, view.Signal, view.Signal, view.Name)
+	}
+	return view, nil
+}
+
 func serviceForRun(serviceOverride string) string {
 	if serviceFlag != "" {
 		return serviceFlag
@@ -156,13 +183,13 @@ func runSignalOnce(appCfg config.Config, cfg signalRunConfig, indexPattern strin
 	defer cancel()
 
 	service := serviceForRun(cfg.serviceOverride)
-	opts := baseTailOptions(cfg.kind, service)
-	entries, err := fetchTailEntries(ctx, client, opts)
+	opts := baseTailOptions(cfg, service)
+	entries, err := fetchEntries(ctx, client, cfg, opts)
 	if err != nil {
 		return err
 	}
 
-	renderer := newTableRenderer(cfg.kind, cfg.fieldsOverride)
+	renderer := cfg.newRenderer()
 	return renderEntries(entries, renderer, true)
 }
 
@@ -172,16 +199,16 @@ func runSignalFollow(appCfg config.Config, cfg signalRunConfig, indexPattern str
 		return fmt.Errorf("failed to create ES client: %w", err)
 	}
 
-	renderer := newTableRenderer(cfg.kind, cfg.fieldsOverride)
+	renderer := cfg.newRenderer()
 
 	notifyCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
 	// Initial load (non-follow sort order for latest docs)
 	service := serviceForRun(cfg.serviceOverride)
-	opts := baseTailOptions(cfg.kind, service)
+	opts := baseTailOptions(cfg, service)
 	ctx, cancel := context.WithTimeout(notifyCtx, appCfg.ES.Timeout)
-	initial, err := fetchTailEntries(ctx, client, opts)
+	initial, err := fetchEntries(ctx, client, cfg, opts)
 	cancel()
 	if err != nil {
 		return err
@@ -191,10 +218,8 @@ func runSignalFollow(appCfg config.Config, cfg signalRunConfig, indexPattern str
 		return err
 	}
 
-	lastTimestamp := maxTimestamp(initial, time.Time{})
-	if lastTimestamp.IsZero() {
-		lastTimestamp = time.Now()
-	}
+	// Later polls ask for what is newer than the documents shown so far
+	cursor := es.NewTailCursor(initial, time.Now())
 
 	refresh := refreshMs
 	if refresh <= 0 {
@@ -213,19 +238,21 @@ func runSignalFollow(appCfg config.Config, cfg signalRunConfig, indexPattern str
 			return nil
 		case <-ticker.C:
 			ctx, cancel := context.WithTimeout(notifyCtx, appCfg.ES.Timeout)
-			opts := baseTailOptions(cfg.kind, service)
+			opts := baseTailOptions(cfg, service)
 			opts.SortAsc = true
-			if !lastTimestamp.IsZero() {
-				opts.Since = lastTimestamp
-			}
-			entries, err := fetchTailEntries(ctx, client, opts)
+			opts.Since = cursor.Since()
+			opts.Lookback = "" // A lookback would take precedence over Since
+			// Documents at Since already shown come back too; room for them
+			// keeps a page of new ones, however many share the timestamp
+			opts.Size += cursor.Overlap()
+			entries, err := fetchEntries(ctx, client, cfg, opts)
 			cancel()
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "refresh error: %v\n", err)
 				continue
 			}
 
-			newEntries := filterNewEntries(entries, lastTimestamp)
+			newEntries := cursor.Advance(entries)
 			if len(newEntries) == 0 {
 				continue
 			}
@@ -233,24 +260,68 @@ func runSignalFollow(appCfg config.Config, cfg signalRunConfig, indexPattern str
 			if err := renderEntries(newEntries, renderer, false); err != nil {
 				return err
 			}
-
-			lastTimestamp = maxTimestamp(newEntries, lastTimestamp)
 		}
 	}
 }
 
-func baseTailOptions(kind signalKind, service string) es.TailOptions {
+func baseTailOptions(cfg signalRunConfig, service string) es.TailOptions {
 	limit := limitFlag
 	if limit <= 0 {
 		limit = defaultLimit
 	}
-	return es.TailOptions{
+	opts := es.TailOptions{
 		Size:           limit,
 		Service:        service,
 		Level:          levelFlag,
-		ProcessorEvent: kind.processorEvent(),
+		ProcessorEvent: cfg.kind.processorEvent(),
 		SortAsc:        false,
 	}
+
+	// A saved view fills in what the flags leave unset
+	if v := cfg.view; v != nil {
+		if opts.Service == "" {
+			opts.Service = v.Service
+			opts.NegateService = v.NegateService
+		}
+		if opts.Level == "" {
+			opts.Level = v.Level
+		}
+		opts.Resource = v.Resource
+		opts.NegateResource = v.NegateResource
+		opts.Lookback = v.LookbackRange()
+		opts.FieldFilters = v.Filters
+	}
+	return opts
+}
+
+// fetchEntries tails documents, searching instead when the view has a query.
+func fetchEntries(ctx context.Context, client *es.Client, cfg signalRunConfig, opts es.TailOptions) ([]es.LogEntry, error) {
+	if cfg.view == nil || cfg.view.Query == "" {
+		return fetchTailEntries(ctx, client, opts)
+	}
+
+	display := cfg.view.DisplayFields()
+	if display == nil {
+		display = fields.DefaultFields(cfg.kind.signalType())
+	}
+	result, err := client.Search(ctx, cfg.view.Query, es.SearchOptions{
+		Size:           opts.Size,
+		Service:        opts.Service,
+		NegateService:  opts.NegateService,
+		Resource:       opts.Resource,
+		NegateResource: opts.NegateResource,
+		Level:          opts.Level,
+		From:           opts.Since,
+		SortAsc:        opts.SortAsc,
+		SearchFields:   fields.CollectSearchFields(display),
+		Lookback:       opts.Lookback,
+		ProcessorEvent: opts.ProcessorEvent,
+		FieldFilters:   opts.FieldFilters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.Logs, nil
 }
 
 func fetchTailEntries(ctx context.Context, client *es.Client, opts es.TailOptions) ([]es.LogEntry, error) {
@@ -261,6 +332,14 @@ func fetchTailEntries(ctx context.Context, client *es.Client, opts es.TailOption
 	return result.Logs, nil
 }
 
+// newRenderer lays out the columns given after --, else the view's, else the defaults.
+func (cfg signalRunConfig) newRenderer() *tableRenderer {
+	if len(cfg.fieldsOverride) == 0 && cfg.view != nil && len(cfg.view.Fields) > 0 {
+		return newTableRendererWithColumns(columnsForView(*cfg.view))
+	}
+	return newTableRenderer(cfg.kind, cfg.fieldsOverride)
+}
+
 func renderEntries(entries []es.LogEntry, renderer *tableRenderer, showHeader bool) error {
 	if signalJSONOutput {
 		printEntriesJSON(entries)
@@ -287,22 +366,3 @@ func printEntriesJSON(entries []es.LogEntry) {
 		fmt.Println(string(data))
 	}
 }
-
-func filterNewEntries(entries []es.LogEntry, after time.Time) []es.LogEntry {
-	var result []es.LogEntry
-	for _, entry := range entries {
-		if entry.Timestamp.After(after) {
-			result = append(result, entry)
-		}
-	}
-	return result
-}
-
-func maxTimestamp(entries []es.LogEntry, current time.Time) time.Time {
-	for _, entry := range entries {
-		if entry.Timestamp.After(current) {
-			current = entry.Timestamp
-		}
-	}
-	return current
-}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/elastic/elasticat/internal/config"
 	"github.com/elastic/elasticat/internal/es"
 	"github.com/elastic/elasticat/internal/fields"
 	"golang.org/x/term"
@@ -27,8 +28,11 @@ type tableRenderer struct {
 }
 
 func newTableRenderer(kind signalKind, fieldsOverride []string) *tableRenderer {
+	return newTableRendererWithColumns(columnsForKind(kind, fieldsOverride))
+}
+
+func newTableRendererWithColumns(cols []displayColumn) *tableRenderer {
 	totalWidth := detectTerminalWidth()
-	cols := columnsForKind(kind, fieldsOverride)
 	return &tableRenderer{
 		columns: cols,
 		widths:  computeColumnWidths(cols, totalWidth),
@@ -67,6 +71,20 @@ func columnsForKind(kind signalKind, fieldsOverride []string) []displayColumn {
 	return cols
 }
 
+// columnsForView builds columns from the fields of a saved view.
+func columnsForView(view config.View) []displayColumn {
+	display := view.DisplayFields()
+	cols := make([]displayColumn, 0, len(display))
+	for _, field := range display {
+		cols = append(cols, displayColumn{
+			Field: field.Name,
+			Label: field.Label,
+			Width: field.Width,
+		})
+	}
+	return cols
+}
+
 func computeColumnWidths(columns []displayColumn, totalWidth int) []int {
 	if totalWidth <= 0 {
 		totalWidth = 80
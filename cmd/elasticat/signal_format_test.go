@@ -5,6 +5,8 @@ package main
 
 import (
 	"testing"
+
+	"github.com/elastic/elasticat/internal/config"
 )
 
 func TestColumnsForKind(t *testing.T) {
@@ -432,3 +434,44 @@ func TestSignalKindProcessorEvent(t *testing.T) {
 		}
 	})
 }
+
+func TestViewForRun(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	views := []config.View{
+		{Name: "checkout", Signal: "logs", Service: "checkout", Lookback: "1h",
+			Fields: []config.ViewField{{Name: "@timestamp", Label: "TIME", Width: 8}, {Name: "body.text"}}},
+		{Name: "slow", Signal: "traces"},
+		{Name: "raw", ESQL: "FROM logs-*"},
+	}
+	for _, v := range views {
+		if err := config.SaveView(v); err != nil {
+			t.Fatalf("SaveView error: %v", err)
+		}
+	}
+
+	view, err := viewForRun(signalKindLogs, "checkout")
+	if err != nil {
+		t.Fatalf("viewForRun error: %v", err)
+	}
+	opts := baseTailOptions(signalRunConfig{kind: signalKindLogs, view: &view}, "")
+	if opts.Service != "checkout" || opts.Lookback != "now-1h" {
+		t.Errorf("view not applied to tail options: %+v", opts)
+	}
+	// An explicit service wins over the view's
+	if opts := baseTailOptions(signalRunConfig{kind: signalKindLogs, view: &view}, "cart"); opts.Service != "cart" {
+		t.Errorf("expected the service override, got %q", opts.Service)
+	}
+
+	cols := columnsForView(view)
+	if len(cols) != 2 || cols[0].Label != "TIME" || cols[0].Width != 8 || cols[1].Label != "BODY.TEXT" {
+		t.Errorf("unexpected columns: %+v", cols)
+	}
+
+	if _, err := viewForRun(signalKindLogs, "slow"); err == nil {
+		t.Error("expected an error for a traces view run as logs")
+	}
+	if _, err := viewForRun(signalKindLogs, "raw"); err == nil {
+		t.Error("expected an error for an ES|QL view")
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/elastic/elasticat/internal/es"
+	"github.com/elastic/elasticat/internal/index"
+	"github.com/spf13/cobra"
+)
+
+// maxTraceSpans caps the number of documents fetched for a single trace
+const maxTraceSpans = 1000
+
+var traceJSONOutput bool
+
+var traceCmd = &cobra.Command{
+	Use:   "trace <trace-id>",
+	Short: "Show a trace as a span waterfall",
+	Long: `Fetch every span of a trace and print it as a waterfall: spans are nested
+under their parent, with bars showing when each span ran relative to the root.
+Failed spans are marked with '!'.
+
+For the interactive view, open the trace in 'catseye traces' and press S.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTrace,
+}
+
+func init() {
+	traceCmd.Flags().BoolVar(&traceJSONOutput, "json", false, "Output spans in tree order as NDJSON")
+	rootCmd.AddCommand(traceCmd)
+}
+
+type traceSpanJSON struct {
+	SpanID       string  `json:"span_id"`
+	ParentSpanID string  `json:"parent_span_id,omitempty"`
+	Name         string  `json:"name"`
+	Service      string  `json:"service,omitempty"`
+	Depth        int     `json:"depth"`
+	OffsetMs     float64 `json:"offset_ms"`
+	DurationMs   float64 `json:"duration_ms"`
+	Error        bool    `json:"error"`
+}
+
+func runTrace(cmd *cobra.Command, args []string) error {
+	appCfg, ok := config.FromContext(cmd.Context())
+	if !ok {
+		return fmt.Errorf("configuration not loaded")
+	}
+
+	client, err := es.NewFromConfig(appCfg.ES.URL, effectiveIndex(appCfg, index.Traces), appCfg.ES.APIKey, appCfg.ES.Username, appCfg.ES.Password)
+	if err != nil {
+		return fmt.Errorf("failed to create ES client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), appCfg.ES.Timeout)
+	defer cancel()
+
+	traceID := args[0]
+	result, _, err := client.TailESQL(ctx, es.TailOptions{
+		Size:    maxTraceSpans,
+		TraceID: traceID,
+		SortAsc: true,
+	})
+	if err != nil {
+		return fmt.Errorf("trace query failed: %w", err)
+	}
+	if len(result.Logs) == 0 {
+		return fmt.Errorf("no spans found for trace %s", traceID)
+	}
+
+	roots := es.BuildSpanTree(result.Logs)
+	if traceJSONOutput {
+		return writeTraceJSON(os.Stdout, roots)
+	}
+
+	fmt.Printf("Trace %s (%d spans)\n\n", traceID, len(result.Logs))
+	renderTraceWaterfall(os.Stdout, roots, detectTerminalWidth())
+	return nil
+}
+
+func writeTraceJSON(w io.Writer, roots []*es.SpanNode) error {
+	timeline := es.NewTimeline(roots)
+	for _, row := range es.FlattenSpanTree(roots, nil) {
+		data, err := json.Marshal(traceSpanJSON{
+			SpanID:       row.Span.SpanID,
+			ParentSpanID: row.Span.ParentSpanID,
+			Name:         traceSpanName(row.Span),
+			Service:      row.Span.ServiceName,
+			Depth:        row.Depth,
+			OffsetMs:     float64(row.Span.Timestamp.Sub(timeline.Start).Nanoseconds()) / 1_000_000.0,
+			DurationMs:   float64(row.Span.Duration) / 1_000_000.0,
+			Error:        row.Span.IsError(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal span: %w", err)
+		}
+		fmt.Fprintln(w, string(data))
+	}
+	return nil
+}
+
+// renderTraceWaterfall prints one row per span: an error mark, the indented
+// span name, service, duration and a bar positioned on the trace timeline.
+func renderTraceWaterfall(w io.Writer, roots []*es.SpanNode, totalWidth int) {
+	const (
+		serviceWidth  = 16
+		durationWidth = 9
+	)
+	nameWidth := totalWidth * 2 / 5
+	if nameWidth < 24 {
+		nameWidth = 24
+	}
+	barWidth := totalWidth - 2 - nameWidth - serviceWidth - durationWidth - 3
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	timeline := es.NewTimeline(roots)
+	for _, row := range es.FlattenSpanTree(roots, nil) {
+		mark := "  "
+		if row.Span.IsError() {
+			mark = "! "
+		}
+		service := row.Span.ServiceName
+		if service == "" {
+			service = "-"
+		}
+		offset, length := timeline.Bar(row.Span, barWidth)
+
+		line := mark +
+			padOrTruncate(strings.Repeat("  ", row.Depth)+traceSpanName(row.Span), nameWidth) + " " +
+			padOrTruncate(service, serviceWidth) + " " +
+			fmt.Sprintf("%*s", durationWidth, es.FormatSpanDuration(row.Span.Duration)) + " " +
+			strings.Repeat(" ", offset) + strings.Repeat("█", length)
+		fmt.Fprintln(w, strings.TrimRight(line, " "))
+	}
+}
+
+func traceSpanName(span es.LogEntry) string {
+	if span.Name != "" {
+		return span.Name
+	}
+	if msg := span.GetMessage(); msg != "" {
+		return msg
+	}
+	return "unnamed"
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestRenderTraceWaterfall(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	ms := int64(time.Millisecond)
+	roots := es.BuildSpanTree([]es.LogEntry{
+		{SpanID: "root", Name: "GET /", ServiceName: "frontend", Timestamp: base, Duration: 100 * ms},
+		{SpanID: "db", ParentSpanID: "root", Name: "SELECT", ServiceName: "frontend", Timestamp: base.Add(50 * time.Millisecond), Duration: 50 * ms,
+			Status: map[string]interface{}{"code": "Error"}},
+	})
+
+	var buf bytes.Buffer
+	renderTraceWaterfall(&buf, roots, 100)
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d:\n%s", len(lines), buf.String())
+	}
+
+	if !strings.HasPrefix(lines[0], "  GET /") {
+		t.Errorf("expected root row unindented, got %q", lines[0])
+	}
+	if !strings.HasPrefix(lines[1], "!   SELECT") {
+		t.Errorf("expected indented error row, got %q", lines[1])
+	}
+	if !strings.Contains(lines[0], "100ms") || !strings.Contains(lines[1], "50.0ms") {
+		t.Errorf("expected durations in rows:\n%s", buf.String())
+	}
+
+	// The child bar starts halfway through the root bar and covers half its width
+	rootBar := strings.Count(lines[0], "█")
+	childBar := strings.Count(lines[1], "█")
+	if childBar != rootBar/2 {
+		t.Errorf("expected child bar to be half the root bar, got %d vs %d", childBar, rootBar)
+	}
+}
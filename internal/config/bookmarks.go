@@ -0,0 +1,115 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// BookmarksFileName is the name of the bookmarks file in the config directory.
+const BookmarksFileName = "bookmarks.yaml"
+
+// Bookmark marks a document to come back to during an investigation.
+// The document is identified by its index pattern and _id; the rest is kept
+// so the list can be shown without querying Elasticsearch.
+type Bookmark struct {
+	Index     string    `yaml:"index"` // Index pattern the document was found in
+	ID        string    `yaml:"id"`
+	Timestamp time.Time `yaml:"timestamp"`
+	Signal    string    `yaml:"signal"` // logs or traces
+	Service   string    `yaml:"service,omitempty"`
+	Summary   string    `yaml:"summary,omitempty"` // Log message or span name
+	TraceID   string    `yaml:"trace-id,omitempty"`
+	Note      string    `yaml:"note,omitempty"`
+}
+
+// Same reports whether two bookmarks mark the same document.
+func (b Bookmark) Same(other Bookmark) bool {
+	return b.Index == other.Index && b.ID == other.ID
+}
+
+// BookmarksFile is the structure of the bookmarks file.
+type BookmarksFile struct {
+	Bookmarks []Bookmark `yaml:"bookmarks,omitempty"`
+}
+
+// GetBookmarksPath returns the full path to the bookmarks file.
+func GetBookmarksPath() (string, error) {
+	dir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, BookmarksFileName), nil
+}
+
+// LoadBookmarks loads the bookmarks, oldest document first.
+// Returns no bookmarks if the file doesn't exist.
+func LoadBookmarks() ([]Bookmark, error) {
+	path, err := GetBookmarksPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read bookmarks file: %w", err)
+	}
+
+	var file BookmarksFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse bookmarks file %s: %w", path, err)
+	}
+	return file.Bookmarks, nil
+}
+
+// SaveBookmark adds a bookmark, or replaces the one marking the same
+// document, keeping the bookmarks in timeline order.
+func SaveBookmark(bookmark Bookmark) error {
+	if bookmark.ID == "" {
+		return fmt.Errorf("bookmark needs a document ID")
+	}
+	bookmarks, err := LoadBookmarks()
+	if err != nil {
+		return err
+	}
+
+	if i := slices.IndexFunc(bookmarks, bookmark.Same); i >= 0 {
+		bookmarks[i] = bookmark
+	} else {
+		bookmarks = append(bookmarks, bookmark)
+	}
+	slices.SortStableFunc(bookmarks, func(a, b Bookmark) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	return writeBookmarks(bookmarks)
+}
+
+// DeleteBookmark removes the bookmark marking the same document, if any.
+func DeleteBookmark(bookmark Bookmark) error {
+	bookmarks, err := LoadBookmarks()
+	if err != nil {
+		return err
+	}
+	return writeBookmarks(slices.DeleteFunc(bookmarks, bookmark.Same))
+}
+
+func writeBookmarks(bookmarks []Bookmark) error {
+	path, err := GetBookmarksPath()
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(BookmarksFile{Bookmarks: bookmarks})
+	if err != nil {
+		return fmt.Errorf("marshal bookmarks: %w", err)
+	}
+	return writeFileAtomic(path, data)
+}
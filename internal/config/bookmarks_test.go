@@ -0,0 +1,47 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBookmarks(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	base := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	late := Bookmark{Index: "logs-*", ID: "b", Timestamp: base.Add(time.Minute), Signal: "logs"}
+	early := Bookmark{Index: "traces-*", ID: "a", Timestamp: base, Signal: "traces"}
+	for _, b := range []Bookmark{late, early} {
+		if err := SaveBookmark(b); err != nil {
+			t.Fatalf("SaveBookmark error: %v", err)
+		}
+	}
+	// Saving the same document again replaces it
+	late.Note = "first error"
+	if err := SaveBookmark(late); err != nil {
+		t.Fatalf("SaveBookmark error: %v", err)
+	}
+
+	bookmarks, err := LoadBookmarks()
+	if err != nil {
+		t.Fatalf("LoadBookmarks error: %v", err)
+	}
+	if len(bookmarks) != 2 || bookmarks[0].ID != "a" || bookmarks[1].Note != "first error" {
+		t.Fatalf("expected both bookmarks in timeline order, got %+v", bookmarks)
+	}
+
+	if err := DeleteBookmark(early); err != nil {
+		t.Fatalf("DeleteBookmark error: %v", err)
+	}
+	bookmarks, _ = LoadBookmarks()
+	if len(bookmarks) != 1 || bookmarks[0].ID != "b" {
+		t.Errorf("expected only b left, got %+v", bookmarks)
+	}
+
+	if err := SaveBookmark(Bookmark{Index: "logs-*"}); err == nil {
+		t.Error("expected an error for a bookmark without an ID")
+	}
+}
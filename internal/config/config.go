@@ -70,6 +70,9 @@ type TUIConfig struct {
 	FieldCapsTimeout  time.Duration `mapstructure:"field_caps_timeout"`
 	AutoDetectTimeout time.Duration `mapstructure:"auto_detect_timeout"`
 	ChatTimeout       time.Duration `mapstructure:"chat_timeout"`
+	ContextSize       int           `mapstructure:"context_size"` // Logs shown on each side of an entry's context
+	Theme             string        `mapstructure:"theme"`        // auto, a built-in theme or a user theme
+	Keymap            KeymapConfig  `mapstructure:"-"`            // Key overrides from the config file
 }
 
 // Default configuration values.
@@ -87,7 +90,9 @@ const (
 	DefaultFieldCapsTimeout  = 10 * time.Second
 	DefaultAutoDetectTimeout = 30 * time.Second
 	DefaultChatTimeout       = 60 * time.Second // Longer timeout for AI chat responses
-	DefaultKibanaSpace       = "elasticat"      // Default space for local stack
+	DefaultContextSize       = 20
+	DefaultKibanaSpace       = "elasticat" // Default space for local stack
+	DefaultTheme             = "auto"      // Pick light or dark from the terminal background
 )
 
 // profileFlag holds the --profile flag value, set by root command.
@@ -146,6 +151,12 @@ func Load(cmd *cobra.Command) (Config, error) {
 
 	cfg.ProfileName = profileName
 
+	// Key overrides live in the config file but aren't Viper settings.
+	// A config file that can't be read was already reported by applyProfile.
+	if profiles, err := LoadProfiles(); err == nil {
+		cfg.TUI.Keymap = profiles.Keymap
+	}
+
 	// Default to "elasticat" space when using localhost Kibana and no space is set
 	if cfg.Kibana.Space == "" && isLocalhostURL(cfg.Kibana.URL) {
 		cfg.Kibana.Space = DefaultKibanaSpace
@@ -168,6 +179,11 @@ func applyProfile(v *viper.Viper) (string, error) {
 		return "", nil
 	}
 
+	// The theme applies to every profile, below env and flags
+	if profileCfg.Theme != "" {
+		v.SetDefault("tui.theme", profileCfg.Theme)
+	}
+
 	profile, name := profileCfg.GetActiveProfile(profileFlag)
 	if profile == nil {
 		return "", nil
@@ -238,6 +254,8 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("tui.field_caps_timeout", DefaultFieldCapsTimeout)
 	v.SetDefault("tui.auto_detect_timeout", DefaultAutoDetectTimeout)
 	v.SetDefault("tui.chat_timeout", DefaultChatTimeout)
+	v.SetDefault("tui.context_size", DefaultContextSize)
+	v.SetDefault("tui.theme", DefaultTheme)
 }
 
 // bindFlagsRecursive binds flags from cmd and all parents so Viper sees them.
@@ -275,6 +293,7 @@ func bindFlagSet(v *viper.Viper, fs *pflag.FlagSet) error {
 		"traces-timeout":      "tui.traces_timeout",
 		"field-caps-timeout":  "tui.field_caps_timeout",
 		"auto-detect-timeout": "tui.auto_detect_timeout",
+		"theme":               "tui.theme",
 	}
 
 	fs.VisitAll(func(f *pflag.Flag) {
@@ -334,5 +353,8 @@ func (c Config) Validate() error {
 	if c.TUI.ChatTimeout <= 0 {
 		return fmt.Errorf("tui.chat_timeout must be > 0")
 	}
+	if c.TUI.ContextSize <= 0 {
+		return fmt.Errorf("tui.context_size must be > 0")
+	}
 	return nil
 }
@@ -51,6 +51,8 @@ func TestLoad_Defaults(t *testing.T) {
 		"ELASTICAT_WATCH_SERVICE",
 		"ELASTICAT_TUI_TICK_INTERVAL",
 		"ELASTICAT_TUI_LOGS_TIMEOUT",
+		"ELASTICAT_TUI_THEME",
+		"ELASTICAT_TUI_CONTEXT_SIZE",
 	}
 	for _, k := range keys {
 		t.Setenv(k, "")
@@ -66,6 +68,12 @@ func TestLoad_Defaults(t *testing.T) {
 	if cfg.TUI.TickInterval != DefaultTickInterval {
 		t.Errorf("TUI.TickInterval = %v, want %v", cfg.TUI.TickInterval, DefaultTickInterval)
 	}
+	if cfg.TUI.Theme != DefaultTheme {
+		t.Errorf("TUI.Theme = %q, want %q", cfg.TUI.Theme, DefaultTheme)
+	}
+	if cfg.TUI.ContextSize != DefaultContextSize {
+		t.Errorf("TUI.ContextSize = %d, want %d", cfg.TUI.ContextSize, DefaultContextSize)
+	}
 }
 
 func TestLoad_EnvOverrides(t *testing.T) {
@@ -140,6 +148,31 @@ func TestLoad_FlagsOverrideEnv(t *testing.T) {
 	}
 }
 
+func TestLoad_ThemeFromConfigFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("ELASTICAT_TUI_THEME", "")
+	if err := SaveProfiles(&ProfileConfig{Theme: "light"}); err != nil {
+		t.Fatalf("SaveProfiles returned error: %v", err)
+	}
+
+	cfg, err := Load(newTestCmd())
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.TUI.Theme != "light" {
+		t.Errorf("TUI.Theme = %q, want light from the config file", cfg.TUI.Theme)
+	}
+
+	t.Setenv("ELASTICAT_TUI_THEME", "high-contrast")
+	cfg, err = Load(newTestCmd())
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.TUI.Theme != "high-contrast" {
+		t.Errorf("TUI.Theme = %q, want high-contrast from the environment", cfg.TUI.Theme)
+	}
+}
+
 func TestLoad_InvalidEnv_FailsFast(t *testing.T) {
 	t.Setenv("ELASTICAT_TUI_LOGS_TIMEOUT", "abc")
 
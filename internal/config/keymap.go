@@ -0,0 +1,40 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// KeymapGlobalScope is the keymap scope that applies to every view.
+const KeymapGlobalScope = "global"
+
+// KeymapConfig holds catseye key overrides from the config file.
+// It maps a scope ("global" or a view name) to the keys of each action, e.g.
+//
+//	keymap:
+//	  global:
+//	    search: ctrl+f
+//	  logs:
+//	    patterns: [ctrl+p, P]
+type KeymapConfig map[string]map[string]KeyList
+
+// KeyList is one key or a list of keys.
+type KeyList []string
+
+// UnmarshalYAML accepts a single key as well as a list.
+func (k *KeyList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*k = KeyList{node.Value}
+		return nil
+	}
+	var keys []string
+	if err := node.Decode(&keys); err != nil {
+		return fmt.Errorf("keys must be a key or a list of keys: %w", err)
+	}
+	*k = keys
+	return nil
+}
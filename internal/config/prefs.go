@@ -0,0 +1,102 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PrefsFileName is the name of the file in the config directory holding
+// settings changed from within catseye.
+const PrefsFileName = "prefs.yaml"
+
+// Prefs are the catseye settings kept between runs.
+type Prefs struct {
+	Layout LayoutPrefs `yaml:"layout,omitempty"`
+}
+
+// LayoutPrefs is the layout of the list views.
+type LayoutPrefs struct {
+	Split      bool `yaml:"split,omitempty"`       // Show the selected entry's detail beside the list
+	SplitRatio int  `yaml:"split-ratio,omitempty"` // Percentage of the split given to the list; 0 = default
+}
+
+// GetPrefsPath returns the full path to the preferences file.
+func GetPrefsPath() (string, error) {
+	dir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, PrefsFileName), nil
+}
+
+// LoadPrefs loads the preferences, returning the defaults if the file
+// doesn't exist.
+func LoadPrefs() (Prefs, error) {
+	path, err := GetPrefsPath()
+	if err != nil {
+		return Prefs{}, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Prefs{}, nil
+	}
+	if err != nil {
+		return Prefs{}, fmt.Errorf("read prefs file: %w", err)
+	}
+
+	var prefs Prefs
+	if err := yaml.Unmarshal(data, &prefs); err != nil {
+		return Prefs{}, fmt.Errorf("parse prefs file %s: %w", path, err)
+	}
+	return prefs, nil
+}
+
+// SavePrefs writes the preferences, replacing the file atomically.
+func SavePrefs(prefs Prefs) error {
+	path, err := GetPrefsPath()
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(prefs)
+	if err != nil {
+		return fmt.Errorf("marshal prefs: %w", err)
+	}
+	return writeFileAtomic(path, data)
+}
+
+// writeFileAtomic writes a file in the config directory through a temporary
+// file, so a crash leaves either the old or the new contents.
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write %s: %w", filepath.Base(path), err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("sync %s: %w", filepath.Base(path), err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close %s: %w", filepath.Base(path), err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("replace %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
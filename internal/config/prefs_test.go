@@ -0,0 +1,44 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"testing"
+)
+
+func TestPrefsRoundTrip(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	prefs, err := LoadPrefs()
+	if err != nil {
+		t.Fatalf("missing file: unexpected error: %v", err)
+	}
+	if prefs != (Prefs{}) {
+		t.Errorf("missing file: expected defaults, got %+v", prefs)
+	}
+
+	want := Prefs{Layout: LayoutPrefs{Split: true, SplitRatio: 60}}
+	if err := SavePrefs(want); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+	got, err := LoadPrefs()
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	path, err := GetPrefsPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("layout: [\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadPrefs(); err == nil {
+		t.Error("expected an error for a malformed file")
+	}
+}
@@ -18,6 +18,8 @@ import (
 type ProfileConfig struct {
 	CurrentProfile string             `yaml:"current-profile,omitempty"`
 	Profiles       map[string]Profile `yaml:"profiles,omitempty"`
+	Theme          string             `yaml:"theme,omitempty"`  // catseye theme
+	Keymap         KeymapConfig       `yaml:"keymap,omitempty"` // catseye key overrides
 }
 
 // ProfileSource indicates where profile credentials come from.
@@ -558,6 +560,8 @@ func (c ProfileConfig) MaskAllCredentials() ProfileConfig {
 	masked := ProfileConfig{
 		CurrentProfile: c.CurrentProfile,
 		Profiles:       make(map[string]Profile),
+		Theme:          c.Theme,
+		Keymap:         c.Keymap,
 	}
 	for name, profile := range c.Profiles {
 		masked.Profiles[name] = profile.MaskCredentials()
@@ -411,6 +411,39 @@ func TestLoadProfiles_NonExistent(t *testing.T) {
 	}
 }
 
+func TestLoadProfiles_Keymap(t *testing.T) {
+	tempDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tempDir)
+
+	path, err := GetConfigPath()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	data := `keymap:
+  global:
+    search: ctrl+f
+  logs:
+    patterns: [ctrl+p, P]
+`
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadProfiles()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := cfg.Keymap[KeymapGlobalScope]["search"]; len(got) != 1 || got[0] != "ctrl+f" {
+		t.Errorf("global search = %v, want [ctrl+f]", got)
+	}
+	if got := cfg.Keymap["logs"]["patterns"]; len(got) != 2 || got[0] != "ctrl+p" || got[1] != "P" {
+		t.Errorf("logs patterns = %v, want [ctrl+p P]", got)
+	}
+}
+
 func TestGetConfigPath(t *testing.T) {
 	tempDir := t.TempDir()
 	t.Setenv("XDG_CONFIG_HOME", tempDir)
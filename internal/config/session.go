@@ -0,0 +1,118 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+	"gopkg.in/yaml.v3"
+)
+
+// SessionsFileName is the name of the file in the config directory holding
+// the catseye session last left in each profile.
+const SessionsFileName = "sessions.yaml"
+
+// Session is where catseye was left: the signal, filters, columns and
+// display settings of the visible tab, restored on the next start.
+type Session struct {
+	Profile        string               `yaml:"profile,omitempty"`  // Profile the session was left in; empty = none
+	Signal         string               `yaml:"signal,omitempty"`   // logs, traces or metrics
+	Query          string               `yaml:"query,omitempty"`    // Free-text search
+	Lookback       string               `yaml:"lookback,omitempty"` // 5m, 1h, 24h, 1w or all
+	Service        string               `yaml:"service,omitempty"`
+	NegateService  bool                 `yaml:"negate-service,omitempty"`
+	Resource       string               `yaml:"resource,omitempty"`
+	NegateResource bool                 `yaml:"negate-resource,omitempty"`
+	Level          string               `yaml:"level,omitempty"`
+	Pattern        string               `yaml:"pattern,omitempty"`        // Message pattern drilled down to
+	ExceptionType  string               `yaml:"exception-type,omitempty"` // Error group drilled down to
+	ExceptionKey   string               `yaml:"exception-key,omitempty"`
+	ExceptionBy    string               `yaml:"exception-by,omitempty"` // message or frame
+	From           time.Time            `yaml:"from,omitempty"`         // Window narrowed from the volume histogram
+	To             time.Time            `yaml:"to,omitempty"`
+	Filters        []shared.FieldFilter `yaml:"filters,omitempty"`
+	Fields         []ViewField          `yaml:"fields,omitempty"`       // Columns of the list, in order
+	TimeDisplay    string               `yaml:"time-display,omitempty"` // clock, relative or full
+	SortAscending  bool                 `yaml:"sort-ascending,omitempty"`
+	TraceLevel     string               `yaml:"trace-level,omitempty"` // names, transactions or spans
+	TraceName      string               `yaml:"trace-name,omitempty"`  // Transaction name of the transactions level
+	TraceID        string               `yaml:"trace-id,omitempty"`    // Trace of the spans level
+}
+
+// SessionsFile is the structure of the sessions file.
+type SessionsFile struct {
+	Sessions []Session `yaml:"sessions,omitempty"`
+}
+
+// GetSessionsPath returns the full path to the sessions file.
+func GetSessionsPath() (string, error) {
+	dir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, SessionsFileName), nil
+}
+
+func loadSessions() ([]Session, error) {
+	path, err := GetSessionsPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read sessions file: %w", err)
+	}
+
+	var file SessionsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse sessions file %s: %w", path, err)
+	}
+	return file.Sessions, nil
+}
+
+// LoadSession returns the session last left in a profile, or nil if there
+// is none.
+func LoadSession(profile string) (*Session, error) {
+	sessions, err := loadSessions()
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(sessions, func(s Session) bool { return s.Profile == profile })
+	if i < 0 {
+		return nil, nil
+	}
+	return &sessions[i], nil
+}
+
+// SaveSession replaces the session of its profile, keeping the other
+// profiles' sessions, and writes the file atomically.
+func SaveSession(session Session) error {
+	sessions, err := loadSessions()
+	if err != nil {
+		return err
+	}
+	if i := slices.IndexFunc(sessions, func(s Session) bool { return s.Profile == session.Profile }); i >= 0 {
+		sessions[i] = session
+	} else {
+		sessions = append(sessions, session)
+	}
+
+	path, err := GetSessionsPath()
+	if err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(SessionsFile{Sessions: sessions})
+	if err != nil {
+		return fmt.Errorf("marshal sessions: %w", err)
+	}
+	return writeFileAtomic(path, data)
+}
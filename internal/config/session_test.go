@@ -0,0 +1,68 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+func TestSessions(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	if s, err := LoadSession(""); err != nil || s != nil {
+		t.Fatalf("missing file: expected no session, got %+v, %v", s, err)
+	}
+
+	from := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	work := Session{
+		Profile:  "work",
+		Signal:   "logs",
+		Service:  "gateway",
+		Lookback: "1h",
+		From:     from,
+		To:       from.Add(time.Minute),
+		Filters:  []shared.FieldFilter{{Field: "http.route", Op: shared.FieldEquals, Value: "/pay"}},
+		Fields:   []ViewField{{Name: "body.text", Label: "MESSAGE"}},
+	}
+	for _, s := range []Session{work, {Signal: "traces", TraceLevel: "transactions", TraceName: "GET /"}} {
+		if err := SaveSession(s); err != nil {
+			t.Fatalf("SaveSession error: %v", err)
+		}
+	}
+	// Saving a profile's session again replaces it
+	work.SortAscending = true
+	if err := SaveSession(work); err != nil {
+		t.Fatalf("SaveSession error: %v", err)
+	}
+
+	got, err := LoadSession("work")
+	if err != nil || got == nil {
+		t.Fatalf("LoadSession error: %v", err)
+	}
+	if !got.SortAscending || got.Service != "gateway" || !got.From.Equal(from) || len(got.Filters) != 1 || got.Fields[0].Label != "MESSAGE" {
+		t.Errorf("work session = %+v", got)
+	}
+	if got, _ := LoadSession(""); got == nil || got.TraceName != "GET /" {
+		t.Errorf("expected the session without a profile to be kept, got %+v", got)
+	}
+
+	// No temporary files are left behind
+	path, _ := GetSessionsPath()
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Errorf("expected only %s in the config directory, got %d entries", SessionsFileName, len(entries))
+	}
+
+	if err := os.WriteFile(path, []byte("sessions: [\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSession("work"); err == nil {
+		t.Error("expected an error for a malformed file")
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ThemesDir is the directory in the config directory holding user themes.
+// A theme named "solarized" is read from themes/solarized.yaml.
+const ThemesDir = "themes"
+
+// ThemeFile is a user theme. Colours are hex ("#1a1a1a") or ANSI 256-colour
+// numbers ("236"). Colours left out are taken from the base theme.
+type ThemeFile struct {
+	Base      string       `yaml:"base,omitempty"` // Built-in theme to start from (default dark)
+	Text      string       `yaml:"text,omitempty"`
+	Muted     string       `yaml:"muted,omitempty"`
+	Accent    string       `yaml:"accent,omitempty"` // Titles, keys and prompts
+	Border    string       `yaml:"border,omitempty"`
+	Success   string       `yaml:"success,omitempty"`
+	Info      string       `yaml:"info,omitempty"` // Service names and span bars
+	StatusBar string       `yaml:"status-bar,omitempty"`
+	Overlay   string       `yaml:"overlay,omitempty"` // Background of the query overlay
+	Selection ThemeColors  `yaml:"selection,omitempty"`
+	Highlight ThemeColors  `yaml:"highlight,omitempty"`  // Search matches
+	Levels    LevelPalette `yaml:"levels,omitempty"`     // Level badges and volume bars
+	LevelText LevelPalette `yaml:"level-text,omitempty"` // Text on the level badges
+	Charts    []string     `yaml:"charts,omitempty"`     // Chart series, in legend order
+}
+
+// ThemeColors is a foreground and background pair.
+type ThemeColors struct {
+	Fg string `yaml:"fg,omitempty"`
+	Bg string `yaml:"bg,omitempty"`
+}
+
+// LevelPalette holds a colour per log level.
+type LevelPalette struct {
+	Error string `yaml:"error,omitempty"`
+	Warn  string `yaml:"warn,omitempty"`
+	Info  string `yaml:"info,omitempty"`
+	Debug string `yaml:"debug,omitempty"`
+	Trace string `yaml:"trace,omitempty"`
+	Other string `yaml:"other,omitempty"`
+}
+
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// GetThemePath returns the path of the user theme with the given name.
+func GetThemePath(name string) (string, error) {
+	dir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, ThemesDir, name+".yaml"), nil
+}
+
+// LoadThemeFile reads and validates the user theme with the given name.
+func LoadThemeFile(name string) (ThemeFile, error) {
+	path, err := GetThemePath(name)
+	if err != nil {
+		return ThemeFile{}, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ThemeFile{}, fmt.Errorf("unknown theme %q (no built-in theme or %s)", name, path)
+	}
+	if err != nil {
+		return ThemeFile{}, fmt.Errorf("read theme file: %w", err)
+	}
+
+	var file ThemeFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return ThemeFile{}, fmt.Errorf("parse theme file %s: %w", path, err)
+	}
+	if err := file.validate(); err != nil {
+		return ThemeFile{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return file, nil
+}
+
+// validate checks that every colour set in the theme can be rendered.
+func (f ThemeFile) validate() error {
+	colors := map[string]string{
+		"text": f.Text, "muted": f.Muted, "accent": f.Accent, "border": f.Border,
+		"success": f.Success, "info": f.Info, "status-bar": f.StatusBar, "overlay": f.Overlay,
+		"selection.fg": f.Selection.Fg, "selection.bg": f.Selection.Bg,
+		"highlight.fg": f.Highlight.Fg, "highlight.bg": f.Highlight.Bg,
+	}
+	for prefix, p := range map[string]LevelPalette{"levels": f.Levels, "level-text": f.LevelText} {
+		colors[prefix+".error"] = p.Error
+		colors[prefix+".warn"] = p.Warn
+		colors[prefix+".info"] = p.Info
+		colors[prefix+".debug"] = p.Debug
+		colors[prefix+".trace"] = p.Trace
+		colors[prefix+".other"] = p.Other
+	}
+	for i, c := range f.Charts {
+		if c == "" {
+			return fmt.Errorf("charts[%d]: colour is empty", i)
+		}
+		colors[fmt.Sprintf("charts[%d]", i)] = c
+	}
+
+	for key, c := range colors {
+		if c != "" && !validColor(c) {
+			return fmt.Errorf("%s: invalid colour %q (expected #rrggbb or an ANSI colour number)", key, c)
+		}
+	}
+	return nil
+}
+
+func validColor(c string) bool {
+	if hexColorPattern.MatchString(c) {
+		return true
+	}
+	n, err := strconv.Atoi(c)
+	return err == nil && n >= 0 && n <= 255
+}
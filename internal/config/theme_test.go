@@ -0,0 +1,67 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTheme(t *testing.T, name, data string) {
+	t.Helper()
+	path, err := GetThemePath(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadThemeFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	writeTheme(t, "ocean", `base: light
+accent: "#005f87"
+levels:
+  error: "160"
+charts: ["#005f87", "33"]
+`)
+	file, err := LoadThemeFile("ocean")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if file.Base != "light" || file.Accent != "#005f87" || file.Levels.Error != "160" || len(file.Charts) != 2 {
+		t.Errorf("unexpected theme file: %+v", file)
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"bad hex", `accent: "#12345"`, "accent: invalid colour"},
+		{"bad ansi", `levels: {warn: "300"}`, "levels.warn: invalid colour"},
+		{"named colour", `selection: {bg: red}`, "selection.bg: invalid colour"},
+		{"empty chart", `charts: ["#fff", ""]`, "charts[1]: colour is empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writeTheme(t, "broken", tt.data)
+			_, err := LoadThemeFile("broken")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	if _, err := LoadThemeFile("missing"); err == nil || !strings.Contains(err.Error(), "unknown theme") {
+		t.Errorf("expected an unknown theme error, got %v", err)
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+	"github.com/elastic/elasticat/internal/fields"
+	"gopkg.in/yaml.v3"
+)
+
+// ViewsFileName is the name of the saved views file in the config directory.
+const ViewsFileName = "views.yaml"
+
+// ProjectViewsDir is the directory holding a project's shared views file.
+// It is looked up from the working directory upwards, so a team can commit
+// .elasticat/views.yaml next to their code.
+const ProjectViewsDir = ".elasticat"
+
+// View is a named, saved combination of signal, filters, columns and query.
+// Views with ESQL set open the query editor on that query instead.
+type View struct {
+	Name           string               `yaml:"name"`
+	Signal         string               `yaml:"signal,omitempty"`   // logs, traces or metrics
+	Query          string               `yaml:"query,omitempty"`    // Free-text search
+	Lookback       string               `yaml:"lookback,omitempty"` // 5m, 1h, 24h, 1w or all
+	Service        string               `yaml:"service,omitempty"`
+	NegateService  bool                 `yaml:"negate-service,omitempty"`
+	Resource       string               `yaml:"resource,omitempty"`
+	NegateResource bool                 `yaml:"negate-resource,omitempty"`
+	Level          string               `yaml:"level,omitempty"`
+	Filters        []shared.FieldFilter `yaml:"filters,omitempty"`
+	Fields         []ViewField          `yaml:"fields,omitempty"` // Columns of the list, in order
+	ESQL           string               `yaml:"esql,omitempty"`   // ES|QL query from the query editor
+
+	Source string `yaml:"-"` // File the view was loaded from
+}
+
+// ViewField is a column of a saved view.
+type ViewField struct {
+	Name   string   `yaml:"name"`
+	Label  string   `yaml:"label,omitempty"`
+	Width  int      `yaml:"width,omitempty"`  // 0 = flexible
+	Search []string `yaml:"search,omitempty"` // ES fields searched for this column; none = not searchable
+}
+
+// ViewsFile is the structure of the saved views file.
+type ViewsFile struct {
+	Views []View `yaml:"views,omitempty"`
+}
+
+// viewSignals are the signals a view can be saved for.
+var viewSignals = []string{"logs", "traces", "metrics"}
+
+// viewLookbacks are the lookbacks a view can be saved with.
+var viewLookbacks = map[string]string{
+	"5m":  "now-5m",
+	"1h":  "now-1h",
+	"24h": "now-24h",
+	"1w":  "now-1w",
+	"all": "",
+}
+
+// LookbackRange returns the view's lookback as an ES time range such as
+// "now-1h". It returns "" for "all" and when the view has no lookback.
+func (v View) LookbackRange() string {
+	return viewLookbacks[v.Lookback]
+}
+
+// DisplayFields returns the view's columns as display fields, or nil when
+// the view keeps the signal's default columns.
+func (v View) DisplayFields() []fields.DisplayField {
+	if len(v.Fields) == 0 {
+		return nil
+	}
+	display := make([]fields.DisplayField, 0, len(v.Fields))
+	for _, f := range v.Fields {
+		label := f.Label
+		if label == "" {
+			label = strings.ToUpper(f.Name)
+		}
+		display = append(display, fields.DisplayField{
+			Name:         f.Name,
+			Label:        label,
+			Width:        f.Width,
+			Selected:     true,
+			SearchFields: f.Search,
+		})
+	}
+	return display
+}
+
+// ViewFieldsFrom converts display fields to the columns stored in a view.
+func ViewFieldsFrom(display []fields.DisplayField) []ViewField {
+	var out []ViewField
+	for _, f := range display {
+		if !f.Selected {
+			continue
+		}
+		out = append(out, ViewField{
+			Name:   f.Name,
+			Label:  f.Label,
+			Width:  f.Width,
+			Search: f.GetSearchFields(),
+		})
+	}
+	return out
+}
+
+// validate checks the values a view can't be applied without.
+func (v View) validate() error {
+	if v.Signal != "" && !containsString(viewSignals, v.Signal) {
+		return fmt.Errorf("view %q: unknown signal %q (expected %s)", v.Name, v.Signal, strings.Join(viewSignals, ", "))
+	}
+	if _, ok := viewLookbacks[v.Lookback]; v.Lookback != "" && !ok {
+		return fmt.Errorf("view %q: unknown lookback %q (expected 5m, 1h, 24h, 1w or all)", v.Name, v.Lookback)
+	}
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// GetViewsPath returns the full path to the saved views file.
+func GetViewsPath() (string, error) {
+	dir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, ViewsFileName), nil
+}
+
+// GetProjectViewsPath returns the project views file closest to the working
+// directory, or "" when there is none. The home directory is not searched,
+// as ~/.elasticat holds other files.
+func GetProjectViewsPath() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("get working directory: %w", err)
+	}
+	home, _ := os.UserHomeDir()
+
+	for {
+		if dir != home {
+			path := filepath.Join(dir, ProjectViewsDir, ViewsFileName)
+			if _, err := os.Stat(path); err == nil {
+				return path, nil
+			}
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// LoadViews loads the user's saved views followed by the project's.
+// A user view shadows a project view of the same name.
+// Returns no views if neither file exists.
+func LoadViews() ([]View, error) {
+	userPath, err := GetViewsPath()
+	if err != nil {
+		return nil, err
+	}
+	views, err := readViews(userPath)
+	if err != nil {
+		return nil, err
+	}
+
+	projectPath, err := GetProjectViewsPath()
+	if err != nil || projectPath == "" {
+		return views, err
+	}
+	project, err := readViews(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range project {
+		if _, ok := findView(views, v.Name); !ok {
+			views = append(views, v)
+		}
+	}
+	return views, nil
+}
+
+// FindView returns the saved view with the given name.
+func FindView(name string) (View, error) {
+	views, err := LoadViews()
+	if err != nil {
+		return View{}, err
+	}
+	i, ok := findView(views, strings.TrimSpace(name))
+	if !ok {
+		return View{}, fmt.Errorf("no saved view named %q", name)
+	}
+	return views[i], nil
+}
+
+// SaveView stores a view in the user's views file, replacing any existing
+// view with the same name.
+func SaveView(view View) error {
+	view.Name = strings.TrimSpace(view.Name)
+	if view.Name == "" {
+		return fmt.Errorf("view name is required")
+	}
+	if err := view.validate(); err != nil {
+		return err
+	}
+
+	path, err := GetViewsPath()
+	if err != nil {
+		return err
+	}
+	views, err := readViews(path)
+	if err != nil {
+		return err
+	}
+
+	if i, ok := findView(views, view.Name); ok {
+		views[i] = view
+	} else {
+		views = append(views, view)
+	}
+	return writeViews(path, views)
+}
+
+// RenameView renames a saved view in the file it was loaded from.
+func RenameView(oldName, newName string) error {
+	newName = strings.TrimSpace(newName)
+	if newName == "" {
+		return fmt.Errorf("view name is required")
+	}
+
+	view, err := FindView(oldName)
+	if err != nil {
+		return err
+	}
+	if newName == view.Name {
+		return nil
+	}
+	if _, err := FindView(newName); err == nil {
+		return fmt.Errorf("a view named %q already exists", newName)
+	}
+
+	views, err := readViews(view.Source)
+	if err != nil {
+		return err
+	}
+	i, ok := findView(views, view.Name)
+	if !ok {
+		return fmt.Errorf("no saved view named %q", oldName)
+	}
+	views[i].Name = newName
+	return writeViews(view.Source, views)
+}
+
+func findView(views []View, name string) (int, bool) {
+	for i, v := range views {
+		if v.Name == name {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// readViews reads a views file, tagging each view with its path.
+func readViews(path string) ([]View, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read views file: %w", err)
+	}
+
+	var file ViewsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse views file %s: %w", path, err)
+	}
+	for i := range file.Views {
+		if err := file.Views[i].validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		file.Views[i].Source = path
+	}
+	return file.Views, nil
+}
+
+func writeViews(path string, views []View) error {
+	data, err := yaml.Marshal(ViewsFile{Views: views})
+	if err != nil {
+		return fmt.Errorf("marshal views: %w", err)
+	}
+	// Replaced atomically so a crash or concurrent save can't truncate it
+	return writeFileAtomic(path, data)
+}
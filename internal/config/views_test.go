@@ -0,0 +1,136 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSaveView(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	views, err := LoadViews()
+	if err != nil {
+		t.Fatalf("LoadViews error: %v", err)
+	}
+	if len(views) != 0 {
+		t.Fatalf("expected no views, got %d", len(views))
+	}
+
+	if err := SaveView(View{Name: " errors ", ESQL: "FROM logs-* | LIMIT 1"}); err != nil {
+		t.Fatalf("SaveView error: %v", err)
+	}
+	if err := SaveView(View{Name: "slow", ESQL: "FROM traces-*"}); err != nil {
+		t.Fatalf("SaveView error: %v", err)
+	}
+	// Saving under an existing name replaces the view in place
+	if err := SaveView(View{Name: "errors", ESQL: "FROM logs-* | LIMIT 10"}); err != nil {
+		t.Fatalf("SaveView error: %v", err)
+	}
+
+	views, err = LoadViews()
+	if err != nil {
+		t.Fatalf("LoadViews error: %v", err)
+	}
+	if len(views) != 2 {
+		t.Fatalf("expected 2 views, got %+v", views)
+	}
+	if views[0].Name != "errors" || views[0].ESQL != "FROM logs-* | LIMIT 10" {
+		t.Errorf("unexpected first view: %+v", views[0])
+	}
+
+	if err := SaveView(View{Name: "  "}); err == nil {
+		t.Error("expected an error for an empty name")
+	}
+}
+
+func TestProjectViews(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	project := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(project, ProjectViewsDir, "sub"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	shared := `views:
+  - name: checkout-errors
+    signal: logs
+    lookback: 1h
+    service: checkout
+    level: ERROR
+    filters:
+      - field: http.status
+        op: equals
+        value: "500"
+    fields:
+      - name: "@timestamp"
+        label: TIME
+        width: 8
+      - name: body.text
+        label: MESSAGE
+        search: [body.text]
+  - name: slow
+    signal: traces
+`
+	if err := os.WriteFile(filepath.Join(project, ProjectViewsDir, ViewsFileName), []byte(shared), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// The project file is found from a subdirectory
+	t.Chdir(filepath.Join(project, ProjectViewsDir, "sub"))
+
+	// A user view shadows the project view of the same name
+	if err := SaveView(View{Name: "slow", Signal: "traces", Lookback: "5m"}); err != nil {
+		t.Fatalf("SaveView error: %v", err)
+	}
+
+	views, err := LoadViews()
+	if err != nil {
+		t.Fatalf("LoadViews error: %v", err)
+	}
+	if len(views) != 2 || views[0].Name != "slow" || views[0].Lookback != "5m" {
+		t.Fatalf("unexpected views: %+v", views)
+	}
+
+	view, err := FindView("checkout-errors")
+	if err != nil {
+		t.Fatalf("FindView error: %v", err)
+	}
+	if view.LookbackRange() != "now-1h" || view.Service != "checkout" || len(view.Filters) != 1 || view.Filters[0].Value != "500" {
+		t.Errorf("unexpected view: %+v", view)
+	}
+	display := view.DisplayFields()
+	if len(display) != 2 || display[0].GetSearchFields() != nil || display[1].GetSearchFields()[0] != "body.text" {
+		t.Errorf("unexpected display fields: %+v", display)
+	}
+
+	// Renaming writes back to the file the view came from
+	if err := RenameView("checkout-errors", "checkout"); err != nil {
+		t.Fatalf("RenameView error: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(project, ProjectViewsDir, ViewsFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "name: checkout\n") {
+		t.Errorf("expected the project file to be renamed, got:\n%s", data)
+	}
+	if err := RenameView("checkout", "slow"); err == nil {
+		t.Error("expected an error when renaming onto an existing view")
+	}
+	if _, err := FindView("missing"); err == nil {
+		t.Error("expected an error for a missing view")
+	}
+}
+
+func TestViewValidation(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	if err := SaveView(View{Name: "bad", Signal: "events"}); err == nil {
+		t.Error("expected an error for an unknown signal")
+	}
+	if err := SaveView(View{Name: "bad", Lookback: "2h"}); err == nil {
+		t.Error("expected an error for an unknown lookback")
+	}
+}
@@ -10,11 +10,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/elastic/go-elasticsearch/v8"
 
 	"github.com/elastic/elasticat/internal/es/metrics"
+	"github.com/elastic/elasticat/internal/es/patterns"
 	"github.com/elastic/elasticat/internal/es/perspectives"
 	"github.com/elastic/elasticat/internal/es/shared"
 	"github.com/elastic/elasticat/internal/es/traces"
@@ -128,9 +131,10 @@ func (c *Client) FieldCaps(ctx context.Context, index, fields string) (*FieldCap
 
 	var response struct {
 		Fields map[string]map[string]struct {
-			Type             string `json:"type"`
-			Aggregatable     bool   `json:"aggregatable"`
-			TimeSeriesMetric string `json:"time_series_metric,omitempty"`
+			Type             string              `json:"type"`
+			Aggregatable     bool                `json:"aggregatable"`
+			TimeSeriesMetric string              `json:"time_series_metric,omitempty"`
+			Meta             map[string][]string `json:"meta,omitempty"`
 		} `json:"fields"`
 	}
 
@@ -149,6 +153,7 @@ func (c *Client) FieldCaps(ctx context.Context, index, fields string) (*FieldCap
 				Type:             info.Type,
 				Aggregatable:     info.Aggregatable,
 				TimeSeriesMetric: info.TimeSeriesMetric,
+				Meta:             info.Meta,
 			}
 		}
 	}
@@ -258,7 +263,9 @@ func (c *Client) ExecuteESQLQuery(ctx context.Context, query string) (*ESQLResul
 				Body:   string(bodyBytes),
 			}
 		}
-		return nil, fmt.Errorf("ES|QL query failed: %s\nError: %s\n\nQuery:\n%s", res.Status, string(bodyBytes), query)
+		qerr := &shared.ESQLQueryError{Status: res.Status, Body: string(bodyBytes), Query: query}
+		qerr.Line, qerr.Column, qerr.Message = parseESQLErrorPosition(bodyBytes)
+		return nil, qerr
 	}
 
 	// Parse response
@@ -360,6 +367,42 @@ func parseUnsupportedFieldTypeFromESQLResponse(body []byte) (field string, typ s
 	return "", "", false
 }
 
+// esqlErrorPositionRe matches the "line L:C: problem" prefix ES puts on
+// parsing and verification errors.
+var esqlErrorPositionRe = regexp.MustCompile(`line (\d+):(\d+): ([^\n]*)`)
+
+// parseESQLErrorPosition extracts the position and text of the first problem
+// reported in an ES|QL error response. Line and column are zero when the
+// response has no position; the message then falls back to the error reason.
+func parseESQLErrorPosition(body []byte) (line, column int, message string) {
+	// Typical response:
+	// {"error":{"root_cause":[{"type":"parsing_exception","reason":"line 1:15: mismatched input 'x' expecting ..."}], ...}}
+	var resp struct {
+		Error struct {
+			Reason    string `json:"reason"`
+			RootCause []struct {
+				Reason string `json:"reason"`
+			} `json:"root_cause"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return 0, 0, ""
+	}
+
+	reasons := []string{resp.Error.Reason}
+	for _, rc := range resp.Error.RootCause {
+		reasons = append(reasons, rc.Reason)
+	}
+	for _, reason := range reasons {
+		if m := esqlErrorPositionRe.FindStringSubmatch(reason); m != nil {
+			line, _ = strconv.Atoi(m[1])
+			column, _ = strconv.Atoi(m[2])
+			return line, column, m[3]
+		}
+	}
+	return 0, 0, resp.Error.Reason
+}
+
 // === Field capabilities ===
 
 // GetFieldCaps retrieves available fields from the index using the field_caps API
@@ -531,6 +574,16 @@ func (c *Client) AggregateMetrics(ctx context.Context, opts metrics.AggregateMet
 	return metrics.Aggregate(ctx, c, opts)
 }
 
+// GetMetricDimensions discovers the attributes a metric can be grouped by
+func (c *Client) GetMetricDimensions(ctx context.Context, metricName string, opts metrics.AggregateMetricsOptions) ([]metrics.Dimension, error) {
+	return metrics.GetDimensions(ctx, c, metricName, opts)
+}
+
+// GetMetricDistribution returns percentiles and a heatmap over time for a histogram metric
+func (c *Client) GetMetricDistribution(ctx context.Context, metricName string, opts metrics.AggregateMetricsOptions, rows int) (*metrics.HistogramDistribution, error) {
+	return metrics.GetDistribution(ctx, c, metricName, opts, rows)
+}
+
 // GetTransactionNames returns aggregated transaction names with statistics
 func (c *Client) GetTransactionNames(ctx context.Context, lookback, service, resource string) ([]traces.TransactionNameAgg, error) {
 	return traces.GetNames(ctx, c, lookback, service, resource)
@@ -541,6 +594,26 @@ func (c *Client) GetTransactionNamesESQL(ctx context.Context, lookback, service,
 	return traces.GetNamesESSQL(ctx, c, lookback, service, resource, negateService, negateResource)
 }
 
+// GetServiceMap derives service dependencies from trace data
+func (c *Client) GetServiceMap(ctx context.Context, opts traces.ServiceMapOptions) (*traces.ServiceMapResult, error) {
+	return traces.GetServiceMap(ctx, c, opts)
+}
+
+// GetLatencyHistogram returns the duration distribution of a transaction name
+func (c *Client) GetLatencyHistogram(ctx context.Context, opts traces.LatencyHistogramOptions) (*traces.LatencyHistogram, error) {
+	return traces.GetLatencyHistogram(ctx, c, opts)
+}
+
+// GetExampleTrace returns the slowest trace whose transaction falls into a latency bucket
+func (c *Client) GetExampleTrace(ctx context.Context, opts traces.LatencyHistogramOptions, bucket traces.LatencyBucket) (string, error) {
+	return traces.GetExampleTrace(ctx, c, opts, bucket)
+}
+
+// GetLogPatterns groups log messages into patterns using ES|QL CATEGORIZE
+func (c *Client) GetLogPatterns(ctx context.Context, opts patterns.Options) (*patterns.PatternsResult, error) {
+	return patterns.Categorize(ctx, c, opts)
+}
+
 // GetServices returns aggregated counts per service
 func (c *Client) GetServices(ctx context.Context, lookback string) ([]perspectives.PerspectiveAgg, error) {
 	return perspectives.GetServices(ctx, c, lookback)
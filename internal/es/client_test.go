@@ -43,3 +43,46 @@ func TestParseUnsupportedFieldTypeFromESQLResponse(t *testing.T) {
 		t.Fatalf("type=%q, want %q", typ, "histogram")
 	}
 }
+
+func TestParseESQLErrorPosition(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		line    int
+		column  int
+		message string
+	}{
+		{
+			name:    "parsing exception",
+			body:    `{"error":{"root_cause":[{"type":"parsing_exception","reason":"line 2:7: mismatched input 'WHER' expecting {<EOF>, '|'}"}],"type":"parsing_exception","reason":"line 2:7: mismatched input 'WHER' expecting {<EOF>, '|'}"},"status":400}`,
+			line:    2,
+			column:  7,
+			message: "mismatched input 'WHER' expecting {<EOF>, '|'}",
+		},
+		{
+			name:    "verification exception",
+			body:    `{"error":{"type":"verification_exception","reason":"Found 1 problem\nline 1:30: Unknown column [foo]"},"status":400}`,
+			line:    1,
+			column:  30,
+			message: "Unknown column [foo]",
+		},
+		{
+			name:    "no position",
+			body:    `{"error":{"type":"illegal_argument_exception","reason":"query too long"},"status":400}`,
+			message: "query too long",
+		},
+		{
+			name: "not json",
+			body: `Bad Gateway`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			line, column, message := parseESQLErrorPosition([]byte(tc.body))
+			if line != tc.line || column != tc.column || message != tc.message {
+				t.Errorf("got (%d, %d, %q), want (%d, %d, %q)", line, column, message, tc.line, tc.column, tc.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package es
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+// DefaultExceptionGroups is the number of groups Exceptions returns when
+// SearchOptions.Size is unset.
+const DefaultExceptionGroups = 50
+
+// ExceptionGroupBy selects what exceptions of one type are told apart by.
+type ExceptionGroupBy int
+
+const (
+	ExceptionsByMessage ExceptionGroupBy = iota // exception.message with numbers, hex values and UUIDs masked
+	ExceptionsByFrame                           // First frame of exception.stacktrace
+)
+
+func (b ExceptionGroupBy) String() string {
+	if b == ExceptionsByFrame {
+		return "frame"
+	}
+	return "message"
+}
+
+// exceptionMasks replace the variable parts of exception messages, in order,
+// so that occurrences of one error group together.
+var exceptionMasks = []struct{ regex, replacement string }{
+	{`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`, "<uuid>"},
+	{`(?i)\b(?:0x[0-9a-f]+|(?=[0-9a-f]*[0-9])[0-9a-f]{8,})\b`, "<hex>"},
+	{`\d+`, "<n>"},
+}
+
+// exceptionFrameRegex keeps the first frame of a stack trace: the line after
+// the exception's own, without Java's and Node's "at" prefix.
+const exceptionFrameRegex = `(?s)^[^\n]*\n\s*(?:at\s+)?([^\n]*).*$`
+
+// ExceptionFilter selects the occurrences of one exception group.
+type ExceptionFilter struct {
+	Type string           // exception.type
+	Key  string           // Masked message or top frame, depending on By
+	By   ExceptionGroupBy // What Key holds
+}
+
+// IsZero reports whether the filter selects nothing in particular.
+func (f ExceptionFilter) IsZero() bool {
+	return f.Type == ""
+}
+
+// ESQL renders the filter as an ES|QL WHERE condition.
+func (f ExceptionFilter) ESQL() string {
+	return fmt.Sprintf("(TO_STRING(exception.type) == %s AND %s == %s)",
+		esqlStringLiteral(f.Type), exceptionKeyExpr(f.By), esqlStringLiteral(f.Key))
+}
+
+// String describes the group, e.g. "TimeoutError: read timed out after <n>ms".
+func (f ExceptionFilter) String() string {
+	if f.Key == "" {
+		return f.Type
+	}
+	return f.Type + ": " + f.Key
+}
+
+// ExceptionGroup is one kind of exception and its occurrences over time.
+type ExceptionGroup struct {
+	ExceptionFilter
+	Count     int64
+	Services  []string // Services the exception occurred in, sorted
+	FirstSeen time.Time
+	LastSeen  time.Time
+	Buckets   []int64 // Occurrences per interval of the window, oldest first
+}
+
+// ExceptionGroups are the most frequent exception groups of a time window.
+type ExceptionGroups struct {
+	Groups   []ExceptionGroup // Most frequent first
+	Start    time.Time        // Start of the first bucket
+	Interval time.Duration    // Width of the buckets
+}
+
+// exceptionKeyExpr is the ES|QL expression of an exception's group key.
+func exceptionKeyExpr(by ExceptionGroupBy) string {
+	if by == ExceptionsByFrame {
+		return fmt.Sprintf("COALESCE(REPLACE(TO_STRING(exception.stacktrace), %s, \"$1\"), \"\")", esqlStringLiteral(exceptionFrameRegex))
+	}
+	expr := "TO_STRING(exception.message)"
+	for _, mask := range exceptionMasks {
+		expr = fmt.Sprintf("REPLACE(%s, %s, %s)", expr, esqlStringLiteral(mask.regex), esqlStringLiteral(mask.replacement))
+	}
+	return fmt.Sprintf("COALESCE(%s, \"\")", expr)
+}
+
+// esqlStringLiteral quotes s as an ES|QL string literal. Unlike
+// escapeESQLString it also escapes backslashes and line breaks, so regexes
+// and multi-line values survive.
+func esqlStringLiteral(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(s) + `"`
+}
+
+// Exceptions groups the OTel exceptions among the documents matching the TUI
+// filters by exception.type and either their masked message or their top
+// stack frame, with per-bucket counts of [opts.From, opts.To) for sparklines.
+// opts.Size limits the groups returned. A first query ranks the groups by
+// count; a second one counts only their occurrences per bucket, so each has
+// all its buckets whatever the number of groups. Returns the groups plus the
+// ranking ES|QL query string for display.
+func (c *Client) Exceptions(ctx context.Context, queryStr string, opts SearchOptions, by ExceptionGroupBy, buckets int) (*ExceptionGroups, string, error) {
+	if opts.To.IsZero() {
+		opts.To = time.Now()
+	}
+	interval := VolumeInterval(opts.To.Sub(opts.From), buckets)
+	filters := buildCommonFilters(commonFilterOptions{
+		indexPattern:   c.index,
+		lookback:       opts.Lookback,
+		from:           opts.From,
+		to:             opts.To,
+		service:        opts.Service,
+		negateService:  opts.NegateService,
+		resource:       opts.Resource,
+		negateResource: opts.NegateResource,
+		level:          opts.Level,
+		traceID:        opts.TraceID,
+		spanID:         opts.SpanID,
+		pattern:        opts.Pattern,
+		exception:      opts.Exception,
+		fieldFilters:   opts.FieldFilters,
+		searchClause:   buildSearchClause(queryStr, opts.SearchFields),
+	})
+	limit := opts.Size
+	if limit <= 0 {
+		limit = DefaultExceptionGroups
+	}
+	empty := &ExceptionGroups{Groups: []ExceptionGroup{}, Start: opts.From.Truncate(interval), Interval: interval}
+
+	query := buildExceptionsQuery(c.index, filters, by, limit)
+	res, err := c.executeExceptionsQuery(ctx, query)
+	if err != nil {
+		return nil, query, err
+	}
+	if res == nil {
+		return empty, query, nil
+	}
+	result := groupExceptions(res, by, opts.From, opts.To, interval)
+	if len(result.Groups) == 0 {
+		return result, query, nil
+	}
+
+	// One row per group and bucket; BUCKET may add one at the unaligned start
+	bucketQuery := buildExceptionBucketsQuery(c.index, filters, by, result.Groups, interval, len(result.Groups)*(len(result.Groups[0].Buckets)+1))
+	res, err = c.executeExceptionsQuery(ctx, bucketQuery)
+	if err != nil {
+		return nil, query, err
+	}
+	if res != nil {
+		fillExceptionBuckets(result, res)
+	}
+	return result, query, nil
+}
+
+// executeExceptionsQuery runs an exceptions query, leaving out the index
+// patterns that don't exist. It returns a nil result when there is nothing
+// to group: no index, or no document with the exception fields yet.
+func (c *Client) executeExceptionsQuery(ctx context.Context, query string) (*ESQLResult, error) {
+	res, _, err := c.executeESQLDroppingUnknownIndices(ctx, query)
+	if err != nil {
+		// No document has the exception fields yet
+		if q, ok := shared.IsESQLQueryError(err); ok && strings.Contains(q.Message, "Unknown column [exception.") {
+			return nil, nil
+		}
+		if shared.IsESQLEmptyStateError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("exceptions query failed: %w", err)
+	}
+	return res, nil
+}
+
+// exceptionsSource is the start of both exceptions queries: the filtered
+// documents with their exc_type and exc_key.
+func exceptionsSource(indexPattern string, filters esqlFilters, by ExceptionGroupBy) string {
+	where := "WHERE true"
+	if len(filters.whereParts) > 0 {
+		where = "WHERE " + strings.Join(filters.whereParts, " AND ")
+	}
+	return fmt.Sprintf(`FROM %s
+| %s
+| EVAL exc_type = TO_STRING(exception.type), exc_key = %s
+| WHERE exc_type IS NOT NULL`, indexPattern, where, exceptionKeyExpr(by))
+}
+
+// buildExceptionsQuery ranks the exception groups by count, keeping the
+// limit most frequent.
+func buildExceptionsQuery(indexPattern string, filters esqlFilters, by ExceptionGroupBy, limit int) string {
+	return exceptionsSource(indexPattern, filters, by) + fmt.Sprintf(`
+| STATS
+    count = COUNT(*),
+    first_seen = MIN(@timestamp),
+    last_seen = MAX(@timestamp),
+    services = VALUES(service.name)
+  BY exc_type, exc_key
+| SORT count DESC, exc_type, exc_key
+| LIMIT %d`, limit)
+}
+
+// buildExceptionBucketsQuery counts the occurrences of the given groups per
+// bucket. rows bounds the group × bucket rows.
+func buildExceptionBucketsQuery(indexPattern string, filters esqlFilters, by ExceptionGroupBy, groups []ExceptionGroup, interval time.Duration, rows int) string {
+	keys := make([]string, len(groups))
+	for i, g := range groups {
+		keys[i] = fmt.Sprintf("(exc_type == %s AND exc_key == %s)", esqlStringLiteral(g.Type), esqlStringLiteral(g.Key))
+	}
+	return exceptionsSource(indexPattern, filters, by) + fmt.Sprintf(`
+| WHERE %s
+| STATS count = COUNT(*) BY exc_type, exc_key, bucket = BUCKET(@timestamp, %s)
+| LIMIT %d`, strings.Join(keys, " OR "), esqlTimeSpan(interval), rows)
+}
+
+// groupExceptions reads the ranked rows of an exceptions query into groups,
+// most frequent first, each with empty buckets on the grid of [from, to) for
+// fillExceptionBuckets.
+func groupExceptions(res *ESQLResult, by ExceptionGroupBy, from, to time.Time, interval time.Duration) *ExceptionGroups {
+	colIndex := map[string]int{}
+	for i, col := range res.Columns {
+		colIndex[col.Name] = i
+	}
+	get := func(row []interface{}, name string) interface{} {
+		idx, ok := colIndex[name]
+		if !ok || idx >= len(row) {
+			return nil
+		}
+		return row[idx]
+	}
+	getString := func(row []interface{}, name string) string {
+		s, _ := get(row, name).(string)
+		return s
+	}
+	getTime := func(row []interface{}, name string) time.Time {
+		t, _ := time.Parse(time.RFC3339Nano, getString(row, name))
+		return t
+	}
+
+	start := from.Truncate(interval)
+	n := 0
+	if to.After(start) {
+		n = int((to.Sub(start) + interval - 1) / interval)
+	}
+	result := &ExceptionGroups{Groups: []ExceptionGroup{}, Start: start, Interval: interval}
+
+	for _, row := range res.Values {
+		f := ExceptionFilter{Type: getString(row, "exc_type"), Key: getString(row, "exc_key"), By: by}
+		if f.Type == "" {
+			continue
+		}
+		g := ExceptionGroup{ExceptionFilter: f, Buckets: make([]int64, n)}
+		if v, ok := get(row, "count").(float64); ok {
+			g.Count = int64(v)
+		}
+		g.FirstSeen = getTime(row, "first_seen")
+		g.LastSeen = getTime(row, "last_seen")
+		switch v := get(row, "services").(type) {
+		case string:
+			g.Services = []string{v}
+		case []interface{}:
+			for _, s := range v {
+				if s, ok := s.(string); ok {
+					g.Services = append(g.Services, s)
+				}
+			}
+		}
+		slices.Sort(g.Services)
+		result.Groups = append(result.Groups, g)
+	}
+
+	// Ties come back in any order
+	slices.SortStableFunc(result.Groups, func(a, b ExceptionGroup) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.String(), b.String())
+	})
+	return result
+}
+
+// fillExceptionBuckets adds the per-bucket rows of an exceptions buckets
+// query to the buckets of their groups.
+func fillExceptionBuckets(result *ExceptionGroups, res *ESQLResult) {
+	colIndex := map[string]int{}
+	for i, col := range res.Columns {
+		colIndex[col.Name] = i
+	}
+	get := func(row []interface{}, name string) interface{} {
+		idx, ok := colIndex[name]
+		if !ok || idx >= len(row) {
+			return nil
+		}
+		return row[idx]
+	}
+	getString := func(row []interface{}, name string) string {
+		s, _ := get(row, name).(string)
+		return s
+	}
+
+	byKey := map[[2]string]*ExceptionGroup{}
+	for i := range result.Groups {
+		g := &result.Groups[i]
+		byKey[[2]string{g.Type, g.Key}] = g
+	}
+	for _, row := range res.Values {
+		g, ok := byKey[[2]string{getString(row, "exc_type"), getString(row, "exc_key")}]
+		if !ok || len(g.Buckets) == 0 {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, getString(row, "bucket"))
+		if err != nil {
+			continue
+		}
+		count, _ := get(row, "count").(float64)
+		b := min(max(int(t.Sub(result.Start)/result.Interval), 0), len(g.Buckets)-1)
+		g.Buckets[b] += int64(count)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package es
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildExceptionsQuery(t *testing.T) {
+	t.Parallel()
+
+	filters := buildCommonFilters(commonFilterOptions{indexPattern: "logs-*", service: "api"})
+
+	byMessage := buildExceptionsQuery("logs-*", filters, ExceptionsByMessage, 50)
+	for _, want := range []string{
+		"FROM logs-*",
+		`service.name == "api"`,
+		`REPLACE(TO_STRING(exception.message), "(?i)\\b[0-9a-f]{8}-`,
+		`"\\d+", "<n>"), "")`,
+		"WHERE exc_type IS NOT NULL",
+		"services = VALUES(service.name)",
+		"BY exc_type, exc_key\n",
+		"SORT count DESC, exc_type, exc_key\n| LIMIT 50",
+	} {
+		if !strings.Contains(byMessage, want) {
+			t.Errorf("expected query to contain %q, got:\n%s", want, byMessage)
+		}
+	}
+
+	byFrame := buildExceptionsQuery("logs-*", filters, ExceptionsByFrame, 50)
+	if want := `COALESCE(REPLACE(TO_STRING(exception.stacktrace), "(?s)^[^\\n]*\\n\\s*(?:at\\s+)?([^\\n]*).*$", "$1"), "")`; !strings.Contains(byFrame, want) {
+		t.Errorf("expected query to contain %q, got:\n%s", want, byFrame)
+	}
+}
+
+func TestBuildExceptionBucketsQuery(t *testing.T) {
+	t.Parallel()
+
+	filters := buildCommonFilters(commonFilterOptions{indexPattern: "logs-*"})
+	groups := []ExceptionGroup{
+		{ExceptionFilter: ExceptionFilter{Type: "TimeoutError", Key: "read timed out after <n>ms"}},
+		{ExceptionFilter: ExceptionFilter{Type: "KeyError"}},
+	}
+	query := buildExceptionBucketsQuery("logs-*", filters, ExceptionsByMessage, groups, 5*time.Minute, 42)
+	for _, want := range []string{
+		`| WHERE (exc_type == "TimeoutError" AND exc_key == "read timed out after <n>ms") OR (exc_type == "KeyError" AND exc_key == "")`,
+		"STATS count = COUNT(*) BY exc_type, exc_key, bucket = BUCKET(@timestamp, 5 minutes)",
+		"LIMIT 42",
+	} {
+		if !strings.Contains(query, want) {
+			t.Errorf("expected query to contain %q, got:\n%s", want, query)
+		}
+	}
+}
+
+func TestExceptionFilterESQL(t *testing.T) {
+	t.Parallel()
+
+	f := ExceptionFilter{Type: "IOError", Key: `open C:\tmp "x"`, By: ExceptionsByFrame}
+	got := f.ESQL()
+	for _, want := range []string{
+		`TO_STRING(exception.type) == "IOError"`,
+		`TO_STRING(exception.stacktrace)`,
+		`== "open C:\\tmp \"x\"")`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %q in %s", want, got)
+		}
+	}
+
+	filters := buildCommonFilters(commonFilterOptions{exception: f})
+	if len(filters.whereParts) != 1 || filters.whereParts[0] != got {
+		t.Errorf("expected the exception filter in the WHERE clause, got %v", filters.whereParts)
+	}
+	if parts := buildCommonFilters(commonFilterOptions{}).whereParts; len(parts) != 0 {
+		t.Errorf("expected no filter without an exception, got %v", parts)
+	}
+}
+
+func TestGroupExceptions(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	totals := &ESQLResult{
+		Columns: []ESQLColumn{
+			{Name: "count"}, {Name: "first_seen"}, {Name: "last_seen"}, {Name: "services"},
+			{Name: "exc_type"}, {Name: "exc_key"},
+		},
+		Values: [][]interface{}{
+			{float64(7), "2026-01-02T10:01:00.000Z", "2026-01-02T10:29:00.000Z", []interface{}{"worker", "api"}, "TimeoutError", "read timed out after <n>ms"},
+			{float64(3), "2026-01-02T10:10:00.000Z", "2026-01-02T10:11:00.000Z", "api", "KeyError", ""},
+			{float64(9), nil, nil, nil, nil, "no type"},
+		},
+	}
+
+	got := groupExceptions(totals, ExceptionsByMessage, from, from.Add(30*time.Minute), 5*time.Minute)
+	if len(got.Groups) != 2 {
+		t.Fatalf("expected 2 groups (untyped row skipped), got %+v", got.Groups)
+	}
+	timeout := got.Groups[0]
+	if timeout.Type != "TimeoutError" || timeout.Count != 7 {
+		t.Errorf("expected the timeouts first with 7 occurrences, got %+v", timeout)
+	}
+	if strings.Join(timeout.Services, ",") != "api,worker" {
+		t.Errorf("services = %v", timeout.Services)
+	}
+	if !timeout.FirstSeen.Equal(from.Add(time.Minute)) || !timeout.LastSeen.Equal(from.Add(29*time.Minute)) {
+		t.Errorf("seen %v..%v", timeout.FirstSeen, timeout.LastSeen)
+	}
+	if got.Groups[1].String() != "KeyError" {
+		t.Errorf("expected a group without message to show its type only, got %q", got.Groups[1].String())
+	}
+
+	buckets := &ESQLResult{
+		Columns: []ESQLColumn{{Name: "count"}, {Name: "exc_type"}, {Name: "exc_key"}, {Name: "bucket"}},
+		Values: [][]interface{}{
+			{float64(2), "TimeoutError", "read timed out after <n>ms", "2026-01-02T10:00:00.000Z"},
+			{float64(5), "TimeoutError", "read timed out after <n>ms", "2026-01-02T10:25:00.000Z"},
+			{float64(3), "KeyError", "", "2026-01-02T10:10:00.000Z"},
+			{float64(4), "OtherError", "", "2026-01-02T10:10:00.000Z"},
+		},
+	}
+	fillExceptionBuckets(got, buckets)
+	if want := []int64{2, 0, 0, 0, 0, 5}; !slices.Equal(timeout.Buckets, want) {
+		t.Errorf("buckets = %v, want %v", timeout.Buckets, want)
+	}
+	if want := []int64{0, 0, 3, 0, 0, 0}; !slices.Equal(got.Groups[1].Buckets, want) {
+		t.Errorf("buckets = %v, want %v (rows of other groups ignored)", got.Groups[1].Buckets, want)
+	}
+}
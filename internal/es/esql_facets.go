@@ -0,0 +1,195 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package es
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+// facetSize is the number of top values GetFieldFacets returns.
+const facetSize = 10
+
+// FacetValue is one distinct value of a field and the number of documents holding it.
+type FacetValue struct {
+	Value string
+	Count int64
+}
+
+// NumericSummary summarizes the values of a numeric field.
+type NumericSummary struct {
+	Min float64
+	Max float64
+	Avg float64
+}
+
+// FieldFacets describes the values a field holds across the documents
+// matching the current filters.
+type FieldFacets struct {
+	Field   string
+	Total   int64           // Documents matching the filters
+	Present int64           // Matching documents that have the field
+	Values  []FacetValue    // Most frequent values first
+	Numeric *NumericSummary // Set for numeric fields that have values
+}
+
+// Percent returns the share of matching documents that hold v, from 0 to 100.
+func (f *FieldFacets) Percent(v FacetValue) float64 {
+	if f.Total == 0 {
+		return 0
+	}
+	return float64(v.Count) * 100 / float64(f.Total)
+}
+
+// IsNumericType reports whether a field caps type holds numbers.
+func IsNumericType(fieldType string) bool {
+	switch fieldType {
+	case "long", "integer", "short", "byte", "double", "float", "half_float", "scaled_float", "unsigned_long":
+		return true
+	}
+	return false
+}
+
+// GetFieldFacets returns the top values of field among the documents matching
+// the TUI filters, with min/max/avg for numeric fields.
+func (c *Client) GetFieldFacets(ctx context.Context, field string, numeric bool, queryStr string, opts SearchOptions) (*FieldFacets, error) {
+	filters := buildCommonFilters(commonFilterOptions{
+		indexPattern:   c.index,
+		lookback:       opts.Lookback,
+		from:           opts.From,
+		to:             opts.To,
+		service:        opts.Service,
+		negateService:  opts.NegateService,
+		resource:       opts.Resource,
+		negateResource: opts.NegateResource,
+		level:          opts.Level,
+		traceID:        opts.TraceID,
+		spanID:         opts.SpanID,
+		pattern:        opts.Pattern,
+		exception:      opts.Exception,
+		fieldFilters:   opts.FieldFilters,
+		searchClause:   buildSearchClause(queryStr, opts.SearchFields),
+	})
+
+	facets := &FieldFacets{Field: field}
+
+	summary, err := c.executeFacetQuery(ctx, buildFacetSummaryQuery(c.index, filters, field, numeric))
+	if err != nil || summary == nil {
+		return facets, err
+	}
+	parseFacetSummary(summary, facets)
+	if facets.Present == 0 {
+		return facets, nil
+	}
+
+	values, err := c.executeFacetQuery(ctx, buildFacetValuesQuery(c.index, filters, field))
+	if err != nil || values == nil {
+		return facets, err
+	}
+	facets.Values = parseFacetValues(values)
+	return facets, nil
+}
+
+// executeFacetQuery runs a facet query, dropping index patterns that don't
+// exist. A nil result means there is nothing to facet on.
+func (c *Client) executeFacetQuery(ctx context.Context, query string) (*ESQLResult, error) {
+	res, _, err := c.executeESQLDroppingUnknownIndices(ctx, query)
+	if err != nil {
+		if shared.IsESQLEmptyStateError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("field facets query failed: %w", err)
+	}
+	return res, nil
+}
+
+func facetWhere(filters esqlFilters, extra ...string) string {
+	parts := append(append([]string(nil), filters.whereParts...), extra...)
+	if len(parts) == 0 {
+		return "WHERE true"
+	}
+	return "WHERE " + strings.Join(parts, " AND ")
+}
+
+func buildFacetSummaryQuery(indexPattern string, filters esqlFilters, field string, numeric bool) string {
+	name := shared.ESQLFieldName(field)
+	stats := []string{
+		"total = COUNT(*)",
+		fmt.Sprintf("present = COUNT(CASE(%s IS NOT NULL, 1, null))", name),
+	}
+	if numeric {
+		stats = append(stats,
+			fmt.Sprintf("min = MIN(%s)", name),
+			fmt.Sprintf("max = MAX(%s)", name),
+			fmt.Sprintf("avg = AVG(%s)", name))
+	}
+	return fmt.Sprintf(`FROM %s
+| %s
+| STATS %s`, indexPattern, facetWhere(filters), strings.Join(stats, ", "))
+}
+
+func buildFacetValuesQuery(indexPattern string, filters esqlFilters, field string) string {
+	name := shared.ESQLFieldName(field)
+	return fmt.Sprintf(`FROM %s
+| %s
+| STATS count = COUNT(*) BY value = TO_STRING(%s)
+| SORT count DESC, value
+| LIMIT %d`, indexPattern, facetWhere(filters, name+" IS NOT NULL"), name, facetSize)
+}
+
+func parseFacetSummary(res *ESQLResult, facets *FieldFacets) {
+	if len(res.Values) == 0 {
+		return
+	}
+	row := res.Values[0]
+	getFloat := func(name string) (float64, bool) {
+		for i, col := range res.Columns {
+			if col.Name == name && i < len(row) {
+				v, ok := row[i].(float64)
+				return v, ok
+			}
+		}
+		return 0, false
+	}
+
+	total, _ := getFloat("total")
+	present, _ := getFloat("present")
+	facets.Total, facets.Present = int64(total), int64(present)
+
+	minV, okMin := getFloat("min")
+	maxV, okMax := getFloat("max")
+	avgV, okAvg := getFloat("avg")
+	if okMin && okMax && okAvg {
+		facets.Numeric = &NumericSummary{Min: minV, Max: maxV, Avg: avgV}
+	}
+}
+
+func parseFacetValues(res *ESQLResult) []FacetValue {
+	colIndex := map[string]int{}
+	for i, col := range res.Columns {
+		colIndex[col.Name] = i
+	}
+	countIdx, okCount := colIndex["count"]
+	valueIdx, okValue := colIndex["value"]
+	if !okCount || !okValue {
+		return nil
+	}
+
+	out := make([]FacetValue, 0, len(res.Values))
+	for _, row := range res.Values {
+		if countIdx >= len(row) || valueIdx >= len(row) {
+			continue
+		}
+		value, ok := row[valueIdx].(string)
+		if !ok {
+			continue
+		}
+		count, _ := row[countIdx].(float64)
+		out = append(out, FacetValue{Value: value, Count: int64(count)})
+	}
+	return out
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package es
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildFacetQueries(t *testing.T) {
+	t.Parallel()
+
+	filters := buildCommonFilters(commonFilterOptions{
+		indexPattern: "logs-*",
+		service:      "api",
+	})
+
+	t.Run("summary", func(t *testing.T) {
+		t.Parallel()
+
+		query := buildFacetSummaryQuery("logs-*", filters, "http.status", true)
+		for _, want := range []string{
+			"FROM logs-*",
+			`service.name == "api"`,
+			"total = COUNT(*)",
+			"present = COUNT(CASE(`http.status` IS NOT NULL, 1, null))",
+			"min = MIN(`http.status`)",
+			"avg = AVG(`http.status`)",
+		} {
+			if !strings.Contains(query, want) {
+				t.Errorf("expected query to contain %q, got:\n%s", want, query)
+			}
+		}
+		if strings.Contains(buildFacetSummaryQuery("logs-*", filters, "host.name", false), "MIN(") {
+			t.Error("expected no numeric summary for non-numeric fields")
+		}
+	})
+
+	t.Run("values", func(t *testing.T) {
+		t.Parallel()
+
+		query := buildFacetValuesQuery("logs-*", filters, "host.name")
+		for _, want := range []string{
+			"`host.name` IS NOT NULL",
+			"STATS count = COUNT(*) BY value = TO_STRING(`host.name`)",
+			"SORT count DESC, value",
+			"LIMIT 10",
+		} {
+			if !strings.Contains(query, want) {
+				t.Errorf("expected query to contain %q, got:\n%s", want, query)
+			}
+		}
+	})
+}
+
+func TestParseFieldFacets(t *testing.T) {
+	t.Parallel()
+
+	facets := &FieldFacets{Field: "http.status"}
+	parseFacetSummary(&ESQLResult{
+		Columns: []ESQLColumn{{Name: "total"}, {Name: "present"}, {Name: "min"}, {Name: "max"}, {Name: "avg"}},
+		Values:  [][]interface{}{{float64(200), float64(150), float64(200), float64(503), float64(260.5)}},
+	}, facets)
+	if facets.Total != 200 || facets.Present != 150 {
+		t.Errorf("unexpected counts: %+v", facets)
+	}
+	if facets.Numeric == nil || facets.Numeric.Max != 503 || facets.Numeric.Avg != 260.5 {
+		t.Errorf("unexpected numeric summary: %+v", facets.Numeric)
+	}
+
+	values := parseFacetValues(&ESQLResult{
+		Columns: []ESQLColumn{{Name: "count"}, {Name: "value"}},
+		Values: [][]interface{}{
+			{float64(100), "200"},
+			{float64(50), "503"},
+			{float64(3), nil},
+		},
+	})
+	if len(values) != 2 || values[0].Value != "200" || values[1].Count != 50 {
+		t.Fatalf("unexpected values: %+v", values)
+	}
+	if got := facets.Percent(values[0]); got != 50 {
+		t.Errorf("expected 50%%, got %v", got)
+	}
+}
+
+func TestParseFieldFacets_NoValues(t *testing.T) {
+	t.Parallel()
+
+	facets := &FieldFacets{Field: "http.status"}
+	parseFacetSummary(&ESQLResult{
+		Columns: []ESQLColumn{{Name: "total"}, {Name: "present"}, {Name: "min"}, {Name: "max"}, {Name: "avg"}},
+		Values:  [][]interface{}{{float64(20), float64(0), nil, nil, nil}},
+	}, facets)
+	if facets.Numeric != nil {
+		t.Errorf("expected no numeric summary without values, got %+v", facets.Numeric)
+	}
+	if facets.Percent(FacetValue{Count: 5}) != 25 {
+		t.Errorf("unexpected percent")
+	}
+}
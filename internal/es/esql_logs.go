@@ -10,9 +10,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/elastic/elasticat/internal/es/patterns"
 	"github.com/elastic/elasticat/internal/es/shared"
 )
 
@@ -25,6 +27,9 @@ func (c *Client) TailESQL(ctx context.Context, opts TailOptions) (*SearchResult,
 	filters := buildCommonFilters(commonFilterOptions{
 		indexPattern:    c.index,
 		lookback:        opts.Lookback,
+		from:            opts.From,
+		to:              opts.To,
+		after:           opts.After,
 		service:         opts.Service,
 		negateService:   opts.NegateService,
 		resource:        opts.Resource,
@@ -34,7 +39,11 @@ func (c *Client) TailESQL(ctx context.Context, opts TailOptions) (*SearchResult,
 		processorEvent:  opts.ProcessorEvent,
 		transactionName: opts.TransactionName,
 		traceID:         opts.TraceID,
+		spanID:          opts.SpanID,
 		metricField:     opts.MetricField,
+		pattern:         opts.Pattern,
+		exception:       opts.Exception,
+		fieldFilters:    opts.FieldFilters,
 	})
 
 	query := buildESQLDocsQuery(c.index, filters, opts.Size, opts.SortAsc)
@@ -57,6 +66,7 @@ func (c *Client) SearchESQL(ctx context.Context, queryStr string, opts SearchOpt
 		lookback:        opts.Lookback,
 		from:            opts.From,
 		to:              opts.To,
+		after:           opts.After,
 		service:         opts.Service,
 		negateService:   opts.NegateService,
 		resource:        opts.Resource,
@@ -65,6 +75,10 @@ func (c *Client) SearchESQL(ctx context.Context, queryStr string, opts SearchOpt
 		processorEvent:  opts.ProcessorEvent,
 		transactionName: opts.TransactionName,
 		traceID:         opts.TraceID,
+		spanID:          opts.SpanID,
+		pattern:         opts.Pattern,
+		exception:       opts.Exception,
+		fieldFilters:    opts.FieldFilters,
 		searchClause:    searchClause,
 	})
 
@@ -92,12 +106,134 @@ func (c *Client) CountESQL(ctx context.Context, opts TailOptions) (int64, string
 		level:          opts.Level,
 		processorEvent: opts.ProcessorEvent,
 		traceID:        opts.TraceID,
+		spanID:         opts.SpanID,
+		pattern:        opts.Pattern,
+		exception:      opts.Exception,
+		fieldFilters:   opts.FieldFilters,
+	})
+
+	count, err := c.executeESQLCount(ctx, filters.countQuery)
+	return count, filters.countQuery, err
+}
+
+// CountSearchESQL counts the documents of an index pattern, which need not be
+// the client's, that match a search and the SearchOptions filters.
+// The TUI uses it to count new documents for tabs that are not visible.
+func (c *Client) CountSearchESQL(ctx context.Context, index, queryStr string, opts SearchOptions) (int64, string, error) {
+	filters := buildCommonFilters(commonFilterOptions{
+		indexPattern:    index,
+		lookback:        opts.Lookback,
+		from:            opts.From,
+		to:              opts.To,
+		after:           opts.After,
+		service:         opts.Service,
+		negateService:   opts.NegateService,
+		resource:        opts.Resource,
+		negateResource:  opts.NegateResource,
+		level:           opts.Level,
+		processorEvent:  opts.ProcessorEvent,
+		transactionName: opts.TransactionName,
+		traceID:         opts.TraceID,
+		spanID:          opts.SpanID,
+		pattern:         opts.Pattern,
+		exception:       opts.Exception,
+		fieldFilters:    opts.FieldFilters,
+		searchClause:    buildSearchClause(queryStr, opts.SearchFields),
 	})
 
 	count, err := c.executeESQLCount(ctx, filters.countQuery)
 	return count, filters.countQuery, err
 }
 
+// SurroundingOptions selects the documents around an anchor document, like
+// grep -C.
+type SurroundingOptions struct {
+	AnchorID    string    // _id of the anchor document
+	At          time.Time // Timestamp of the anchor document
+	Size        int       // Documents wanted on each side of the anchor
+	Service     string    // Only documents of this service, when set
+	ContainerID string    // Only documents of this container, when set
+}
+
+// SurroundingESQL fetches up to Size documents before and after an anchor
+// document, oldest first, with the anchor between them. Only the service and
+// container filters apply. Returns the two ES|QL queries for display.
+func (c *Client) SurroundingESQL(ctx context.Context, opts SurroundingOptions) ([]LogEntry, string, error) {
+	beforeQuery, afterQuery := buildSurroundingQueries(c.index, opts)
+	queries := beforeQuery + "\n\n" + afterQuery
+
+	before, _, err := c.executeESQLDocs(ctx, beforeQuery, "")
+	if err != nil {
+		return nil, queries, err
+	}
+	after, _, err := c.executeESQLDocs(ctx, afterQuery, "")
+	if err != nil {
+		return nil, queries, err
+	}
+	return mergeSurrounding(before.Logs, after.Logs, opts.AnchorID, opts.Size), queries, nil
+}
+
+// buildSurroundingQueries builds the queries of the documents up to the
+// anchor, newest first, and from it, oldest first. Documents sort by
+// @timestamp, then _id, so however many share the anchor's timestamp, each
+// side holds the anchor and its neighbours in that order.
+func buildSurroundingQueries(indexPattern string, opts SurroundingOptions) (before, after string) {
+	filters := buildCommonFilters(commonFilterOptions{
+		indexPattern: indexPattern,
+		service:      opts.Service,
+		containerID:  opts.ContainerID,
+	})
+	at := fmt.Sprintf("TIMESTAMP(\"%s\")", opts.At.UTC().Format(time.RFC3339Nano))
+	side := func(op, order string) string {
+		f := filters
+		f.whereParts = append(slices.Clone(filters.whereParts), fmt.Sprintf("(@timestamp %s %s OR (@timestamp == %s AND _id %s= \"%s\"))",
+			op, at, at, op, escapeESQLString(opts.AnchorID)))
+		f.order = order
+		return buildESQLDocsQuery(indexPattern, f, opts.Size+1, false) // +1: the anchor is on both sides
+	}
+	return side("<", "@timestamp DESC, _id DESC"), side(">", "@timestamp ASC, _id ASC")
+}
+
+// mergeSurrounding joins the documents before the anchor (newest first) and
+// after it (oldest first) into one list, oldest first and then by _id like
+// the queries, keeping size documents on each side of the anchor.
+func mergeSurrounding(before, after []LogEntry, anchorID string, size int) []LogEntry {
+	merged := make([]LogEntry, 0, len(before)+len(after))
+	seen := make(map[string]bool)
+	for _, e := range slices.Concat(before, after) {
+		if key := tailKey(e); !seen[key] {
+			seen[key] = true
+			merged = append(merged, e)
+		}
+	}
+	slices.SortStableFunc(merged, func(a, b LogEntry) int {
+		if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	i := slices.IndexFunc(merged, func(e LogEntry) bool { return e.ID == anchorID })
+	if i < 0 {
+		return merged
+	}
+	return merged[max(i-size, 0):min(i+size+1, len(merged))]
+}
+
+// DocumentsByID fetches the documents of an index pattern, which need not be
+// the client's, with the given IDs. Documents that no longer exist are
+// missing from the result.
+func (c *Client) DocumentsByID(ctx context.Context, index string, ids []string) ([]LogEntry, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	result, _, err := c.executeESQLDocs(ctx, buildESQLDocumentsByIDQuery(index, ids), "")
+	if err != nil {
+		return nil, err
+	}
+	return result.Logs, nil
+}
+
 // --- internal helpers ---
 
 type commonFilterOptions struct {
@@ -105,6 +241,7 @@ type commonFilterOptions struct {
 	lookback        string
 	from            time.Time
 	to              time.Time
+	after           *TailPosition
 	service         string
 	negateService   bool
 	resource        string
@@ -114,13 +251,18 @@ type commonFilterOptions struct {
 	processorEvent  string
 	transactionName string
 	traceID         string
+	spanID          string
 	metricField     string
+	pattern         string
+	exception       ExceptionFilter
+	fieldFilters    []shared.FieldFilter
 	searchClause    string
 }
 
 type esqlFilters struct {
 	whereParts []string
 	countQuery string
+	order      string // SORT overriding the @timestamp order, e.g. "@timestamp ASC, _id ASC" to page after a TailPosition
 }
 
 func buildCommonFilters(opts commonFilterOptions) esqlFilters {
@@ -131,10 +273,19 @@ func buildCommonFilters(opts commonFilterOptions) esqlFilters {
 		whereParts = append(whereParts, fmt.Sprintf("@timestamp >= NOW() - %s", shared.LookbackToESQLInterval(opts.lookback)))
 	}
 	if !opts.from.IsZero() {
-		whereParts = append(whereParts, fmt.Sprintf("@timestamp >= TIMESTAMP(\"%s\")", opts.from.Format(time.RFC3339)))
+		whereParts = append(whereParts, fmt.Sprintf("@timestamp >= TIMESTAMP(\"%s\")", opts.from.Format(time.RFC3339Nano)))
 	}
 	if !opts.to.IsZero() {
-		whereParts = append(whereParts, fmt.Sprintf("@timestamp <= TIMESTAMP(\"%s\")", opts.to.Format(time.RFC3339)))
+		whereParts = append(whereParts, fmt.Sprintf("@timestamp <= TIMESTAMP(\"%s\")", opts.to.Format(time.RFC3339Nano)))
+	}
+	if opts.after != nil {
+		ts := opts.after.Timestamp.Format(time.RFC3339Nano)
+		if opts.after.ID == "" {
+			whereParts = append(whereParts, fmt.Sprintf("@timestamp >= TIMESTAMP(\"%s\")", ts))
+		} else {
+			whereParts = append(whereParts, fmt.Sprintf("(@timestamp > TIMESTAMP(\"%s\") OR (@timestamp == TIMESTAMP(\"%s\") AND _id > \"%s\"))",
+				ts, ts, escapeESQLString(opts.after.ID)))
+		}
 	}
 
 	// Service filter
@@ -182,21 +333,52 @@ func buildCommonFilters(opts commonFilterOptions) esqlFilters {
 		whereParts = append(whereParts, fmt.Sprintf("(trace.id == \"%s\" OR trace_id == \"%s\")", escapeESQLString(opts.traceID), escapeESQLString(opts.traceID)))
 	}
 
+	// Span ID filter
+	if opts.spanID != "" {
+		whereParts = append(whereParts, fmt.Sprintf("(span.id == \"%s\" OR span_id == \"%s\")", escapeESQLString(opts.spanID), escapeESQLString(opts.spanID)))
+	}
+
 	// Metric field filter (for metric detail view - filter docs containing this metric)
 	if opts.metricField != "" {
 		// Use backticks to escape the field name which may contain dots
 		whereParts = append(whereParts, fmt.Sprintf("`%s` IS NOT NULL", opts.metricField))
 	}
 
+	// Message pattern filter (drill-down from the log patterns view)
+	if opts.pattern != "" {
+		if clause := patterns.LikeClause(opts.pattern); clause != "" {
+			whereParts = append(whereParts, clause)
+		}
+	}
+
+	// Exception group filter (drill-down from the errors view)
+	if !opts.exception.IsZero() {
+		whereParts = append(whereParts, opts.exception.ESQL())
+	}
+
+	// Arbitrary field predicates (filter-by-value from the detail view)
+	for _, f := range opts.fieldFilters {
+		if f.Field != "" {
+			whereParts = append(whereParts, f.ESQL())
+		}
+	}
+
 	// Search clause from query string
 	if opts.searchClause != "" {
 		whereParts = append(whereParts, opts.searchClause)
 	}
 
+	// Paging after a position: ties on @timestamp are ordered by _id
+	var order string
+	if opts.after != nil {
+		order = "@timestamp ASC, _id ASC"
+	}
+
 	countQuery := buildCountQuery(opts.indexPattern, whereParts)
 	return esqlFilters{
 		whereParts: whereParts,
 		countQuery: countQuery,
+		order:      order,
 	}
 }
 
@@ -211,9 +393,12 @@ func buildCountQuery(indexPattern string, whereParts []string) string {
 }
 
 func buildESQLDocsQuery(indexPattern string, filters esqlFilters, size int, sortAsc bool) string {
-	order := "DESC"
-	if sortAsc {
-		order = "ASC"
+	order := "@timestamp DESC"
+	switch {
+	case filters.order != "":
+		order = filters.order
+	case sortAsc:
+		order = "@timestamp ASC"
 	}
 	if size == 0 {
 		size = 100
@@ -224,100 +409,120 @@ func buildESQLDocsQuery(indexPattern string, filters esqlFilters, size int, sort
 		where = "WHERE " + strings.Join(filters.whereParts, " AND ")
 	}
 
-	return fmt.Sprintf(`FROM %s
+	// _id identifies documents for live tail and bookmarks
+	return fmt.Sprintf(`FROM %s METADATA _id
 | %s
-| SORT @timestamp %s
+| SORT %s
 | LIMIT %d
 | KEEP *`, indexPattern, where, order, size)
 }
 
+// buildESQLDocumentsByIDQuery builds the query fetching the documents with
+// the given IDs.
+func buildESQLDocumentsByIDQuery(indexPattern string, ids []string) string {
+	quoted := make([]string, len(ids))
+	for i, id := range ids {
+		quoted[i] = `"` + escapeESQLString(id) + `"`
+	}
+	return fmt.Sprintf(`FROM %s METADATA _id
+| WHERE _id IN (%s)
+| LIMIT %d
+| KEEP *`, indexPattern, strings.Join(quoted, ", "), len(ids))
+}
+
 func (c *Client) executeESQLDocs(ctx context.Context, query string, countQuery string) (*SearchResult, int64, error) {
-	// ES|QL returns a 400 verification_exception when the FROM pattern matches no indices.
-	// Treat that condition as an empty state; if multiple patterns are used, drop the missing
-	// pattern and retry.
-	currentQuery := query
-	currentCountQuery := countQuery
-	for {
-		dataRes, err := c.ExecuteESQLQuery(ctx, currentQuery)
-		if err != nil {
-			// Unknown index: try to remove that pattern and retry with remaining indices
-			if missing, ok := shared.IsESQLUnknownIndex(err); ok {
-				from, ok := esqlExtractFromPattern(currentQuery)
-				if !ok {
-					// Can't safely rewrite; default to empty state.
-					return &SearchResult{Logs: []LogEntry{}, Total: 0}, 0, nil
-				}
-				newFrom := removeIndexPattern(from, missing)
-				if newFrom == "" {
-					return &SearchResult{Logs: []LogEntry{}, Total: 0}, 0, nil
-				}
-				currentQuery = esqlRewriteFromPattern(currentQuery, newFrom)
-				if currentCountQuery != "" {
-					currentCountQuery = esqlRewriteFromPattern(currentCountQuery, newFrom)
-				}
-				continue
-			}
-			// Other empty-state errors (e.g., unsupported field types): return empty
-			if shared.IsESQLEmptyStateError(err) {
-				return &SearchResult{Logs: []LogEntry{}, Total: 0}, 0, nil
-			}
-			return nil, 0, err
+	dataRes, query, err := c.executeESQLDroppingUnknownIndices(ctx, query)
+	if err != nil {
+		// Other empty-state errors (e.g., unsupported field types): return empty
+		if shared.IsESQLEmptyStateError(err) {
+			return &SearchResult{Logs: []LogEntry{}, Total: 0}, 0, nil
 		}
+		return nil, 0, err
+	}
+	if dataRes == nil {
+		return &SearchResult{Logs: []LogEntry{}, Total: 0}, 0, nil
+	}
 
-		entries := make([]LogEntry, 0, len(dataRes.Values))
-		for _, row := range dataRes.Values {
-			rowMap := esqlRowToMap(dataRes.Columns, row)
-			normalizeESQLCompatibility(rowMap)
-			entry := extractLogEntry(rowMap)
-			if raw, err := json.Marshal(rowMap); err == nil {
-				entry.RawJSON = string(raw)
-			}
-			entries = append(entries, entry)
-		}
+	entries := make([]LogEntry, 0, len(dataRes.Values))
+	for _, row := range dataRes.Values {
+		entries = append(entries, esqlRowToLogEntry(dataRes.Columns, row))
+	}
 
-		total := int64(len(entries))
-		if currentCountQuery != "" {
-			if count, err := c.executeESQLCount(ctx, currentCountQuery); err == nil {
-				total = count
-			}
+	total := int64(len(entries))
+	if countQuery != "" {
+		// Count over the index patterns the documents came from
+		if from, ok := esqlExtractFromPattern(query); ok {
+			countQuery = esqlRewriteFromPattern(countQuery, from)
+		}
+		if count, err := c.executeESQLCount(ctx, countQuery); err == nil {
+			total = count
 		}
-
-		return &SearchResult{Logs: entries, Total: total}, total, nil
 	}
+
+	return &SearchResult{Logs: entries, Total: total}, total, nil
 }
 
 func (c *Client) executeESQLCount(ctx context.Context, query string) (int64, error) {
-	currentQuery := query
-	for {
-		res, err := c.ExecuteESQLQuery(ctx, currentQuery)
-		if err != nil {
-			// Unknown index: try to remove that pattern and retry with remaining indices
-			if missing, ok := shared.IsESQLUnknownIndex(err); ok {
-				from, ok := esqlExtractFromPattern(currentQuery)
-				if !ok {
-					return 0, nil
-				}
-				newFrom := removeIndexPattern(from, missing)
-				if newFrom == "" {
-					return 0, nil
-				}
-				currentQuery = esqlRewriteFromPattern(currentQuery, newFrom)
-				continue
-			}
-			// Other empty-state errors (e.g., unsupported field types): return 0 count
-			if shared.IsESQLEmptyStateError(err) {
-				return 0, nil
-			}
-			return 0, err
-		}
-		if len(res.Values) == 0 || len(res.Values[0]) == 0 {
+	res, _, err := c.executeESQLDroppingUnknownIndices(ctx, query)
+	if err != nil {
+		// Other empty-state errors (e.g., unsupported field types): return 0 count
+		if shared.IsESQLEmptyStateError(err) {
 			return 0, nil
 		}
-		if v, ok := res.Values[0][0].(float64); ok {
-			return int64(v), nil
+		return 0, err
+	}
+	if res == nil || len(res.Values) == 0 || len(res.Values[0]) == 0 {
+		return 0, nil
+	}
+	if v, ok := res.Values[0][0].(float64); ok {
+		return int64(v), nil
+	}
+	return 0, fmt.Errorf("unexpected ES|QL count result shape")
+}
+
+// executeESQLDroppingUnknownIndices runs query, leaving out the patterns of
+// its FROM command that match no index. A query whose FROM can't be rewritten
+// gives a nil result when its index is unknown.
+func (c *Client) executeESQLDroppingUnknownIndices(ctx context.Context, query string) (*ESQLResult, string, error) {
+	from, _ := esqlExtractFromPattern(query)
+	return shared.ExecuteESQLDroppingUnknownIndices(ctx, c, from, func(f string) string {
+		if f == from {
+			return query
 		}
-		return 0, fmt.Errorf("unexpected ES|QL count result shape")
+		return esqlRewriteFromPattern(query, f)
+	})
+}
+
+func esqlRowToLogEntry(columns []ESQLColumn, row []interface{}) LogEntry {
+	rowMap := esqlRowToMap(columns, row)
+	normalizeESQLCompatibility(rowMap)
+	entry := extractLogEntry(rowMap)
+	if raw, err := json.Marshal(rowMap); err == nil {
+		entry.RawJSON = string(raw)
 	}
+	return entry
+}
+
+// ESQLDocument converts a row of an arbitrary ES|QL result into a LogEntry.
+// ok is false unless the row carries both @timestamp and _id (requested with
+// METADATA _id), so aggregated rows aren't mistaken for documents.
+func ESQLDocument(columns []ESQLColumn, row []interface{}) (entry LogEntry, ok bool) {
+	var hasTimestamp, hasID bool
+	for i, col := range columns {
+		if i >= len(row) || row[i] == nil {
+			continue
+		}
+		switch col.Name {
+		case "@timestamp":
+			hasTimestamp = true
+		case "_id":
+			hasID = true
+		}
+	}
+	if !hasTimestamp || !hasID {
+		return LogEntry{}, false
+	}
+	return esqlRowToLogEntry(columns, row), true
 }
 
 func esqlRowToMap(columns []ESQLColumn, row []interface{}) map[string]interface{} {
@@ -345,6 +550,11 @@ func normalizeESQLCompatibility(m map[string]interface{}) {
 			m["span_id"] = v
 		}
 	}
+	if v, ok := GetNestedParts(m, "parent", "id"); ok {
+		if _, exists := m["parent_span_id"]; !exists {
+			m["parent_span_id"] = v
+		}
+	}
 	if v, ok := GetNestedParts(m, "transaction", "name"); ok {
 		if _, exists := m["name"]; !exists {
 			m["name"] = v
@@ -376,9 +586,10 @@ func esqlExtractFromPattern(query string) (string, bool) {
 	}
 	rest := strings.TrimPrefix(q, "FROM ")
 	if i := strings.IndexByte(rest, '\n'); i >= 0 {
-		return strings.TrimSpace(rest[:i]), true
+		rest = rest[:i]
 	}
-	return strings.TrimSpace(rest), true
+	pattern, _ := cutFromMetadata(rest)
+	return pattern, true
 }
 
 func esqlRewriteFromPattern(query string, newFrom string) string {
@@ -387,23 +598,21 @@ func esqlRewriteFromPattern(query string, newFrom string) string {
 		return query
 	}
 	rest := strings.TrimPrefix(q, "FROM ")
-	if i := strings.IndexByte(rest, '\n'); i >= 0 {
-		return "FROM " + newFrom + "\n" + rest[i+1:]
+	first, tail, multiline := strings.Cut(rest, "\n")
+	_, metadata := cutFromMetadata(first)
+	if multiline {
+		return "FROM " + newFrom + metadata + "\n" + tail
 	}
-	return "FROM " + newFrom
+	return "FROM " + newFrom + metadata
 }
 
-func removeIndexPattern(from string, missing string) string {
-	parts := strings.Split(from, ",")
-	out := make([]string, 0, len(parts))
-	for _, p := range parts {
-		p = strings.TrimSpace(p)
-		if p == "" || p == missing {
-			continue
-		}
-		out = append(out, p)
+// cutFromMetadata splits the rest of a FROM line into the index pattern and
+// its METADATA clause, which keeps its leading space.
+func cutFromMetadata(rest string) (pattern, metadata string) {
+	if i := strings.Index(rest, " METADATA "); i >= 0 {
+		return strings.TrimSpace(rest[:i]), " " + strings.TrimSpace(rest[i:])
 	}
-	return strings.Join(out, ",")
+	return strings.TrimSpace(rest), ""
 }
 
 // Note: getNestedMapValue has been replaced by GetNestedParts in json_helpers.go
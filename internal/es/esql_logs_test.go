@@ -4,9 +4,13 @@
 package es
 
 import (
+	"fmt"
+	"slices"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/elastic/elasticat/internal/es/shared"
 )
 
 func TestBuildCommonFilters(t *testing.T) {
@@ -287,6 +291,65 @@ func TestBuildCommonFilters(t *testing.T) {
 			t.Errorf("expected escaped quotes in filter, got %q", filters.whereParts[0])
 		}
 	})
+
+	t.Run("trace and span filter", func(t *testing.T) {
+		t.Parallel()
+
+		filters := buildCommonFilters(commonFilterOptions{
+			indexPattern: "logs-*",
+			traceID:      "abc",
+			spanID:       "def",
+		})
+
+		if len(filters.whereParts) != 2 {
+			t.Fatalf("expected 2 where parts, got %d", len(filters.whereParts))
+		}
+		if filters.whereParts[1] != `(span.id == "def" OR span_id == "def")` {
+			t.Errorf("unexpected span filter: %q", filters.whereParts[1])
+		}
+	})
+
+	t.Run("pattern filter", func(t *testing.T) {
+		t.Parallel()
+
+		filters := buildCommonFilters(commonFilterOptions{
+			indexPattern: "logs-*",
+			pattern:      ".*?Connection.+?refused.*?",
+		})
+
+		if len(filters.whereParts) != 1 {
+			t.Fatalf("expected 1 where part, got %d", len(filters.whereParts))
+		}
+		if !strings.HasSuffix(filters.whereParts[0], `LIKE "*Connection*refused*"`) {
+			t.Errorf("expected LIKE pattern filter, got %q", filters.whereParts[0])
+		}
+	})
+
+	t.Run("field filters in order", func(t *testing.T) {
+		t.Parallel()
+
+		filters := buildCommonFilters(commonFilterOptions{
+			indexPattern: "logs-*",
+			fieldFilters: []FieldFilter{
+				{Field: "http.response.status_code", Op: shared.FieldEquals, Value: "500"},
+				{Field: "k8s.pod.name", Op: shared.FieldExists, Negate: true},
+			},
+			searchClause: "body.text LIKE \"*x*\"",
+		})
+
+		if len(filters.whereParts) != 3 {
+			t.Fatalf("expected 3 where parts, got %d", len(filters.whereParts))
+		}
+		if filters.whereParts[0] != "(TO_STRING(`http.response.status_code`) == \"500\")" {
+			t.Errorf("unexpected equals filter: %q", filters.whereParts[0])
+		}
+		if filters.whereParts[1] != "`k8s.pod.name` IS NULL" {
+			t.Errorf("unexpected missing filter: %q", filters.whereParts[1])
+		}
+		if !strings.Contains(filters.countQuery, "`k8s.pod.name` IS NULL") {
+			t.Errorf("expected field filters in the count query, got %q", filters.countQuery)
+		}
+	})
 }
 
 func TestBuildCountQuery(t *testing.T) {
@@ -362,6 +425,22 @@ func TestBuildESQLDocsQuery(t *testing.T) {
 		}
 	})
 
+	t.Run("pages after a tail position", func(t *testing.T) {
+		t.Parallel()
+
+		after := TailPosition{Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 123456000, time.UTC), ID: "doc-7"}
+		filters := buildCommonFilters(commonFilterOptions{indexPattern: "logs-*", after: &after})
+		query := buildESQLDocsQuery("logs-*", filters, 500, true)
+
+		want := `(@timestamp > TIMESTAMP("2026-01-02T03:04:05.123456Z") OR (@timestamp == TIMESTAMP("2026-01-02T03:04:05.123456Z") AND _id > "doc-7"))`
+		if !strings.Contains(query, want) {
+			t.Errorf("expected the keyset condition %s in:\n%s", want, query)
+		}
+		if !strings.Contains(query, "SORT @timestamp ASC, _id ASC") {
+			t.Errorf("expected ties sorted by _id:\n%s", query)
+		}
+	})
+
 	t.Run("with where parts", func(t *testing.T) {
 		t.Parallel()
 
@@ -707,6 +786,15 @@ func TestEsqlExtractFromPattern(t *testing.T) {
 		}
 	})
 
+	t.Run("leaves out METADATA", func(t *testing.T) {
+		t.Parallel()
+
+		pattern, ok := esqlExtractFromPattern("FROM logs-*,traces-* METADATA _id\n| WHERE true")
+		if !ok || pattern != "logs-*,traces-*" {
+			t.Errorf("expected 'logs-*,traces-*', got %q (ok=%v)", pattern, ok)
+		}
+	})
+
 	t.Run("returns false for non-FROM query", func(t *testing.T) {
 		t.Parallel()
 
@@ -785,71 +873,130 @@ func TestEsqlRewriteFromPattern(t *testing.T) {
 			t.Errorf("expected 'FROM new-*', got %q", result)
 		}
 	})
-}
-
-func TestRemoveIndexPattern(t *testing.T) {
-	t.Parallel()
 
-	t.Run("removes single pattern", func(t *testing.T) {
+	t.Run("keeps METADATA", func(t *testing.T) {
 		t.Parallel()
 
-		result := removeIndexPattern("logs-*,traces-*,metrics-*", "traces-*")
-		if result != "logs-*,metrics-*" {
-			t.Errorf("expected 'logs-*,metrics-*', got %q", result)
-		}
-	})
-
-	t.Run("removes first pattern", func(t *testing.T) {
-		t.Parallel()
-
-		result := removeIndexPattern("logs-*,traces-*", "logs-*")
-		if result != "traces-*" {
-			t.Errorf("expected 'traces-*', got %q", result)
-		}
-	})
-
-	t.Run("removes last pattern", func(t *testing.T) {
-		t.Parallel()
-
-		result := removeIndexPattern("logs-*,traces-*", "traces-*")
-		if result != "logs-*" {
-			t.Errorf("expected 'logs-*', got %q", result)
+		result := esqlRewriteFromPattern("FROM logs-*,traces-* METADATA _id\n| WHERE true", "logs-*")
+		if result != "FROM logs-* METADATA _id\n| WHERE true" {
+			t.Errorf("unexpected rewrite: %q", result)
 		}
 	})
+}
 
-	t.Run("returns empty when all removed", func(t *testing.T) {
-		t.Parallel()
+func TestBuildESQLDocumentsByIDQuery(t *testing.T) {
+	t.Parallel()
 
-		result := removeIndexPattern("logs-*", "logs-*")
-		if result != "" {
-			t.Errorf("expected empty string, got %q", result)
-		}
-	})
+	query := buildESQLDocumentsByIDQuery("logs-*", []string{"a1", `b"2`})
+	if !strings.HasPrefix(query, "FROM logs-* METADATA _id\n") {
+		t.Errorf("expected _id requested on the FROM line, got %q", query)
+	}
+	if !strings.Contains(query, `WHERE _id IN ("a1", "b\"2")`) {
+		t.Errorf("expected the quoted IDs, got %q", query)
+	}
+	if !strings.Contains(query, "LIMIT 2") {
+		t.Errorf("expected a limit of one per ID, got %q", query)
+	}
+}
 
-	t.Run("handles whitespace", func(t *testing.T) {
-		t.Parallel()
+func TestESQLDocument(t *testing.T) {
+	t.Parallel()
 
-		result := removeIndexPattern("logs-* , traces-* , metrics-*", "traces-*")
-		if result != "logs-*,metrics-*" {
-			t.Errorf("expected 'logs-*,metrics-*', got %q", result)
-		}
-	})
+	columns := []ESQLColumn{{Name: "@timestamp"}, {Name: "_id"}, {Name: "service.name"}, {Name: "message"}}
+	entry, ok := ESQLDocument(columns, []interface{}{"2026-01-02T03:04:05.000Z", "abc", "api", "hello"})
+	if !ok {
+		t.Fatal("expected a row with @timestamp and _id to be a document")
+	}
+	if entry.ServiceName != "api" || entry.GetMessage() != "hello" {
+		t.Errorf("unexpected entry: %+v", entry)
+	}
+	if !strings.Contains(entry.RawJSON, `"_id":"abc"`) {
+		t.Errorf("expected _id in raw JSON, got %s", entry.RawJSON)
+	}
+
+	if _, ok := ESQLDocument(columns, []interface{}{"2026-01-02T03:04:05.000Z", nil, "api", "hello"}); ok {
+		t.Error("expected a row without _id not to be a document")
+	}
+	if _, ok := ESQLDocument([]ESQLColumn{{Name: "count"}, {Name: "service.name"}}, []interface{}{float64(3), "api"}); ok {
+		t.Error("expected an aggregated row not to be a document")
+	}
+}
 
-	t.Run("handles empty parts", func(t *testing.T) {
-		t.Parallel()
+func TestSurroundingSameTimestamp(t *testing.T) {
+	t.Parallel()
 
-		result := removeIndexPattern("logs-*,,traces-*", "")
-		if result != "logs-*,traces-*" {
-			t.Errorf("expected 'logs-*,traces-*', got %q", result)
-		}
-	})
+	// Far more documents share the anchor's millisecond than fit on a side
+	at := time.Date(2026, 5, 1, 12, 0, 0, 123000000, time.UTC)
+	var docs []LogEntry
+	for i := range 50 {
+		docs = append(docs, LogEntry{ID: fmt.Sprintf("doc-%02d", i), Timestamp: at})
+	}
+	docs = append(docs, LogEntry{ID: "earlier", Timestamp: at.Add(-time.Millisecond)}, LogEntry{ID: "later", Timestamp: at.Add(time.Millisecond)})
+	opts := SurroundingOptions{AnchorID: "doc-25", At: at, Size: 3}
+
+	beforeQuery, afterQuery := buildSurroundingQueries("logs-*", opts)
+	ts := `TIMESTAMP("2026-05-01T12:00:00.123Z")`
+	for query, want := range map[string][]string{
+		beforeQuery: {`(@timestamp < ` + ts + ` OR (@timestamp == ` + ts + ` AND _id <= "doc-25"))`, "SORT @timestamp DESC, _id DESC", "LIMIT 4"},
+		afterQuery:  {`(@timestamp > ` + ts + ` OR (@timestamp == ` + ts + ` AND _id >= "doc-25"))`, "SORT @timestamp ASC, _id ASC", "LIMIT 4"},
+	} {
+		for _, w := range want {
+			if !strings.Contains(query, w) {
+				t.Errorf("expected %s in:\n%s", w, query)
+			}
+		}
+	}
+
+	// What ES returns for each side: the documents on it, in its order, limited
+	key := func(e LogEntry) int {
+		if c := e.Timestamp.Compare(at); c != 0 {
+			return c
+		}
+		return strings.Compare(e.ID, opts.AnchorID)
+	}
+	side := func(keep func(int) bool, dir int) []LogEntry {
+		var out []LogEntry
+		for _, e := range docs {
+			if keep(key(e)) {
+				out = append(out, e)
+			}
+		}
+		slices.SortFunc(out, func(a, b LogEntry) int {
+			if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+				return dir * c
+			}
+			return dir * strings.Compare(a.ID, b.ID)
+		})
+		return out[:opts.Size+1]
+	}
+	before := side(func(k int) bool { return k <= 0 }, -1)
+	after := side(func(k int) bool { return k >= 0 }, 1)
+
+	var ids []string
+	for _, e := range mergeSurrounding(before, after, opts.AnchorID, opts.Size) {
+		ids = append(ids, e.ID)
+	}
+	if got, want := strings.Join(ids, ","), "doc-22,doc-23,doc-24,doc-25,doc-26,doc-27,doc-28"; got != want {
+		t.Errorf("merged = %s, want %s", got, want)
+	}
+}
 
-	t.Run("pattern not found returns original", func(t *testing.T) {
-		t.Parallel()
+func TestMergeSurrounding(t *testing.T) {
+	t.Parallel()
 
-		result := removeIndexPattern("logs-*,traces-*", "metrics-*")
-		if result != "logs-*,traces-*" {
-			t.Errorf("expected 'logs-*,traces-*', got %q", result)
-		}
-	})
+	base := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	doc := func(id string, ms int) LogEntry {
+		return LogEntry{ID: id, Timestamp: base.Add(time.Duration(ms) * time.Millisecond)}
+	}
+	// Newest first before the anchor, oldest first after it; the anchor is in both
+	before := []LogEntry{doc("anchor", 0), doc("b1", -1), doc("b2", -2), doc("b3", -3)}
+	after := []LogEntry{doc("anchor", 0), doc("a1", 1), doc("a2", 2)}
+
+	var ids []string
+	for _, e := range mergeSurrounding(before, after, "anchor", 2) {
+		ids = append(ids, e.ID)
+	}
+	if got := strings.Join(ids, ","); got != "b2,b1,anchor,a1,a2" {
+		t.Errorf("merged = %s, want b2,b1,anchor,a1,a2", got)
+	}
 }
@@ -0,0 +1,200 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package es
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/elasticat/internal/es/patterns"
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+// volumeIntervals are the bucket widths LogVolume picks from, smallest first.
+var volumeIntervals = []time.Duration{
+	time.Second, 5 * time.Second, 10 * time.Second, 15 * time.Second, 30 * time.Second,
+	time.Minute, 2 * time.Minute, 5 * time.Minute, 10 * time.Minute, 15 * time.Minute, 30 * time.Minute,
+	time.Hour, 3 * time.Hour, 6 * time.Hour, 12 * time.Hour, 24 * time.Hour,
+}
+
+// VolumeBucket is the document count of one time bucket, split by level.
+type VolumeBucket struct {
+	Start time.Time
+	Error int64 // ERROR, FATAL and CRITICAL
+	Warn  int64 // WARN and WARNING
+	Other int64 // Everything else, including documents without a level
+}
+
+// Total returns the document count across all levels.
+func (b VolumeBucket) Total() int64 {
+	return b.Error + b.Warn + b.Other
+}
+
+// VolumeHistogram is a contiguous date histogram of document counts.
+type VolumeHistogram struct {
+	Buckets  []VolumeBucket
+	Interval time.Duration
+}
+
+// VolumeInterval returns the smallest preset interval that splits the window
+// into at most target buckets.
+func VolumeInterval(window time.Duration, target int) time.Duration {
+	if target < 1 {
+		target = 1
+	}
+	for _, interval := range volumeIntervals {
+		if window/interval <= time.Duration(target) {
+			return interval
+		}
+	}
+	return volumeIntervals[len(volumeIntervals)-1]
+}
+
+// LogVolume counts the documents matching the TUI filters per time bucket and
+// level class. opts.From and opts.To bound the histogram; empty buckets inside
+// that window are filled in so the result can be drawn column by column.
+// Returns the histogram plus the ES|QL query string for display.
+func (c *Client) LogVolume(ctx context.Context, queryStr string, opts SearchOptions, interval time.Duration) (*VolumeHistogram, string, error) {
+	filters := buildCommonFilters(commonFilterOptions{
+		indexPattern:   c.index,
+		lookback:       opts.Lookback,
+		from:           opts.From,
+		to:             opts.To,
+		service:        opts.Service,
+		negateService:  opts.NegateService,
+		resource:       opts.Resource,
+		negateResource: opts.NegateResource,
+		level:          opts.Level,
+		traceID:        opts.TraceID,
+		spanID:         opts.SpanID,
+		pattern:        opts.Pattern,
+		exception:      opts.Exception,
+		fieldFilters:   opts.FieldFilters,
+		searchClause:   buildSearchClause(queryStr, opts.SearchFields),
+	})
+
+	query := buildVolumeQuery(c.index, filters, interval)
+	res, _, err := c.executeESQLDroppingUnknownIndices(ctx, query)
+	if err != nil {
+		if shared.IsESQLEmptyStateError(err) {
+			return fillVolumeBuckets(nil, opts.From, opts.To, interval), query, nil
+		}
+		return nil, query, fmt.Errorf("log volume query failed: %w", err)
+	}
+	if res == nil {
+		return fillVolumeBuckets(nil, opts.From, opts.To, interval), query, nil
+	}
+	return fillVolumeBuckets(parseVolumeResult(res), opts.From, opts.To, interval), query, nil
+}
+
+func buildVolumeQuery(indexPattern string, filters esqlFilters, interval time.Duration) string {
+	where := "WHERE true"
+	if len(filters.whereParts) > 0 {
+		where = "WHERE " + strings.Join(filters.whereParts, " AND ")
+	}
+	return fmt.Sprintf(`FROM %s
+| %s
+| EVAL lvl = %s
+| STATS
+    total = COUNT(*),
+    errors = COUNT(CASE(lvl IN ("ERROR", "FATAL", "CRITICAL"), 1, null)),
+    warns = COUNT(CASE(lvl IN ("WARN", "WARNING"), 1, null))
+  BY bucket = BUCKET(@timestamp, %s)
+| SORT bucket`, indexPattern, where, patterns.LevelExpr, esqlTimeSpan(interval))
+}
+
+// esqlTimeSpan renders a duration as an ES|QL time span literal.
+func esqlTimeSpan(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return "1 minute"
+	case d%(24*time.Hour) == 0:
+		return fmt.Sprintf("%d days", d/(24*time.Hour))
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d hours", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d minutes", d/time.Minute)
+	default:
+		return fmt.Sprintf("%d seconds", (d+time.Second-1)/time.Second)
+	}
+}
+
+func parseVolumeResult(res *ESQLResult) []VolumeBucket {
+	colIndex := map[string]int{}
+	for i, col := range res.Columns {
+		colIndex[col.Name] = i
+	}
+
+	getInt := func(row []interface{}, name string) int64 {
+		idx, ok := colIndex[name]
+		if !ok || idx >= len(row) {
+			return 0
+		}
+		if v, ok := row[idx].(float64); ok {
+			return int64(v)
+		}
+		return 0
+	}
+
+	out := make([]VolumeBucket, 0, len(res.Values))
+	for _, row := range res.Values {
+		idx, ok := colIndex["bucket"]
+		if !ok || idx >= len(row) {
+			continue
+		}
+		ts, _ := row[idx].(string)
+		start, err := time.Parse(time.RFC3339Nano, ts)
+		if err != nil {
+			continue
+		}
+		b := VolumeBucket{
+			Start: start,
+			Error: getInt(row, "errors"),
+			Warn:  getInt(row, "warns"),
+		}
+		if total := getInt(row, "total"); total > b.Error+b.Warn {
+			b.Other = total - b.Error - b.Warn
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+// fillVolumeBuckets lays the returned buckets out on a contiguous grid covering
+// [from, to). Without a window the grid spans the returned buckets only.
+func fillVolumeBuckets(buckets []VolumeBucket, from, to time.Time, interval time.Duration) *VolumeHistogram {
+	hist := &VolumeHistogram{Interval: interval}
+	if interval <= 0 {
+		hist.Buckets = buckets
+		return hist
+	}
+
+	start, end := from, to
+	if len(buckets) > 0 {
+		if start.IsZero() || buckets[0].Start.Before(start) {
+			start = buckets[0].Start
+		}
+		if last := buckets[len(buckets)-1].Start.Add(interval); end.IsZero() || last.After(end) {
+			end = last
+		}
+	}
+	if start.IsZero() || end.IsZero() {
+		return hist
+	}
+
+	byStart := make(map[int64]VolumeBucket, len(buckets))
+	for _, b := range buckets {
+		byStart[b.Start.UnixNano()] = b
+	}
+	for t := start.Truncate(interval); t.Before(end); t = t.Add(interval) {
+		b, ok := byStart[t.UnixNano()]
+		if !ok {
+			b = VolumeBucket{Start: t}
+		}
+		hist.Buckets = append(hist.Buckets, b)
+	}
+	return hist
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package es
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVolumeInterval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		window   time.Duration
+		target   int
+		expected time.Duration
+	}{
+		{"5 minutes", 5 * time.Minute, 60, 5 * time.Second},
+		{"1 hour", time.Hour, 60, time.Minute},
+		{"24 hours", 24 * time.Hour, 60, 30 * time.Minute},
+		{"1 week", 7 * 24 * time.Hour, 60, 3 * time.Hour},
+		{"narrow window", 30 * time.Second, 60, time.Second},
+		{"huge window clamps", 365 * 24 * time.Hour, 60, 24 * time.Hour},
+		{"zero target", time.Minute, 0, time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := VolumeInterval(tt.window, tt.target); got != tt.expected {
+				t.Errorf("VolumeInterval(%v, %d) = %v, want %v", tt.window, tt.target, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildVolumeQuery(t *testing.T) {
+	t.Parallel()
+
+	filters := buildCommonFilters(commonFilterOptions{
+		indexPattern: "logs-*",
+		service:      "api",
+		from:         time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC),
+	})
+	query := buildVolumeQuery("logs-*", filters, 5*time.Minute)
+
+	for _, want := range []string{
+		"FROM logs-*",
+		`service.name == "api"`,
+		`@timestamp >= TIMESTAMP("2026-01-02T03:00:00Z")`,
+		`errors = COUNT(CASE(lvl IN ("ERROR", "FATAL", "CRITICAL"), 1, null))`,
+		"BY bucket = BUCKET(@timestamp, 5 minutes)",
+		"SORT bucket",
+	} {
+		if !strings.Contains(query, want) {
+			t.Errorf("expected query to contain %q, got:\n%s", want, query)
+		}
+	}
+}
+
+func TestEsqlTimeSpan(t *testing.T) {
+	t.Parallel()
+
+	tests := map[time.Duration]string{
+		15 * time.Second: "15 seconds",
+		2 * time.Minute:  "2 minutes",
+		3 * time.Hour:    "3 hours",
+		24 * time.Hour:   "1 days",
+		0:                "1 minute",
+	}
+	for d, want := range tests {
+		if got := esqlTimeSpan(d); got != want {
+			t.Errorf("esqlTimeSpan(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestParseVolumeResult(t *testing.T) {
+	t.Parallel()
+
+	res := &ESQLResult{
+		Columns: []ESQLColumn{{Name: "total"}, {Name: "errors"}, {Name: "warns"}, {Name: "bucket"}},
+		Values: [][]interface{}{
+			{float64(10), float64(2), float64(3), "2026-01-02T03:00:00.000Z"},
+			{float64(4), float64(0), float64(0), "2026-01-02T03:01:00.000Z"},
+			{float64(1), float64(0), float64(0), nil},
+		},
+	}
+
+	buckets := parseVolumeResult(res)
+	if len(buckets) != 2 {
+		t.Fatalf("expected 2 buckets, got %d", len(buckets))
+	}
+	b := buckets[0]
+	if b.Error != 2 || b.Warn != 3 || b.Other != 5 || b.Total() != 10 {
+		t.Errorf("unexpected first bucket: %+v", b)
+	}
+	if !b.Start.Equal(time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected bucket start %v", b.Start)
+	}
+}
+
+func TestFillVolumeBuckets(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	buckets := []VolumeBucket{
+		{Start: base.Add(time.Minute), Error: 1},
+		{Start: base.Add(3 * time.Minute), Other: 2},
+	}
+
+	t.Run("window fills gaps", func(t *testing.T) {
+		t.Parallel()
+
+		hist := fillVolumeBuckets(buckets, base.Add(30*time.Second), base.Add(5*time.Minute), time.Minute)
+		if len(hist.Buckets) != 5 {
+			t.Fatalf("expected 5 buckets, got %d", len(hist.Buckets))
+		}
+		if !hist.Buckets[0].Start.Equal(base) {
+			t.Errorf("expected grid aligned to the interval, got %v", hist.Buckets[0].Start)
+		}
+		if hist.Buckets[1].Error != 1 || hist.Buckets[3].Other != 2 || hist.Buckets[2].Total() != 0 {
+			t.Errorf("unexpected buckets: %+v", hist.Buckets)
+		}
+	})
+
+	t.Run("no window spans results", func(t *testing.T) {
+		t.Parallel()
+
+		hist := fillVolumeBuckets(buckets, time.Time{}, time.Time{}, time.Minute)
+		if len(hist.Buckets) != 3 {
+			t.Fatalf("expected 3 buckets, got %d", len(hist.Buckets))
+		}
+	})
+
+	t.Run("empty without window", func(t *testing.T) {
+		t.Parallel()
+
+		hist := fillVolumeBuckets(nil, time.Time{}, time.Time{}, time.Minute)
+		if len(hist.Buckets) != 0 {
+			t.Errorf("expected no buckets, got %d", len(hist.Buckets))
+		}
+	})
+}
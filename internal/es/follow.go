@@ -0,0 +1,127 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package es
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// TailPosition is a place in the (@timestamp, _id) order documents are
+// tailed in. ES|QL polls ask for the documents sorted after it, so a page
+// full of documents sharing a timestamp is followed by the next one.
+type TailPosition struct {
+	Timestamp time.Time
+	ID        string // Empty when the source doesn't return _id
+}
+
+// compareTailPositions orders positions by timestamp, then _id.
+func compareTailPositions(a, b TailPosition) int {
+	if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+		return c
+	}
+	return strings.Compare(a.ID, b.ID)
+}
+
+// TailCursor is the position of a live tail. Each poll asks for documents
+// after the newest one seen, oldest first, and Advance drops any returned
+// again. Documents sharing a timestamp are told apart by _id, so none is
+// shown twice or skipped when several arrive within the same instant.
+type TailCursor struct {
+	newest TailPosition         // Newest document seen
+	seen   map[string]time.Time // Documents at or after the newest timestamp, by key
+}
+
+// NewTailCursor starts a tail after the given documents, in any order, or
+// at start when there are none.
+func NewTailCursor(entries []LogEntry, start time.Time) *TailCursor {
+	c := &TailCursor{seen: make(map[string]time.Time)}
+	c.Advance(entries)
+	if c.newest.Timestamp.IsZero() {
+		c.newest.Timestamp = start
+	}
+	return c
+}
+
+// Since returns the timestamp of the newest document seen, at full
+// precision. Queries that can't page by _id ask for documents from it on,
+// with room for the Overlap documents they return again.
+func (c *TailCursor) Since() time.Time {
+	return c.newest.Timestamp
+}
+
+// After returns the position the next ES|QL poll pages from.
+func (c *TailCursor) After() TailPosition {
+	return c.newest
+}
+
+// Overlap returns how many documents seen share the newest timestamp, which
+// a query from Since on returns again.
+func (c *TailCursor) Overlap() int {
+	n := 0
+	for _, ts := range c.seen {
+		if ts.Equal(c.newest.Timestamp) {
+			n++
+		}
+	}
+	return n
+}
+
+// Newest returns the timestamp of the newest document seen.
+func (c *TailCursor) Newest() time.Time {
+	return c.newest.Timestamp
+}
+
+// Advance returns the documents not seen yet, oldest first, and moves the
+// cursor past them.
+func (c *TailCursor) Advance(entries []LogEntry) []LogEntry {
+	since := c.newest.Timestamp
+	var fresh []LogEntry
+	for _, e := range entries {
+		if !since.IsZero() && e.Timestamp.Before(since) {
+			continue
+		}
+		key := tailKey(e)
+		if _, ok := c.seen[key]; ok {
+			continue
+		}
+		c.seen[key] = e.Timestamp
+		fresh = append(fresh, e)
+		if pos := (TailPosition{Timestamp: e.Timestamp, ID: e.ID}); compareTailPositions(pos, c.newest) > 0 {
+			c.newest = pos
+		}
+	}
+	slices.SortStableFunc(fresh, func(a, b LogEntry) int {
+		return compareTailPositions(TailPosition{a.Timestamp, a.ID}, TailPosition{b.Timestamp, b.ID})
+	})
+
+	// Only documents the next poll can return again need remembering
+	for key, ts := range c.seen {
+		if ts.Before(c.newest.Timestamp) {
+			delete(c.seen, key)
+		}
+	}
+	return fresh
+}
+
+// Clone returns an independent copy of the cursor.
+func (c *TailCursor) Clone() *TailCursor {
+	if c == nil {
+		return nil
+	}
+	clone := *c
+	clone.seen = maps.Clone(c.seen)
+	return &clone
+}
+
+// tailKey identifies a document. Without an _id (sources that don't return
+// it), the timestamp and raw document stand in for it.
+func tailKey(e LogEntry) string {
+	if e.ID != "" {
+		return e.ID
+	}
+	return e.Timestamp.Format(time.RFC3339Nano) + "\x00" + e.RawJSON
+}
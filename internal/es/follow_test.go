@@ -0,0 +1,84 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package es
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTailCursor(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	doc := func(id string, offset time.Duration) LogEntry {
+		return LogEntry{ID: id, Timestamp: base.Add(offset), Body: id}
+	}
+	ids := func(entries []LogEntry) []string {
+		var out []string
+		for _, e := range entries {
+			out = append(out, e.ID)
+		}
+		return out
+	}
+
+	// The initial page comes newest first
+	c := NewTailCursor([]LogEntry{doc("b", 300*time.Millisecond), doc("a", 0)}, time.Now())
+	if want := base.Add(300 * time.Millisecond); !c.Since().Equal(want) {
+		t.Errorf("Since() = %v, want %v (full precision)", c.Since(), want)
+	}
+	if got := c.After(); got.ID != "b" || !got.Timestamp.Equal(c.Since()) {
+		t.Errorf("After() = %+v, want b at Since", got)
+	}
+
+	// The poll returns b's timestamp again: b is dropped, c shares it
+	got := c.Advance([]LogEntry{
+		doc("b", 300*time.Millisecond),
+		doc("c", 300*time.Millisecond),
+		doc("d", 1500*time.Millisecond),
+	})
+	if want := []string{"c", "d"}; len(got) != 2 || got[0].ID != want[0] || got[1].ID != want[1] {
+		t.Fatalf("Advance() = %v, want %v", ids(got), want)
+	}
+	if !c.Newest().Equal(base.Add(1500 * time.Millisecond)) {
+		t.Errorf("Newest() = %v", c.Newest())
+	}
+
+	// Documents from before Since can't come back, so they are forgotten
+	if _, ok := c.seen["b"]; ok {
+		t.Error("expected documents older than Since to be forgotten")
+	}
+	if got := c.Advance([]LogEntry{doc("d", 1500*time.Millisecond), doc("e", 1500*time.Millisecond)}); len(got) != 1 || got[0].ID != "e" {
+		t.Errorf("Advance() = %v, want [e]", ids(got))
+	}
+	if got := c.Overlap(); got != 2 {
+		t.Errorf("Overlap() = %d, want 2 (d and e share the newest timestamp)", got)
+	}
+	if got := c.After(); got.ID != "e" {
+		t.Errorf("After() = %+v, want e, the highest _id at the newest timestamp", got)
+	}
+
+	if empty := NewTailCursor(nil, base); !empty.Since().Equal(base) {
+		t.Errorf("empty tail starts at %v, want %v", empty.Since(), base)
+	}
+
+	clone := c.Clone()
+	clone.Advance([]LogEntry{doc("f", 2*time.Second)})
+	if c.Newest().Equal(clone.Newest()) {
+		t.Error("advancing a clone moved the original")
+	}
+}
+
+func TestTailCursorWithoutIDs(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	first := LogEntry{Timestamp: ts, RawJSON: `{"body":"one"}`}
+	second := LogEntry{Timestamp: ts, RawJSON: `{"body":"two"}`}
+
+	c := NewTailCursor([]LogEntry{first}, time.Now())
+	if got := c.Advance([]LogEntry{first, second}); len(got) != 1 || got[0].RawJSON != second.RawJSON {
+		t.Errorf("Advance() = %+v, want only the second document", got)
+	}
+}
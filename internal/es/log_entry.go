@@ -174,6 +174,12 @@ func extractLogEntry(raw map[string]interface{}) LogEntry {
 		}
 	}
 
+	// === DOCUMENT ID ===
+	// Search hits carry it beside _source; ES|QL rows when asked for with METADATA _id
+	if id, ok := raw["_id"].(string); ok {
+		entry.ID = id
+	}
+
 	// === CONTAINER ID ===
 	if containerID, ok := raw["container_id"].(string); ok {
 		entry.ContainerID = containerID
@@ -194,6 +200,15 @@ func extractLogEntry(raw map[string]interface{}) LogEntry {
 	if spanID, ok := raw["span_id"].(string); ok {
 		entry.SpanID = spanID
 	}
+	if parentID, ok := raw["parent_span_id"].(string); ok {
+		entry.ParentSpanID = parentID
+	} else if parentID, ok := raw["parent.id"].(string); ok {
+		entry.ParentSpanID = parentID
+	} else if parent, ok := raw["parent"].(map[string]interface{}); ok {
+		if id, ok := parent["id"].(string); ok {
+			entry.ParentSpanID = id
+		}
+	}
 	if name, ok := raw["name"].(string); ok {
 		entry.Name = name
 	}
@@ -246,6 +261,23 @@ func (l *LogEntry) GetLevel() string {
 	return "INFO"
 }
 
+// IsError reports whether a span failed, using the OTel status code or the
+// APM event.outcome attribute.
+func (l *LogEntry) IsError() bool {
+	if l.Status != nil {
+		if code, ok := l.Status["code"].(string); ok {
+			switch strings.ToUpper(code) {
+			case "ERROR", "STATUS_CODE_ERROR":
+				return true
+			}
+		}
+	}
+	if outcome, ok := l.Attributes["event.outcome"].(string); ok && outcome == "failure" {
+		return true
+	}
+	return false
+}
+
 // GetOriginal returns the log.record.original attribute if present
 func (l *LogEntry) GetOriginal() string {
 	// Check for log.record.original in attributes
@@ -330,6 +362,8 @@ func (l *LogEntry) GetFieldValue(fieldPath string) string {
 		return l.TraceID
 	case "span_id":
 		return l.SpanID
+	case "parent_span_id":
+		return l.ParentSpanID
 	case "name":
 		if l.Name != "" {
 			return l.Name
@@ -316,11 +316,12 @@ func TestExtractLogEntry_ServiceName(t *testing.T) {
 
 func TestExtractLogEntry_TraceFields(t *testing.T) {
 	raw := map[string]interface{}{
-		"trace_id": "abc123",
-		"span_id":  "def456",
-		"name":     "GET /api/users",
-		"kind":     "SERVER",
-		"duration": float64(1500000), // nanoseconds
+		"trace_id":       "abc123",
+		"span_id":        "def456",
+		"parent_span_id": "aaa111",
+		"name":           "GET /api/users",
+		"kind":           "SERVER",
+		"duration":       float64(1500000), // nanoseconds
 		"status": map[string]interface{}{
 			"code": "OK",
 		},
@@ -334,6 +335,9 @@ func TestExtractLogEntry_TraceFields(t *testing.T) {
 	if entry.SpanID != "def456" {
 		t.Errorf("SpanID = %q, want %q", entry.SpanID, "def456")
 	}
+	if entry.ParentSpanID != "aaa111" {
+		t.Errorf("ParentSpanID = %q, want %q", entry.ParentSpanID, "aaa111")
+	}
 	if entry.Name != "GET /api/users" {
 		t.Errorf("Name = %q, want %q", entry.Name, "GET /api/users")
 	}
@@ -346,6 +350,26 @@ func TestExtractLogEntry_TraceFields(t *testing.T) {
 	if entry.Status == nil || entry.Status["code"] != "OK" {
 		t.Errorf("Status = %v, want map with code=OK", entry.Status)
 	}
+	if entry.IsError() {
+		t.Error("IsError() = true for status OK")
+	}
+}
+
+func TestExtractLogEntry_ParentFromECS(t *testing.T) {
+	raw := map[string]interface{}{
+		"span_id": "child",
+		"parent":  map[string]interface{}{"id": "root"},
+		"status":  map[string]interface{}{"code": "Error"},
+	}
+
+	entry := extractLogEntry(raw)
+
+	if entry.ParentSpanID != "root" {
+		t.Errorf("ParentSpanID = %q, want %q", entry.ParentSpanID, "root")
+	}
+	if !entry.IsError() {
+		t.Error("IsError() = false for status Error")
+	}
 }
 
 func TestExtractLogEntry_ContainerID(t *testing.T) {
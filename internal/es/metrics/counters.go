@@ -0,0 +1,237 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Counter temporalities reported in AggregatedMetric.Temporality
+const (
+	TemporalityCumulative = "cumulative"
+	TemporalityDelta      = "delta"
+)
+
+// CounterSeriesLimit caps the time series (by document count) a cumulative
+// counter's rate is summed over, keeping the bucket count of a page bounded.
+// Rates of counters with more series are flagged AggregatedMetric.Partial.
+const CounterSeriesLimit = 20
+
+// counterSample is one date_histogram bucket of a counter field
+type counterSample struct {
+	Timestamp time.Time
+	Count     int64
+	Sum       float64 // Sum of reported values (delta temporality)
+	Max       float64 // Highest reported value (cumulative temporality)
+	HasData   bool
+}
+
+// counterTemporality returns the temporality declared by a counter field's
+// mapping meta ("temporality": "delta"). TSDS counter fields hold running
+// totals, so it defaults to cumulative.
+func counterTemporality(meta map[string][]string) string {
+	for _, v := range meta["temporality"] {
+		if strings.EqualFold(v, TemporalityDelta) {
+			return TemporalityDelta
+		}
+	}
+	return TemporalityCumulative
+}
+
+// counterOverTimeAggs returns the per-bucket sub-aggregations rates are derived from
+func counterOverTimeAggs(field, temporality string) map[string]interface{} {
+	aggs := map[string]interface{}{
+		"value": map[string]interface{}{"avg": map[string]interface{}{"field": field}},
+	}
+	if temporality == TemporalityDelta {
+		aggs["sum_value"] = map[string]interface{}{"sum": map[string]interface{}{"field": field}}
+	} else {
+		// Only used when the index has no _tsid to split the series by
+		aggs["max_value"] = map[string]interface{}{"max": map[string]interface{}{"field": field}}
+	}
+	return aggs
+}
+
+// counterSeriesAgg splits a cumulative counter into its time series (_tsid of
+// a TSDS index), each with its own running total per bucket, so increases are
+// taken per series rather than from a maximum across series.
+func counterSeriesAgg(field, bucketSize string) map[string]interface{} {
+	return map[string]interface{}{
+		"terms": map[string]interface{}{
+			"field": "_tsid",
+			"size":  CounterSeriesLimit,
+		},
+		"aggs": map[string]interface{}{
+			"over_time": map[string]interface{}{
+				"date_histogram": map[string]interface{}{
+					"field":          "@timestamp",
+					"fixed_interval": bucketSize,
+				},
+				"aggs": map[string]interface{}{
+					"max_value": map[string]interface{}{"max": map[string]interface{}{"field": field}},
+				},
+			},
+		},
+	}
+}
+
+// parseCounterOverTime extracts the counter samples of an over_time date histogram
+func parseCounterOverTime(agg map[string]interface{}) []counterSample {
+	overTime, ok := agg["over_time"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	buckets, ok := overTime["buckets"].([]interface{})
+	if !ok {
+		return nil
+	}
+	samples := make([]counterSample, 0, len(buckets))
+	for _, b := range buckets {
+		bucket, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		s := counterSample{}
+		if keyMs, ok := bucket["key"].(float64); ok {
+			s.Timestamp = time.UnixMilli(int64(keyMs))
+		}
+		if count, ok := bucket["doc_count"].(float64); ok {
+			s.Count = int64(count)
+		}
+		if v, ok := bucket["sum_value"].(map[string]interface{}); ok {
+			s.Sum, _ = v["value"].(float64)
+		}
+		if v, ok := bucket["max_value"].(map[string]interface{}); ok {
+			s.Max, s.HasData = v["value"].(float64)
+		}
+		samples = append(samples, s)
+	}
+	return samples
+}
+
+// counterRates converts the samples of a single counter series into per-second rates.
+//
+// Delta counters report increments, so a bucket's rate is its sum over the
+// bucket length. Cumulative counters report running totals: the rate is the
+// increase of the bucket maximum since the previous bucket with data, and a
+// drop is treated as a reset (the counter restarted from zero), so the
+// increase is the new value itself. The first cumulative bucket has no
+// baseline and is omitted.
+func counterRates(samples []counterSample, bucketSize time.Duration, temporality string) []MetricBucket {
+	rates := make([]MetricBucket, 0, len(samples))
+
+	if temporality == TemporalityDelta {
+		seconds := bucketSize.Seconds()
+		if seconds <= 0 {
+			return rates
+		}
+		for _, s := range samples {
+			rates = append(rates, MetricBucket{Timestamp: s.Timestamp, Count: s.Count, Value: s.Sum / seconds})
+		}
+		return rates
+	}
+
+	prev := -1
+	for i, s := range samples {
+		if !s.HasData {
+			continue
+		}
+		if prev >= 0 {
+			elapsed := s.Timestamp.Sub(samples[prev].Timestamp).Seconds()
+			increase := s.Max - samples[prev].Max
+			if increase < 0 {
+				increase = s.Max // Counter reset
+			}
+			if elapsed > 0 {
+				rates = append(rates, MetricBucket{Timestamp: s.Timestamp, Count: s.Count, Value: increase / elapsed})
+			}
+		}
+		prev = i
+	}
+	return rates
+}
+
+// aggregateCounterRates returns the per-second rates of a counter aggregation.
+// Delta increments add up across series, so their bucket sums are used as is.
+// Cumulative counters are differenced per time series and the series' rates
+// summed; without series (no _tsid) the field is taken as a single series.
+func aggregateCounterRates(agg map[string]interface{}, bucketSize time.Duration, temporality string) []MetricBucket {
+	if temporality == TemporalityDelta {
+		return counterRates(parseCounterOverTime(agg), bucketSize, temporality)
+	}
+
+	series, _ := agg["series"].(map[string]interface{})
+	buckets, _ := series["buckets"].([]interface{})
+	if len(buckets) == 0 {
+		return counterRates(parseCounterOverTime(agg), bucketSize, temporality)
+	}
+
+	sums := make(map[time.Time]MetricBucket)
+	for _, b := range buckets {
+		s, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, r := range counterRates(parseCounterOverTime(s), bucketSize, temporality) {
+			sum := sums[r.Timestamp]
+			sum.Timestamp = r.Timestamp
+			sum.Count += r.Count
+			sum.Value += r.Value
+			sums[r.Timestamp] = sum
+		}
+	}
+	rates := make([]MetricBucket, 0, len(sums))
+	for _, r := range sums {
+		rates = append(rates, r)
+	}
+	sort.Slice(rates, func(i, j int) bool { return rates[i].Timestamp.Before(rates[j].Timestamp) })
+	return rates
+}
+
+// seriesTruncated reports whether the series terms of a counter aggregation
+// left time series out, so the rates summed over them fall short.
+func seriesTruncated(agg map[string]interface{}) bool {
+	series, _ := agg["series"].(map[string]interface{})
+	other, _ := series["sum_other_doc_count"].(float64)
+	return other > 0
+}
+
+// applyCounterRates replaces the raw values of a counter metric with per-second
+// rates and recomputes its statistics from them.
+func applyCounterRates(am *AggregatedMetric, metricAgg map[string]interface{}, bucketSize time.Duration, temporality string) {
+	am.Temporality = temporality
+	am.Rate = true
+	am.Partial = seriesTruncated(metricAgg)
+	am.Buckets = aggregateCounterRates(metricAgg, bucketSize, temporality)
+	am.Min, am.Max, am.Avg, am.Latest = 0, 0, 0, 0
+	for i, b := range am.Buckets {
+		if i == 0 || b.Value < am.Min {
+			am.Min = b.Value
+		}
+		if i == 0 || b.Value > am.Max {
+			am.Max = b.Value
+		}
+		am.Avg += b.Value
+	}
+	if n := len(am.Buckets); n > 0 {
+		am.Avg /= float64(n)
+		am.Latest = am.Buckets[n-1].Value
+	}
+
+	// Each group holds its own time series
+	if groups, ok := metricAgg["groups"].(map[string]interface{}); ok {
+		if buckets, ok := groups["buckets"].([]interface{}); ok {
+			for i, b := range buckets {
+				group, ok := b.(map[string]interface{})
+				if !ok || i >= len(am.Series) {
+					continue
+				}
+				am.Series[i].Buckets = aggregateCounterRates(group, bucketSize, temporality)
+				am.Partial = am.Partial || seriesTruncated(group)
+			}
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+func TestAggregate_CounterRates(t *testing.T) {
+	// Cumulative counter sampled every minute, reset between the 3rd and 4th
+	// bucket, in an index without _tsid series
+	mock := &mockExecutor{
+		index: "metrics-*",
+		fieldCapsResp: &shared.FieldCapsResponse{
+			Fields: map[string]map[string]shared.FieldCapsInfo{
+				"metrics.http.requests": {"long": {Type: "long", Aggregatable: true, TimeSeriesMetric: "counter"}},
+			},
+		},
+		searchResponse: &shared.SearchResponse{
+			Body: io.NopCloser(strings.NewReader(`{"aggregations": {"m0": {
+				"doc_count": 4,
+				"stats": {"min": 30, "max": 1200, "avg": 600},
+				"over_time": {"buckets": [
+					{"key": 1700000000000, "doc_count": 1, "max_value": {"value": 600}, "sum_value": {"value": 600}},
+					{"key": 1700000060000, "doc_count": 1, "max_value": {"value": 900}, "sum_value": {"value": 900}},
+					{"key": 1700000120000, "doc_count": 1, "max_value": {"value": 1200}, "sum_value": {"value": 1200}},
+					{"key": 1700000180000, "doc_count": 1, "max_value": {"value": 30}, "sum_value": {"value": 30}}
+				]}
+			}}}`)),
+			StatusCode: 200,
+			Status:     "200 OK",
+		},
+	}
+
+	result, err := Aggregate(context.Background(), mock, AggregateMetricsOptions{BucketSize: "1m"})
+	if err != nil {
+		t.Fatalf("Aggregate failed: %v", err)
+	}
+
+	var query map[string]interface{}
+	if err := json.Unmarshal(mock.lastSearchBody, &query); err != nil {
+		t.Fatalf("Failed to parse query: %v", err)
+	}
+	overTime := query["aggs"].(map[string]interface{})["m0"].(map[string]interface{})["aggs"].(map[string]interface{})["over_time"].(map[string]interface{})["aggs"].(map[string]interface{})
+	if _, ok := overTime["max_value"]; !ok {
+		t.Errorf("Expected max sub-aggregation for counter, got %v", overTime)
+	}
+	series := query["aggs"].(map[string]interface{})["m0"].(map[string]interface{})["aggs"].(map[string]interface{})["series"].(map[string]interface{})
+	if field := series["terms"].(map[string]interface{})["field"]; field != "_tsid" {
+		t.Errorf("Expected the counter split by _tsid, got %v", field)
+	}
+
+	m := result.Metrics[0]
+	if !m.Rate || m.Temporality != TemporalityCumulative {
+		t.Fatalf("Expected cumulative rate, got rate=%v temporality=%q", m.Rate, m.Temporality)
+	}
+	// 300/60s, 300/60s, then the reset counts the new value: 30/60s
+	want := []float64{5, 5, 0.5}
+	if len(m.Buckets) != len(want) {
+		t.Fatalf("Expected %d rate buckets, got %+v", len(want), m.Buckets)
+	}
+	for i, v := range want {
+		if m.Buckets[i].Value != v {
+			t.Errorf("Bucket %d: expected %v/s, got %v", i, v, m.Buckets[i].Value)
+		}
+	}
+	if m.Latest != 0.5 || m.Max != 5 || m.Min != 0.5 {
+		t.Errorf("Unexpected stats: min=%v max=%v latest=%v", m.Min, m.Max, m.Latest)
+	}
+}
+
+func TestAggregate_CounterRatesPerSeries(t *testing.T) {
+	// Two pods: b is ahead of a, then stops reporting. Differencing the maximum
+	// across pods would see b's disappearance as a reset of a's counter.
+	mock := &mockExecutor{
+		index: "metrics-*",
+		fieldCapsResp: &shared.FieldCapsResponse{
+			Fields: map[string]map[string]shared.FieldCapsInfo{
+				"metrics.http.requests": {"long": {Type: "long", Aggregatable: true, TimeSeriesMetric: "counter"}},
+			},
+		},
+		searchResponse: &shared.SearchResponse{
+			Body: io.NopCloser(strings.NewReader(`{"aggregations": {"m0": {
+				"doc_count": 5,
+				"stats": {"min": 600, "max": 2060, "avg": 1300},
+				"over_time": {"buckets": [
+					{"key": 1700000000000, "doc_count": 2, "max_value": {"value": 2000}},
+					{"key": 1700000060000, "doc_count": 2, "max_value": {"value": 2060}},
+					{"key": 1700000120000, "doc_count": 1, "max_value": {"value": 1200}}
+				]},
+				"series": {"buckets": [
+					{"key": "a", "doc_count": 3, "over_time": {"buckets": [
+						{"key": 1700000000000, "doc_count": 1, "max_value": {"value": 600}},
+						{"key": 1700000060000, "doc_count": 1, "max_value": {"value": 900}},
+						{"key": 1700000120000, "doc_count": 1, "max_value": {"value": 1200}}
+					]}},
+					{"key": "b", "doc_count": 2, "over_time": {"buckets": [
+						{"key": 1700000000000, "doc_count": 1, "max_value": {"value": 2000}},
+						{"key": 1700000060000, "doc_count": 1, "max_value": {"value": 2060}},
+						{"key": 1700000120000, "doc_count": 0, "max_value": {"value": null}}
+					]}}
+				]}
+			}}}`)),
+			StatusCode: 200,
+			Status:     "200 OK",
+		},
+	}
+
+	result, err := Aggregate(context.Background(), mock, AggregateMetricsOptions{BucketSize: "1m"})
+	if err != nil {
+		t.Fatalf("Aggregate failed: %v", err)
+	}
+
+	// a's 5/s plus b's 1/s, then a alone
+	m := result.Metrics[0]
+	want := []float64{6, 5}
+	if len(m.Buckets) != len(want) {
+		t.Fatalf("Expected %d rate buckets, got %+v", len(want), m.Buckets)
+	}
+	for i, v := range want {
+		if m.Buckets[i].Value != v {
+			t.Errorf("Bucket %d: expected %v/s, got %v", i, v, m.Buckets[i].Value)
+		}
+	}
+}
+
+func TestAggregate_CounterRatesPartial(t *testing.T) {
+	body := func(other int) string {
+		return fmt.Sprintf(`{"aggregations": {"m0": {
+			"doc_count": 6,
+			"stats": {"min": 600, "max": 900, "avg": 750},
+			"series": {"sum_other_doc_count": %d, "buckets": [
+				{"key": "a", "doc_count": 2, "over_time": {"buckets": [
+					{"key": 1700000000000, "doc_count": 1, "max_value": {"value": 600}},
+					{"key": 1700000060000, "doc_count": 1, "max_value": {"value": 900}}
+				]}}
+			]}
+		}}}`, other)
+	}
+	for _, tc := range []struct {
+		other   int
+		partial bool
+	}{{0, false}, {4, true}} {
+		mock := &mockExecutor{
+			index: "metrics-*",
+			fieldCapsResp: &shared.FieldCapsResponse{
+				Fields: map[string]map[string]shared.FieldCapsInfo{
+					"metrics.http.requests": {"long": {Type: "long", Aggregatable: true, TimeSeriesMetric: "counter"}},
+				},
+			},
+			searchResponse: &shared.SearchResponse{
+				Body:       io.NopCloser(strings.NewReader(body(tc.other))),
+				StatusCode: 200,
+				Status:     "200 OK",
+			},
+		}
+		result, err := Aggregate(context.Background(), mock, AggregateMetricsOptions{BucketSize: "1m"})
+		if err != nil {
+			t.Fatalf("Aggregate failed: %v", err)
+		}
+		if got := result.Metrics[0].Partial; got != tc.partial {
+			t.Errorf("sum_other_doc_count %d: Partial = %v, want %v", tc.other, got, tc.partial)
+		}
+	}
+}
+
+func TestCounterTemporality(t *testing.T) {
+	if got := counterTemporality(nil); got != TemporalityCumulative {
+		t.Errorf("Expected counters without meta to be cumulative, got %q", got)
+	}
+	if got := counterTemporality(map[string][]string{"temporality": {"Delta"}}); got != TemporalityDelta {
+		t.Errorf("Expected the mapping meta to declare delta, got %q", got)
+	}
+}
+
+func TestCounterRates_Delta(t *testing.T) {
+	base := time.UnixMilli(1700000000000)
+	samples := []counterSample{
+		{Timestamp: base, Count: 2, Sum: 120, Max: 80, HasData: true},
+		{Timestamp: base.Add(time.Minute), Count: 2, Sum: 60, Max: 40, HasData: true},
+		{Timestamp: base.Add(2 * time.Minute)},
+		{Timestamp: base.Add(3 * time.Minute), Count: 1, Sum: 30, Max: 30, HasData: true},
+	}
+
+	rates := counterRates(samples, time.Minute, TemporalityDelta)
+	want := []float64{2, 1, 0, 0.5}
+	if len(rates) != len(want) {
+		t.Fatalf("Expected %d buckets, got %+v", len(want), rates)
+	}
+	for i, v := range want {
+		if rates[i].Value != v {
+			t.Errorf("Bucket %d: expected %v/s, got %v", i, v, rates[i].Value)
+		}
+	}
+}
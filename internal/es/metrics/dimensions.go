@@ -0,0 +1,107 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/elastic/elasticat/internal/es/errfmt"
+)
+
+// DefaultGroupByLimit is the number of dimension values kept when AggregateMetricsOptions.GroupByLimit is unset
+const DefaultGroupByLimit = 5
+
+// maxDimensionCandidates caps the attribute fields probed in a single dimension query
+const maxDimensionCandidates = 200
+
+// GetDimensions discovers the attributes a metric can be broken down by:
+// keyword fields under "attributes.*" that are present on documents carrying
+// the metric within the options' time range and filters.
+// Dimensions are ordered by the number of documents they appear on.
+func GetDimensions(ctx context.Context, exec Executor, metricName string, opts AggregateMetricsOptions) ([]Dimension, error) {
+	index := exec.GetIndex()
+
+	caps, err := exec.FieldCaps(ctx, index, "attributes.*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attribute field caps: %w", err)
+	}
+
+	var candidates []string
+	for name, typeMap := range caps.Fields {
+		for _, info := range typeMap {
+			if info.Type == "keyword" && info.Aggregatable {
+				candidates = append(candidates, name)
+			}
+			break // Only process first type
+		}
+	}
+	if len(candidates) == 0 {
+		return []Dimension{}, nil
+	}
+	sort.Strings(candidates)
+	if len(candidates) > maxDimensionCandidates {
+		candidates = candidates[:maxDimensionCandidates]
+	}
+
+	// Count co-occurrence of each candidate with the metric in one request
+	aggs := make(map[string]interface{}, len(candidates))
+	for i, field := range candidates {
+		aggs[fmt.Sprintf("d%d", i)] = map[string]interface{}{
+			"filter": map[string]interface{}{
+				"exists": map[string]interface{}{
+					"field": field,
+				},
+			},
+		}
+	}
+	query := map[string]interface{}{
+		"size": 0,
+		"aggs": aggs,
+		"query": buildBoolFilter(opts, map[string]interface{}{
+			"exists": map[string]interface{}{
+				"field": metricName,
+			},
+		}),
+	}
+
+	queryJSON, err := json.Marshal(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal dimension query: %w", err)
+	}
+
+	res, err := exec.SearchForMetrics(ctx, index, queryJSON, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute dimension query: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError {
+		body, _ := io.ReadAll(res.Body)
+		return nil, errfmt.FormatQueryError(res.Status, body, queryJSON)
+	}
+
+	var raw struct {
+		Aggregations map[string]struct {
+			DocCount int64 `json:"doc_count"`
+		} `json:"aggregations"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	dims := []Dimension{}
+	for i, field := range candidates {
+		if agg, ok := raw.Aggregations[fmt.Sprintf("d%d", i)]; ok && agg.DocCount > 0 {
+			dims = append(dims, Dimension{Field: field, DocCount: agg.DocCount})
+		}
+	}
+	sort.SliceStable(dims, func(i, j int) bool {
+		return dims[i].DocCount > dims[j].DocCount
+	})
+	return dims, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+func TestGetDimensions(t *testing.T) {
+	mock := &mockExecutor{
+		index: "metrics-*",
+		fieldCapsResp: &shared.FieldCapsResponse{
+			Fields: map[string]map[string]shared.FieldCapsInfo{
+				"attributes.http.route":  {"keyword": {Type: "keyword", Aggregatable: true}},
+				"attributes.k8s.pod":     {"keyword": {Type: "keyword", Aggregatable: true}},
+				"attributes.status_code": {"long": {Type: "long", Aggregatable: true}},
+				"attributes.unused":      {"keyword": {Type: "keyword", Aggregatable: true}},
+			},
+		},
+		// Candidates are probed in name order: http.route (d0), k8s.pod (d1), unused (d2)
+		searchResponse: &shared.SearchResponse{
+			Body: io.NopCloser(strings.NewReader(`{"aggregations": {
+				"d0": {"doc_count": 40},
+				"d1": {"doc_count": 90},
+				"d2": {"doc_count": 0}
+			}}`)),
+			StatusCode: 200,
+			Status:     "200 OK",
+		},
+	}
+
+	dims, err := GetDimensions(context.Background(), mock, "metrics.http.server.request.duration", AggregateMetricsOptions{
+		Lookback: "now-1h",
+		Service:  "api",
+	})
+	if err != nil {
+		t.Fatalf("GetDimensions failed: %v", err)
+	}
+
+	if len(dims) != 2 {
+		t.Fatalf("Expected 2 dimensions, got %+v", dims)
+	}
+	if dims[0].Field != "attributes.k8s.pod" || dims[1].ShortName() != "http.route" {
+		t.Errorf("Expected dimensions ordered by doc count, got %+v", dims)
+	}
+
+	// The metric must be present, and the usual filters apply
+	var query map[string]interface{}
+	if err := json.Unmarshal(mock.lastSearchBody, &query); err != nil {
+		t.Fatalf("Failed to parse query: %v", err)
+	}
+	filters := query["query"].(map[string]interface{})["bool"].(map[string]interface{})["filter"].([]interface{})
+	if len(filters) != 3 {
+		t.Errorf("Expected exists, lookback and service filters, got %v", filters)
+	}
+	if !strings.Contains(string(mock.lastSearchBody), `"field":"metrics.http.server.request.duration"`) {
+		t.Errorf("Expected exists filter on the metric: %s", mock.lastSearchBody)
+	}
+}
+
+func TestAggregate_GroupBy(t *testing.T) {
+	mock := &mockExecutor{
+		index: "metrics-*",
+		fieldCapsResp: &shared.FieldCapsResponse{
+			Fields: map[string]map[string]shared.FieldCapsInfo{
+				"metrics.memory.usage": {"double": {Type: "double", Aggregatable: true, TimeSeriesMetric: "gauge"}},
+				"metrics.cpu.usage":    {"double": {Type: "double", Aggregatable: true, TimeSeriesMetric: "gauge"}},
+			},
+		},
+		searchResponse: &shared.SearchResponse{
+			Body: io.NopCloser(strings.NewReader(`{"aggregations": {"m0": {
+				"doc_count": 30,
+				"stats": {"min": 1, "max": 9, "avg": 5},
+				"over_time": {"buckets": [{"key": 1700000000000, "doc_count": 30, "value": {"value": 5}}]},
+				"groups": {"buckets": [
+					{"key": "pod-a", "doc_count": 20, "over_time": {"buckets": [{"key": 1700000000000, "doc_count": 20, "value": {"value": 8}}]}},
+					{"key": "pod-b", "doc_count": 10, "over_time": {"buckets": [{"key": 1700000000000, "doc_count": 10, "value": {"value": 2}}]}}
+				]}
+			}}}`)),
+			StatusCode: 200,
+			Status:     "200 OK",
+		},
+	}
+
+	result, err := Aggregate(context.Background(), mock, AggregateMetricsOptions{
+		BucketSize:   "1m",
+		MetricNames:  []string{"metrics.memory.usage"},
+		GroupBy:      "attributes.k8s.pod",
+		GroupByLimit: 3,
+	})
+	if err != nil {
+		t.Fatalf("Aggregate failed: %v", err)
+	}
+
+	// Only the requested metric is aggregated, split by the dimension
+	var query map[string]interface{}
+	if err := json.Unmarshal(mock.lastSearchBody, &query); err != nil {
+		t.Fatalf("Failed to parse query: %v", err)
+	}
+	aggs := query["aggs"].(map[string]interface{})
+	if len(aggs) != 1 {
+		t.Fatalf("Expected 1 aggregation, got %d", len(aggs))
+	}
+	terms := aggs["m0"].(map[string]interface{})["aggs"].(map[string]interface{})["groups"].(map[string]interface{})["terms"].(map[string]interface{})
+	if terms["field"] != "attributes.k8s.pod" || terms["size"] != float64(3) {
+		t.Errorf("Unexpected group terms: %v", terms)
+	}
+
+	if len(result.Metrics) != 1 || result.Metrics[0].Name != "metrics.memory.usage" {
+		t.Fatalf("Expected memory metric, got %+v", result.Metrics)
+	}
+	series := result.Metrics[0].Series
+	if len(series) != 2 || series[0].Key != "pod-a" || series[0].Count != 20 || series[1].Buckets[0].Value != 2 {
+		t.Errorf("Unexpected series: %+v", series)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/elastic/elasticat/internal/es/errfmt"
+)
+
+// DefaultHeatmapRows is the number of value ranges of a heatmap when no row count is given
+const DefaultHeatmapRows = 10
+
+// DistributionPercentiles are the percentiles tracked over time for histogram metrics
+var DistributionPercentiles = []float64{50, 95, 99}
+
+// GetDistribution returns the distribution of a histogram metric over time:
+// percentiles per time bucket, and a heatmap counting values per time bucket
+// and value range. OpenTelemetry exponential histograms are stored as
+// histogram fields, so they are handled the same way.
+//
+// Two requests are made: the first finds the value range and percentiles, the
+// second splits that range into equal-width rows per time bucket.
+func GetDistribution(ctx context.Context, exec Executor, metricName string, opts AggregateMetricsOptions, rows int) (*HistogramDistribution, error) {
+	if rows <= 0 {
+		rows = DefaultHeatmapRows
+	}
+	query := func(overTimeAggs map[string]interface{}, aggs map[string]interface{}) map[string]interface{} {
+		aggs["over_time"] = map[string]interface{}{
+			"date_histogram": map[string]interface{}{
+				"field":          "@timestamp",
+				"fixed_interval": opts.BucketSize,
+			},
+			"aggs": overTimeAggs,
+		}
+		return map[string]interface{}{
+			"size": 0,
+			"aggs": aggs,
+			"query": buildBoolFilter(opts, map[string]interface{}{
+				"exists": map[string]interface{}{
+					"field": metricName,
+				},
+			}),
+		}
+	}
+
+	// Value range and percentiles over time
+	var rangeResp struct {
+		Aggregations struct {
+			Min      struct{ Value *float64 } `json:"min"`
+			Max      struct{ Value *float64 } `json:"max"`
+			OverTime struct {
+				Buckets []struct {
+					Key         float64 `json:"key"`
+					DocCount    int64   `json:"doc_count"`
+					Percentiles struct {
+						Values map[string]*float64 `json:"values"`
+					} `json:"percentiles"`
+				} `json:"buckets"`
+			} `json:"over_time"`
+		} `json:"aggregations"`
+	}
+	err := searchDistribution(ctx, exec, query(
+		map[string]interface{}{
+			"percentiles": map[string]interface{}{
+				"percentiles": map[string]interface{}{
+					"field":    metricName,
+					"percents": DistributionPercentiles,
+				},
+			},
+		},
+		map[string]interface{}{
+			"min": map[string]interface{}{"min": map[string]interface{}{"field": metricName}},
+			"max": map[string]interface{}{"max": map[string]interface{}{"field": metricName}},
+		},
+	), &rangeResp)
+	if err != nil {
+		return nil, err
+	}
+
+	dist := &HistogramDistribution{}
+	for _, p := range DistributionPercentiles {
+		series := MetricSeries{Key: fmt.Sprintf("p%g", p)}
+		for _, b := range rangeResp.Aggregations.OverTime.Buckets {
+			v, ok := percentileValue(b.Percentiles.Values, p)
+			if !ok {
+				continue // No values in this bucket
+			}
+			series.Count += b.DocCount
+			series.Buckets = append(series.Buckets, MetricBucket{
+				Timestamp: time.UnixMilli(int64(b.Key)),
+				Value:     v,
+				Count:     b.DocCount,
+			})
+		}
+		dist.Percentiles = append(dist.Percentiles, series)
+	}
+
+	minPtr, maxPtr := rangeResp.Aggregations.Min.Value, rangeResp.Aggregations.Max.Value
+	if minPtr == nil || maxPtr == nil {
+		return dist, nil // No values in range
+	}
+	minVal, maxVal := *minPtr, *maxPtr
+	interval := (maxVal - minVal) / float64(rows)
+	if interval <= 0 {
+		// All values are equal: a single row
+		rows, interval = 1, 1
+	}
+	// Row 0 starts at the minimum; histogram offsets must lie within [0, interval)
+	offset := math.Mod(minVal, interval)
+	if offset < 0 {
+		offset += interval
+	}
+
+	// Value counts per row and time bucket
+	var heatResp struct {
+		Aggregations struct {
+			OverTime struct {
+				Buckets []struct {
+					Key    float64 `json:"key"`
+					Values struct {
+						Buckets []struct {
+							Key      float64 `json:"key"`
+							DocCount int64   `json:"doc_count"`
+						} `json:"buckets"`
+					} `json:"values"`
+				} `json:"buckets"`
+			} `json:"over_time"`
+		} `json:"aggregations"`
+	}
+	err = searchDistribution(ctx, exec, query(
+		map[string]interface{}{
+			"values": map[string]interface{}{
+				"histogram": map[string]interface{}{
+					"field":    metricName,
+					"interval": interval,
+					"offset":   offset,
+				},
+			},
+		},
+		map[string]interface{}{},
+	), &heatResp)
+	if err != nil {
+		return nil, err
+	}
+
+	heatmap := Heatmap{Min: minVal, Interval: interval, Counts: make([][]int64, rows)}
+	timeBuckets := heatResp.Aggregations.OverTime.Buckets
+	for r := range heatmap.Counts {
+		heatmap.Counts[r] = make([]int64, len(timeBuckets))
+	}
+	for col, tb := range timeBuckets {
+		heatmap.Times = append(heatmap.Times, time.UnixMilli(int64(tb.Key)))
+		for _, vb := range tb.Values.Buckets {
+			// Rounding keeps keys that are a hair below a row bound in that row
+			row := int(math.Floor((vb.Key-minVal)/interval + 1e-9))
+			if row < 0 {
+				row = 0
+			}
+			if row >= rows {
+				row = rows - 1 // The maximum itself starts a row of its own
+			}
+			heatmap.Counts[row][col] += vb.DocCount
+		}
+	}
+	dist.Heatmap = heatmap
+	return dist, nil
+}
+
+// percentileValue looks up percentile p in a percentiles aggregation response,
+// whose keys are formatted like "50.0"
+func percentileValue(values map[string]*float64, p float64) (float64, bool) {
+	for key, v := range values {
+		k, err := strconv.ParseFloat(key, 64)
+		if err == nil && k == p && v != nil {
+			return *v, true
+		}
+	}
+	return 0, false
+}
+
+// searchDistribution runs a distribution query and decodes its response into out
+func searchDistribution(ctx context.Context, exec Executor, query map[string]interface{}, out interface{}) error {
+	queryJSON, err := json.Marshal(query)
+	if err != nil {
+		return fmt.Errorf("failed to marshal distribution query: %w", err)
+	}
+
+	res, err := exec.SearchForMetrics(ctx, exec.GetIndex(), queryJSON, 0)
+	if err != nil {
+		return fmt.Errorf("failed to execute distribution query: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError {
+		body, _ := io.ReadAll(res.Body)
+		return errfmt.FormatQueryError(res.Status, body, queryJSON)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+func TestGetDistribution(t *testing.T) {
+	response := func(body string) *shared.SearchResponse {
+		return &shared.SearchResponse{Body: io.NopCloser(strings.NewReader(body)), StatusCode: 200, Status: "200 OK"}
+	}
+	mock := &mockExecutor{
+		index: "metrics-*",
+		searchQueue: []*shared.SearchResponse{
+			response(`{"aggregations": {
+				"min": {"value": 0},
+				"max": {"value": 100},
+				"over_time": {"buckets": [
+					{"key": 1700000000000, "doc_count": 10, "percentiles": {"values": {"50.0": 20, "95.0": 80, "99.0": 95}}},
+					{"key": 1700000060000, "doc_count": 0, "percentiles": {"values": {"50.0": null, "95.0": null, "99.0": null}}}
+				]}
+			}}`),
+			response(`{"aggregations": {"over_time": {"buckets": [
+				{"key": 1700000000000, "values": {"buckets": [
+					{"key": 0, "doc_count": 6},
+					{"key": 40, "doc_count": 3},
+					{"key": 100, "doc_count": 1}
+				]}},
+				{"key": 1700000060000, "values": {"buckets": []}}
+			]}}}`),
+		},
+	}
+
+	dist, err := GetDistribution(context.Background(), mock, "metrics.http.server.duration", AggregateMetricsOptions{BucketSize: "1m"}, 5)
+	if err != nil {
+		t.Fatalf("GetDistribution failed: %v", err)
+	}
+
+	if len(dist.Percentiles) != 3 || dist.Percentiles[0].Key != "p50" || dist.Percentiles[2].Key != "p99" {
+		t.Fatalf("Expected p50/p95/p99 series, got %+v", dist.Percentiles)
+	}
+	// Buckets without values are left out of the percentile series
+	if p95 := dist.Percentiles[1]; len(p95.Buckets) != 1 || p95.Buckets[0].Value != 80 {
+		t.Errorf("Unexpected p95 series: %+v", p95)
+	}
+
+	h := dist.Heatmap
+	if len(h.Counts) != 5 || h.Interval != 20 || len(h.Times) != 2 {
+		t.Fatalf("Expected 5 rows of 20 over 2 columns, got rows=%d interval=%v columns=%d", len(h.Counts), h.Interval, len(h.Times))
+	}
+	// 0 -> row 0, 40 -> row 2, the maximum joins the top row
+	if h.Counts[0][0] != 6 || h.Counts[2][0] != 3 || h.Counts[4][0] != 1 || h.Counts[0][1] != 0 {
+		t.Errorf("Unexpected heatmap counts: %v", h.Counts)
+	}
+
+	// The heatmap query splits the value range with a histogram aggregation
+	var query map[string]interface{}
+	if err := json.Unmarshal(mock.lastSearchBody, &query); err != nil {
+		t.Fatalf("Failed to parse query: %v", err)
+	}
+	hist := query["aggs"].(map[string]interface{})["over_time"].(map[string]interface{})["aggs"].(map[string]interface{})["values"].(map[string]interface{})["histogram"].(map[string]interface{})
+	if hist["interval"] != float64(20) || hist["field"] != "metrics.http.server.duration" {
+		t.Errorf("Unexpected histogram aggregation: %v", hist)
+	}
+}
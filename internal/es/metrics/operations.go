@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,20 +16,60 @@ import (
 	"github.com/elastic/elasticat/internal/es/shared"
 )
 
+// DefaultMetricsPageSize is the number of metrics aggregated per page when
+// AggregateMetricsOptions.Limit is unset
+const DefaultMetricsPageSize = 50
+
 // GetFieldNames discovers metric field names from field_caps API
 // Returns only aggregatable numeric fields under the "metrics.*" namespace
 func GetFieldNames(ctx context.Context, exec Executor, index string) ([]MetricFieldInfo, error) {
-	return getFieldNamesFiltered(ctx, exec, index, false)
+	return getFieldNamesFiltered(ctx, exec, index, "", false)
 }
 
 // GetFieldNamesForESQL discovers metric field names compatible with ES|QL aggregations.
 // ES|QL cannot aggregate counter types or histogram fields.
 func GetFieldNamesForESQL(ctx context.Context, exec Executor, index string) ([]MetricFieldInfo, error) {
-	return getFieldNamesFiltered(ctx, exec, index, true)
+	return getFieldNamesFiltered(ctx, exec, index, "", true)
+}
+
+// fieldCapsPattern returns the field_caps patterns of the metric fields whose
+// name may contain nameFilter, so most filtering happens server-side. Patterns
+// match case-sensitively: the filter is tried as typed, in lower and in upper
+// case, and filterFieldsByName keeps the case-insensitive matches.
+func fieldCapsPattern(nameFilter string) string {
+	// Commas would split the pattern into several patterns
+	nameFilter = strings.ReplaceAll(strings.TrimSpace(nameFilter), ",", "")
+	if nameFilter == "" {
+		return "metrics.*"
+	}
+	var patterns []string
+	for _, v := range []string{nameFilter, strings.ToLower(nameFilter), strings.ToUpper(nameFilter)} {
+		if p := "metrics.*" + v + "*"; !slices.Contains(patterns, p) {
+			patterns = append(patterns, p)
+		}
+	}
+	return strings.Join(patterns, ",")
 }
 
-func getFieldNamesFiltered(ctx context.Context, exec Executor, index string, esqlCompatible bool) ([]MetricFieldInfo, error) {
-	res, err := exec.FieldCaps(ctx, index, "metrics.*")
+// filterFieldsByName keeps the fields whose name or short name contains
+// nameFilter, ignoring case
+func filterFieldsByName(fields []MetricFieldInfo, nameFilter string) []MetricFieldInfo {
+	filter := strings.ToLower(strings.TrimSpace(nameFilter))
+	if filter == "" {
+		return fields
+	}
+	var filtered []MetricFieldInfo
+	for _, f := range fields {
+		if strings.Contains(strings.ToLower(f.Name), filter) ||
+			strings.Contains(strings.ToLower(f.ShortName), filter) {
+			filtered = append(filtered, f)
+		}
+	}
+	return filtered
+}
+
+func getFieldNamesFiltered(ctx context.Context, exec Executor, index, nameFilter string, esqlCompatible bool) ([]MetricFieldInfo, error) {
+	res, err := exec.FieldCaps(ctx, index, fieldCapsPattern(nameFilter))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get metric field caps: %w", err)
 	}
@@ -57,6 +98,11 @@ func getFieldNamesFiltered(ctx context.Context, exec Executor, index string, esq
 				}
 			}
 
+			var temporality string
+			if info.TimeSeriesMetric == "counter" {
+				temporality = counterTemporality(info.Meta)
+			}
+
 			// Filter to supported numeric types
 			switch info.Type {
 			case "long", "double", "float", "half_float", "scaled_float", "aggregate_metric_double":
@@ -69,6 +115,7 @@ func getFieldNamesFiltered(ctx context.Context, exec Executor, index string, esq
 					ShortName:      shortName,
 					Type:           info.Type,
 					TimeSeriesType: info.TimeSeriesMetric,
+					Temporality:    temporality,
 				})
 			case "histogram":
 				// Only include histogram for non-ES|QL (Query DSL) path
@@ -92,7 +139,7 @@ func getFieldNamesFiltered(ctx context.Context, exec Executor, index string, esq
 	// Sort by name for consistent display
 	SortFields(metricFields)
 
-	return metricFields, nil
+	return filterFieldsByName(metricFields, nameFilter), nil
 }
 
 // SortFields sorts metric fields by short name
@@ -106,7 +153,10 @@ func SortFields(fields []MetricFieldInfo) {
 	}
 }
 
-// Aggregate retrieves aggregated statistics for all discovered metrics
+// Aggregate retrieves aggregated statistics for one page of the discovered metrics.
+// Metric names are discovered with field_caps and narrowed to opts.NameFilter, and
+// only the opts.Offset/opts.Limit page of them is aggregated. The result's Total
+// is the number of matching metrics, so callers can fetch further pages lazily.
 // NOTE: This path intentionally remains Query DSL-based. The ES|QL surface
 // cannot yet express the dynamic field discovery plus extended_stats/date
 // histogram combination we build here without multiple client-side joins,
@@ -115,19 +165,22 @@ func Aggregate(ctx context.Context, exec Executor, opts AggregateMetricsOptions)
 	index := exec.GetIndex()
 
 	// Discover metrics
-	metricFields, err := GetFieldNames(ctx, exec, index)
+	metricFields, err := getFieldNamesFiltered(ctx, exec, index, opts.NameFilter, false)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(metricFields) == 0 {
-		return &MetricsAggResult{Metrics: []AggregatedMetric{}, BucketSize: opts.BucketSize}, nil
+	if len(opts.MetricNames) > 0 {
+		metricFields = selectFields(metricFields, opts.MetricNames)
 	}
 
-	// Limit to 50 metrics to avoid huge queries
-	maxMetrics := 50
-	if len(metricFields) > maxMetrics {
-		metricFields = metricFields[:maxMetrics]
+	// Aggregate a single page to keep the query small
+	total := len(metricFields)
+	metricFields = pageFields(metricFields, opts.Offset, opts.Limit)
+	nextOffset := opts.Offset + len(metricFields)
+
+	if len(metricFields) == 0 {
+		return &MetricsAggResult{Metrics: []AggregatedMetric{}, BucketSize: opts.BucketSize, Total: total, NextOffset: total}, nil
 	}
 
 	// Build aggregation query
@@ -192,18 +245,43 @@ func Aggregate(ctx context.Context, exec Executor, opts AggregateMetricsOptions)
 					"field": mf.Name,
 				},
 			}
+			overTimeAggs := map[string]interface{}{
+				"value": map[string]interface{}{
+					"avg": map[string]interface{}{
+						"field": mf.Name,
+					},
+				},
+			}
+			// Counters also need increments or per-series totals to derive rates
+			if mf.TimeSeriesType == "counter" {
+				overTimeAggs = counterOverTimeAggs(mf.Name, mf.Temporality)
+				if mf.Temporality == TemporalityCumulative {
+					subAggs["series"] = counterSeriesAgg(mf.Name, opts.BucketSize)
+				}
+			}
 			subAggs["over_time"] = map[string]interface{}{
 				"date_histogram": map[string]interface{}{
 					"field":          "@timestamp",
 					"fixed_interval": opts.BucketSize,
 				},
-				"aggs": map[string]interface{}{
-					"value": map[string]interface{}{
-						"avg": map[string]interface{}{
-							"field": mf.Name,
-						},
-					},
+				"aggs": overTimeAggs,
+			}
+		}
+
+		// Split the series by a dimension: top values by document count, each with its own time series
+		if opts.GroupBy != "" {
+			groupAggs := map[string]interface{}{
+				"over_time": subAggs["over_time"],
+			}
+			if series, ok := subAggs["series"]; ok {
+				groupAggs["series"] = series
+			}
+			subAggs["groups"] = map[string]interface{}{
+				"terms": map[string]interface{}{
+					"field": opts.GroupBy,
+					"size":  groupByLimit(opts),
 				},
+				"aggs": groupAggs,
 			}
 		}
 
@@ -221,9 +299,45 @@ func Aggregate(ctx context.Context, exec Executor, opts AggregateMetricsOptions)
 		"size": 0,
 		"aggs": aggs,
 	}
+	if boolQuery := buildBoolFilter(opts); boolQuery != nil {
+		query["query"] = boolQuery
+	}
+
+	queryJSON, err := json.Marshal(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal aggregation query: %w", err)
+	}
+
+	res, err := exec.SearchForMetrics(ctx, index, queryJSON, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute aggregation: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError {
+		body, _ := io.ReadAll(res.Body)
+		return nil, errfmt.FormatQueryError(res.Status, body, queryJSON)
+	}
+
+	result, err := parseAggResponse(res.Body, metricFields, opts.BucketSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Total = total
+	result.NextOffset = nextOffset
 
+	// Generate an ES|QL query for Kibana integration (simpler than the full DSL query)
+	result.Query = generateKibanaESQLQuery(index, opts)
+
+	return result, nil
+}
+
+// buildBoolFilter builds the time range, service and resource filters shared by
+// the metrics queries. Returns nil when no filter applies.
+func buildBoolFilter(opts AggregateMetricsOptions, extra ...interface{}) map[string]interface{} {
 	// Build filters array
-	var filters []interface{}
+	filters := append([]interface{}{}, extra...)
 	var mustNot []interface{}
 
 	// Add time range filter if specified
@@ -265,45 +379,60 @@ func Aggregate(ctx context.Context, exec Executor, opts AggregateMetricsOptions)
 		}
 	}
 
-	// Add filters to query if any exist
-	if len(filters) > 0 || len(mustNot) > 0 {
-		boolQuery := map[string]interface{}{}
-		if len(filters) > 0 {
-			boolQuery["filter"] = filters
-		}
-		if len(mustNot) > 0 {
-			boolQuery["must_not"] = mustNot
-		}
-		query["query"] = map[string]interface{}{
-			"bool": boolQuery,
-		}
+	if len(filters) == 0 && len(mustNot) == 0 {
+		return nil
 	}
-
-	queryJSON, err := json.Marshal(query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal aggregation query: %w", err)
+	boolQuery := map[string]interface{}{}
+	if len(filters) > 0 {
+		boolQuery["filter"] = filters
 	}
-
-	res, err := exec.SearchForMetrics(ctx, index, queryJSON, 0)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute aggregation: %w", err)
+	if len(mustNot) > 0 {
+		boolQuery["must_not"] = mustNot
 	}
-	defer res.Body.Close()
-
-	if res.IsError {
-		body, _ := io.ReadAll(res.Body)
-		return nil, errfmt.FormatQueryError(res.Status, body, queryJSON)
+	return map[string]interface{}{
+		"bool": boolQuery,
 	}
+}
 
-	result, err := parseAggResponse(res.Body, metricFields, opts.BucketSize)
-	if err != nil {
-		return nil, err
+// selectFields keeps the discovered fields whose name is in names, preserving discovery order
+func selectFields(fields []MetricFieldInfo, names []string) []MetricFieldInfo {
+	wanted := make(map[string]bool, len(names))
+	for _, n := range names {
+		wanted[n] = true
 	}
+	var selected []MetricFieldInfo
+	for _, f := range fields {
+		if wanted[f.Name] {
+			selected = append(selected, f)
+		}
+	}
+	return selected
+}
 
-	// Generate an ES|QL query for Kibana integration (simpler than the full DSL query)
-	result.Query = generateKibanaESQLQuery(index, opts)
+// pageFields returns the fields of the page starting at offset.
+// A limit of 0 means DefaultMetricsPageSize.
+func pageFields(fields []MetricFieldInfo, offset, limit int) []MetricFieldInfo {
+	if limit <= 0 {
+		limit = DefaultMetricsPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(fields) {
+		return nil
+	}
+	end := offset + limit
+	if end > len(fields) {
+		end = len(fields)
+	}
+	return fields[offset:end]
+}
 
-	return result, nil
+func groupByLimit(opts AggregateMetricsOptions) int {
+	if opts.GroupByLimit > 0 {
+		return opts.GroupByLimit
+	}
+	return DefaultGroupByLimit
 }
 
 // generateKibanaESQLQuery creates an ES|QL query for Kibana integration.
@@ -362,6 +491,7 @@ func parseAggResponse(body io.Reader, fields []MetricFieldInfo, bucketSize strin
 		Metrics:    make([]AggregatedMetric, 0, len(fields)),
 		BucketSize: bucketSize,
 	}
+	bucketDuration, _ := time.ParseDuration(bucketSize)
 
 	for i, mf := range fields {
 		aggName := fmt.Sprintf("m%d", i)
@@ -421,28 +551,22 @@ func parseAggResponse(body io.Reader, fields []MetricFieldInfo, bucketSize strin
 		}
 
 		// Extract time series buckets
-		if overTime, ok := metricAgg["over_time"].(map[string]interface{}); ok {
-			if buckets, ok := overTime["buckets"].([]interface{}); ok {
-				am.Buckets = make([]MetricBucket, 0, len(buckets))
+		am.Buckets = parseOverTime(metricAgg)
+
+		// Extract one series per dimension value when grouped
+		if groups, ok := metricAgg["groups"].(map[string]interface{}); ok {
+			if buckets, ok := groups["buckets"].([]interface{}); ok {
 				for _, b := range buckets {
-					bucket, ok := b.(map[string]interface{})
+					group, ok := b.(map[string]interface{})
 					if !ok {
 						continue
 					}
-					mb := MetricBucket{}
-					if keyMs, ok := bucket["key"].(float64); ok {
-						mb.Timestamp = time.UnixMilli(int64(keyMs))
+					series := MetricSeries{Key: fmt.Sprintf("%v", group["key"])}
+					if count, ok := group["doc_count"].(float64); ok {
+						series.Count = int64(count)
 					}
-					if count, ok := bucket["doc_count"].(float64); ok {
-						mb.Count = int64(count)
-					}
-					if value, ok := bucket["value"].(map[string]interface{}); ok {
-						// All metrics now use avg for over_time buckets
-						if v, ok := value["value"].(float64); ok {
-							mb.Value = v
-						}
-					}
-					am.Buckets = append(am.Buckets, mb)
+					series.Buckets = parseOverTime(group)
+					am.Series = append(am.Series, series)
 				}
 			}
 		}
@@ -476,12 +600,51 @@ func parseAggResponse(body io.Reader, fields []MetricFieldInfo, bucketSize strin
 			am.Latest = am.Avg
 		}
 
+		// Raw counter values are running totals or increments; show per-second rates instead
+		if mf.TimeSeriesType == "counter" && !isPreAggregated {
+			applyCounterRates(&am, metricAgg, bucketDuration, mf.Temporality)
+		}
+
 		result.Metrics = append(result.Metrics, am)
 	}
 
 	return result, nil
 }
 
+// parseOverTime extracts the over_time date histogram of an aggregation
+func parseOverTime(agg map[string]interface{}) []MetricBucket {
+	overTime, ok := agg["over_time"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	buckets, ok := overTime["buckets"].([]interface{})
+	if !ok {
+		return nil
+	}
+	result := make([]MetricBucket, 0, len(buckets))
+	for _, b := range buckets {
+		bucket, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		mb := MetricBucket{}
+		if keyMs, ok := bucket["key"].(float64); ok {
+			mb.Timestamp = time.UnixMilli(int64(keyMs))
+		}
+		if count, ok := bucket["doc_count"].(float64); ok {
+			mb.Count = int64(count)
+		}
+		if value, ok := bucket["value"].(map[string]interface{}); ok {
+			// All metrics now use avg for over_time buckets
+			if v, ok := value["value"].(float64); ok {
+				mb.Value = v
+			}
+		}
+		result = append(result, mb)
+	}
+	return result
+}
+
 // Note: extractNestedFloat has been replaced by shared.GetNestedFloat
 
 // AggregateESQL retrieves aggregated statistics for metrics using ES|QL.
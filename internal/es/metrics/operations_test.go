@@ -19,7 +19,9 @@ type mockExecutor struct {
 	index          string
 	fieldCapsResp  *shared.FieldCapsResponse
 	fieldCapsErr   error
+	lastFieldCaps  string
 	searchResponse *shared.SearchResponse
+	searchQueue    []*shared.SearchResponse // Served in order before searchResponse
 	searchErr      error
 	lastSearchBody []byte
 	esqlResult     *shared.ESQLResult
@@ -30,6 +32,7 @@ func (m *mockExecutor) GetIndex() string {
 }
 
 func (m *mockExecutor) FieldCaps(ctx context.Context, index, fields string) (*shared.FieldCapsResponse, error) {
+	m.lastFieldCaps = fields
 	if m.fieldCapsErr != nil {
 		return nil, m.fieldCapsErr
 	}
@@ -41,6 +44,11 @@ func (m *mockExecutor) SearchForMetrics(ctx context.Context, index string, body
 	if m.searchErr != nil {
 		return nil, m.searchErr
 	}
+	if len(m.searchQueue) > 0 {
+		res := m.searchQueue[0]
+		m.searchQueue = m.searchQueue[1:]
+		return res, nil
+	}
 	return m.searchResponse, nil
 }
 
@@ -584,8 +592,8 @@ func TestAggregate_ErrorResponse(t *testing.T) {
 	}
 }
 
-func TestAggregate_LimitsMetrics(t *testing.T) {
-	// Create more than 50 fields to test the limit
+func TestAggregate_PagesMetrics(t *testing.T) {
+	// More fields than fit on one page
 	fields := make(map[string]map[string]shared.FieldCapsInfo)
 	for i := 0; i < 60; i++ {
 		name := "metrics.field" + string(rune('a'+i%26)) + string(rune('0'+i/26))
@@ -594,33 +602,105 @@ func TestAggregate_LimitsMetrics(t *testing.T) {
 		}
 	}
 
-	mock := &mockExecutor{
-		index:         "metrics-*",
-		fieldCapsResp: &shared.FieldCapsResponse{Fields: fields},
-		searchResponse: &shared.SearchResponse{
-			Body:       io.NopCloser(strings.NewReader(`{"aggregations": {}}`)),
-			StatusCode: 200,
-			Status:     "200 OK",
-			IsError:    false,
-		},
+	newMock := func() *mockExecutor {
+		return &mockExecutor{
+			index:         "metrics-*",
+			fieldCapsResp: &shared.FieldCapsResponse{Fields: fields},
+			searchResponse: &shared.SearchResponse{
+				Body:       io.NopCloser(strings.NewReader(`{"aggregations": {}}`)),
+				StatusCode: 200,
+				Status:     "200 OK",
+				IsError:    false,
+			},
+		}
 	}
 
-	opts := AggregateMetricsOptions{BucketSize: "1m"}
+	countAggs := func(t *testing.T, body []byte) int {
+		t.Helper()
+		var query map[string]interface{}
+		if err := json.Unmarshal(body, &query); err != nil {
+			t.Fatalf("Failed to parse query: %v", err)
+		}
+		return len(query["aggs"].(map[string]interface{}))
+	}
 
-	_, err := Aggregate(context.Background(), mock, opts)
+	// The first page holds DefaultMetricsPageSize metrics
+	mock := newMock()
+	result, err := Aggregate(context.Background(), mock, AggregateMetricsOptions{BucketSize: "1m"})
 	if err != nil {
 		t.Fatalf("Aggregate failed: %v", err)
 	}
+	if n := countAggs(t, mock.lastSearchBody); n != DefaultMetricsPageSize {
+		t.Errorf("Expected %d aggregations, got %d", DefaultMetricsPageSize, n)
+	}
+	if result.Total != 60 || !result.HasMore() {
+		t.Errorf("Expected 60 total metrics with more to fetch, got total=%d hasMore=%v", result.Total, result.HasMore())
+	}
 
-	// The query should only include 50 metrics
-	var query map[string]interface{}
-	if err := json.Unmarshal(mock.lastSearchBody, &query); err != nil {
-		t.Fatalf("Failed to parse query: %v", err)
+	// The next page holds the rest
+	mock = newMock()
+	result, err = Aggregate(context.Background(), mock, AggregateMetricsOptions{BucketSize: "1m", Offset: DefaultMetricsPageSize})
+	if err != nil {
+		t.Fatalf("Aggregate failed: %v", err)
 	}
+	if n := countAggs(t, mock.lastSearchBody); n != 10 {
+		t.Errorf("Expected 10 aggregations on the last page, got %d", n)
+	}
+	if result.NextOffset != 60 || result.HasMore() {
+		t.Errorf("Unexpected last page: nextOffset=%d hasMore=%v", result.NextOffset, result.HasMore())
+	}
+}
+
+func TestAggregate_NameFilterBeforePaging(t *testing.T) {
+	newMock := func() *mockExecutor {
+		return &mockExecutor{
+			index: "metrics-*",
+			fieldCapsResp: &shared.FieldCapsResponse{Fields: map[string]map[string]shared.FieldCapsInfo{
+				"metrics.system.CPU.usage":   {"double": {Type: "double", Aggregatable: true}},
+				"metrics.system.memory.used": {"long": {Type: "long", Aggregatable: true}},
+				"metrics.process.cpu*time":   {"double": {Type: "double", Aggregatable: true}},
+			}},
+			searchResponse: &shared.SearchResponse{
+				Body:       io.NopCloser(strings.NewReader(`{"aggregations": {}}`)),
+				StatusCode: 200,
+				Status:     "200 OK",
+			},
+		}
+	}
+
+	// Case-insensitive, and wildcard characters are matched literally
+	mock := newMock()
+	result, err := Aggregate(context.Background(), mock, AggregateMetricsOptions{BucketSize: "1m", NameFilter: "cpu", Limit: 1})
+	if err != nil {
+		t.Fatalf("Aggregate failed: %v", err)
+	}
+	if want := "metrics.*cpu*,metrics.*CPU*"; mock.lastFieldCaps != want {
+		t.Errorf("Expected field caps patterns %q, got %q", want, mock.lastFieldCaps)
+	}
+	if result.Total != 2 || !result.HasMore() {
+		t.Errorf("Expected 2 matching metrics paged by one, got total=%d nextOffset=%d", result.Total, result.NextOffset)
+	}
+
+	result, err = Aggregate(context.Background(), newMock(), AggregateMetricsOptions{BucketSize: "1m", NameFilter: "cpu*"})
+	if err != nil {
+		t.Fatalf("Aggregate failed: %v", err)
+	}
+	if result.Total != 1 {
+		t.Errorf("Expected only the literal 'cpu*' to match, got total=%d", result.Total)
+	}
+}
 
-	aggs := query["aggs"].(map[string]interface{})
-	if len(aggs) != 50 {
-		t.Errorf("Expected 50 aggregations, got %d", len(aggs))
+func TestFieldCapsPattern(t *testing.T) {
+	for filter, want := range map[string]string{
+		"":         "metrics.*",
+		" cpu ":    "metrics.*cpu*,metrics.*CPU*",
+		"Cpu":      "metrics.*Cpu*,metrics.*cpu*,metrics.*CPU*",
+		"http,get": "metrics.*httpget*,metrics.*HTTPGET*",
+		"42":       "metrics.*42*",
+	} {
+		if got := fieldCapsPattern(filter); got != want {
+			t.Errorf("fieldCapsPattern(%q) = %q, want %q", filter, got, want)
+		}
 	}
 }
 
@@ -3,7 +3,10 @@
 
 package metrics
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // MetricFieldInfo represents a discovered metric field from field_caps
 type MetricFieldInfo struct {
@@ -11,6 +14,7 @@ type MetricFieldInfo struct {
 	ShortName      string // Display name (e.g., "raradio.session.active")
 	Type           string // ES type: "long", "double", "histogram"
 	TimeSeriesType string // "gauge", "counter", or ""
+	Temporality    string // TemporalityCumulative or TemporalityDelta for counters, "" otherwise
 }
 
 // MetricBucket represents a single time bucket for a metric
@@ -31,6 +35,44 @@ type AggregatedMetric struct {
 	Latest    float64
 	LastSeen  time.Time      // Timestamp of the most recent data point
 	Buckets   []MetricBucket // Time series data for sparkline
+	Series    []MetricSeries // Per-dimension time series (only with AggregateMetricsOptions.GroupBy)
+
+	// Counters are reported as per-second rates: values, stats and buckets are rates
+	Rate        bool   // Values are per-second rates
+	Temporality string // TemporalityCumulative or TemporalityDelta for counters, "" otherwise
+	Partial     bool   // Rates sum only the CounterSeriesLimit busiest time series
+}
+
+// MetricSeries is the time series of a metric for one value of a dimension
+type MetricSeries struct {
+	Key     string         // Dimension value (e.g., "/api/users")
+	Count   int64          // Number of data points with this value
+	Buckets []MetricBucket // Time series data
+}
+
+// Dimension is an attribute that metric documents can be grouped by
+type Dimension struct {
+	Field    string // Full field path (e.g., "attributes.http.route")
+	DocCount int64  // Number of metric documents carrying the attribute
+}
+
+// ShortName returns the field without the "attributes." prefix
+func (d Dimension) ShortName() string {
+	return strings.TrimPrefix(d.Field, "attributes.")
+}
+
+// HistogramDistribution is the distribution of a histogram metric over time
+type HistogramDistribution struct {
+	Percentiles []MetricSeries // One series per DistributionPercentiles entry (Key "p50", "p95", ...)
+	Heatmap     Heatmap        // Value counts per time bucket and value range
+}
+
+// Heatmap counts the values of a histogram metric per time bucket and value range
+type Heatmap struct {
+	Times    []time.Time // Start of each column's time bucket
+	Min      float64     // Lower bound of row 0
+	Interval float64     // Width of the value range of each row
+	Counts   [][]int64   // Counts[row][column]; row 0 holds the lowest values
 }
 
 // MetricsAggResult contains all aggregated metrics
@@ -38,6 +80,13 @@ type MetricsAggResult struct {
 	Metrics    []AggregatedMetric
 	BucketSize string // ES interval (e.g., "10s", "1m")
 	Query      string // ES|QL query used (for Kibana integration)
+	Total      int    // Number of metrics matching the options, across all pages
+	NextOffset int    // Offset of the page after this one
+}
+
+// HasMore reports whether matching metrics remain after this page
+func (r *MetricsAggResult) HasMore() bool {
+	return r.NextOffset < r.Total
 }
 
 // AggregateMetricsOptions configures the metrics aggregation query
@@ -48,4 +97,12 @@ type AggregateMetricsOptions struct {
 	NegateService  bool   // If true, exclude Service instead of filtering to it
 	Resource       string // Filter by resource environment
 	NegateResource bool   // If true, exclude Resource instead of filtering to it
+
+	NameFilter string // Only metrics whose name contains this, as typed or in lower or upper case
+	Offset     int    // Skip this many matching metrics (sorted by short name)
+	Limit      int    // Aggregate at most this many metrics (0 = DefaultMetricsPageSize)
+
+	MetricNames  []string // Only aggregate these metric fields (empty = all discovered)
+	GroupBy      string   // Split each metric into one series per value of this field (Query DSL path only)
+	GroupByLimit int      // Top N values of GroupBy by document count (0 = DefaultGroupByLimit)
 }
@@ -0,0 +1,15 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package patterns
+
+import "github.com/elastic/elasticat/internal/es/shared"
+
+// Executor defines the Elasticsearch operations needed for log patterns
+type Executor interface {
+	// Embed ESQLExecutor for common ES|QL query execution
+	shared.ESQLExecutor
+
+	// GetIndex returns the current index pattern
+	GetIndex() string
+}
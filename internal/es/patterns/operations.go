@@ -0,0 +1,220 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package patterns groups log messages into categories using the ES|QL
+// CATEGORIZE grouping function.
+package patterns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+// DefaultLimit is the number of patterns returned when Options.Limit is unset
+const DefaultLimit = 30
+
+// MessageExpr is the ES|QL expression used as the message text to categorize.
+// TO_STRING normalises text/keyword mappings so COALESCE accepts them.
+const MessageExpr = "COALESCE(TO_STRING(body.text), TO_STRING(message), TO_STRING(event_name))"
+
+// LevelExpr normalises the severity field for per-level breakdowns.
+const LevelExpr = `TO_UPPER(COALESCE(TO_STRING(severity_text), TO_STRING(log.level), ""))`
+
+// Categorize returns the most frequent message patterns for the current index
+func Categorize(ctx context.Context, exec Executor, opts Options) (*PatternsResult, error) {
+	res, query, err := shared.ExecuteESQLDroppingUnknownIndices(ctx, exec, exec.GetIndex(), func(from string) string {
+		return buildQuery(from, opts)
+	})
+	if err != nil {
+		if shared.IsESQLEmptyStateError(err) {
+			return &PatternsResult{Patterns: []PatternAgg{}, Query: query}, nil
+		}
+		return nil, fmt.Errorf("ES|QL pattern query failed: %w", err)
+	}
+	if res == nil {
+		return &PatternsResult{Patterns: []PatternAgg{}, Query: query}, nil
+	}
+
+	return &PatternsResult{Patterns: parseResult(res), Query: query}, nil
+}
+
+func buildQuery(from string, opts Options) string {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	whereParts := []string{}
+	if opts.Lookback != "" {
+		whereParts = append(whereParts, fmt.Sprintf("@timestamp >= NOW() - %s", shared.LookbackToESQLInterval(opts.Lookback)))
+	}
+	if opts.Service != "" {
+		op := "=="
+		if opts.NegateService {
+			op = "!="
+		}
+		whereParts = append(whereParts, fmt.Sprintf("service.name %s \"%s\"", op, shared.EscapeESQLString(opts.Service)))
+	}
+	if opts.Resource != "" {
+		op := "=="
+		if opts.NegateResource {
+			op = "!="
+		}
+		whereParts = append(whereParts, fmt.Sprintf("resource.attributes.deployment.environment %s \"%s\"", op, shared.EscapeESQLString(opts.Resource)))
+	}
+	if opts.Level != "" {
+		whereParts = append(whereParts, fmt.Sprintf("lvl == \"%s\"", shared.EscapeESQLString(strings.ToUpper(opts.Level))))
+	}
+	for _, f := range opts.FieldFilters {
+		if f.Field != "" {
+			whereParts = append(whereParts, f.ESQL())
+		}
+	}
+	whereParts = append(whereParts, "msg IS NOT NULL")
+
+	return fmt.Sprintf(`FROM %s
+| EVAL msg = %s, lvl = %s
+| WHERE %s
+| STATS
+    count = COUNT(*),
+    first_seen = MIN(@timestamp),
+    last_seen = MAX(@timestamp),
+    errors = COUNT(CASE(lvl IN ("ERROR", "FATAL", "CRITICAL"), 1, null)),
+    warns = COUNT(CASE(lvl IN ("WARN", "WARNING"), 1, null)),
+    infos = COUNT(CASE(lvl == "INFO", 1, null)),
+    debugs = COUNT(CASE(lvl IN ("DEBUG", "TRACE"), 1, null))
+  BY pattern = CATEGORIZE(msg)
+| SORT count DESC
+| LIMIT %d`, from, MessageExpr, LevelExpr, strings.Join(whereParts, " AND "), limit)
+}
+
+func parseResult(res *shared.ESQLResult) []PatternAgg {
+	colIndex := map[string]int{}
+	for i, col := range res.Columns {
+		colIndex[col.Name] = i
+	}
+
+	getInt := func(row []interface{}, name string) int64 {
+		idx, ok := colIndex[name]
+		if !ok || idx >= len(row) {
+			return 0
+		}
+		if v, ok := row[idx].(float64); ok {
+			return int64(v)
+		}
+		return 0
+	}
+
+	getString := func(row []interface{}, name string) string {
+		idx, ok := colIndex[name]
+		if !ok || idx >= len(row) {
+			return ""
+		}
+		if v, ok := row[idx].(string); ok {
+			return v
+		}
+		return ""
+	}
+
+	getTime := func(row []interface{}, name string) time.Time {
+		if ts := getString(row, name); ts != "" {
+			if parsed, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+				return parsed
+			}
+		}
+		return time.Time{}
+	}
+
+	out := make([]PatternAgg, 0, len(res.Values))
+	for _, row := range res.Values {
+		pattern := getString(row, "pattern")
+		if pattern == "" {
+			continue
+		}
+		agg := PatternAgg{
+			Pattern:   pattern,
+			Count:     getInt(row, "count"),
+			FirstSeen: getTime(row, "first_seen"),
+			LastSeen:  getTime(row, "last_seen"),
+			Levels: LevelCounts{
+				Error: getInt(row, "errors"),
+				Warn:  getInt(row, "warns"),
+				Info:  getInt(row, "infos"),
+				Debug: getInt(row, "debugs"),
+			},
+		}
+		known := agg.Levels.Error + agg.Levels.Warn + agg.Levels.Info + agg.Levels.Debug
+		if agg.Count > known {
+			agg.Levels.Other = agg.Count - known
+		}
+		out = append(out, agg)
+	}
+	return out
+}
+
+// Tokens extracts the literal tokens from a CATEGORIZE key.
+// Keys look like ".*?Connected.+?to.+?db.*?": tokens separated by lazy
+// wildcards, with regex metacharacters escaped by a backslash or \Q...\E.
+func Tokens(pattern string) []string {
+	var tokens []string
+	var cur strings.Builder
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+
+	for i := 0; i < len(pattern); {
+		switch {
+		case strings.HasPrefix(pattern[i:], ".*?"), strings.HasPrefix(pattern[i:], ".+?"):
+			flush()
+			i += 3
+		case strings.HasPrefix(pattern[i:], `\Q`):
+			end := strings.Index(pattern[i+2:], `\E`)
+			if end < 0 {
+				cur.WriteString(pattern[i+2:])
+				i = len(pattern)
+				continue
+			}
+			cur.WriteString(pattern[i+2 : i+2+end])
+			i += 2 + end + 2
+		case pattern[i] == '\\' && i+1 < len(pattern):
+			cur.WriteByte(pattern[i+1])
+			i += 2
+		default:
+			cur.WriteByte(pattern[i])
+			i++
+		}
+	}
+	flush()
+	return tokens
+}
+
+// Template returns the literal tokens of a CATEGORIZE key joined by spaces
+func Template(pattern string) string {
+	return strings.Join(Tokens(pattern), " ")
+}
+
+// LikeClause returns an ES|QL condition matching documents whose message fits
+// the CATEGORIZE key. Returns "" if the key has no literal tokens.
+func LikeClause(pattern string) string {
+	tokens := Tokens(pattern)
+	if len(tokens) == 0 {
+		return ""
+	}
+
+	// LIKE wildcards (* and ?) and backslashes inside tokens are replaced by a
+	// single-character wildcard so they match literally without escaping.
+	escaper := strings.NewReplacer("*", "?", "\\", "?")
+	parts := make([]string, len(tokens))
+	for i, tok := range tokens {
+		parts[i] = escaper.Replace(tok)
+	}
+	like := "*" + strings.Join(parts, "*") + "*"
+	return fmt.Sprintf("COALESCE(%s, \"\") LIKE \"%s\"", MessageExpr, shared.EscapeESQLString(like))
+}
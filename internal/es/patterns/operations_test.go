@@ -0,0 +1,149 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package patterns
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+// mockExecutor implements the Executor interface for testing
+type mockExecutor struct {
+	index         string
+	esqlResult    *shared.ESQLResult
+	esqlErr       error
+	lastESQLQuery string
+}
+
+func (m *mockExecutor) ExecuteESQLQuery(ctx context.Context, query string) (*shared.ESQLResult, error) {
+	m.lastESQLQuery = query
+	if m.esqlErr != nil {
+		return nil, m.esqlErr
+	}
+	return m.esqlResult, nil
+}
+
+func (m *mockExecutor) GetIndex() string {
+	return m.index
+}
+
+func TestCategorize_Success(t *testing.T) {
+	mock := &mockExecutor{
+		index: "logs-*",
+		esqlResult: &shared.ESQLResult{
+			Columns: []shared.ESQLColumn{
+				{Name: "count", Type: "long"},
+				{Name: "first_seen", Type: "date"},
+				{Name: "last_seen", Type: "date"},
+				{Name: "errors", Type: "long"},
+				{Name: "warns", Type: "long"},
+				{Name: "infos", Type: "long"},
+				{Name: "debugs", Type: "long"},
+				{Name: "pattern", Type: "keyword"},
+			},
+			Values: [][]interface{}{
+				{float64(120), "2026-01-02T10:00:00.000Z", "2026-01-02T11:00:00.000Z", float64(100), float64(0), float64(10), float64(0), ".*?Connection.+?refused.*?"},
+				{float64(5), "2026-01-02T10:30:00.000Z", "2026-01-02T10:45:00.000Z", float64(0), float64(0), float64(5), float64(0), ".*?Started.*?"},
+				{float64(1), nil, nil, float64(0), float64(0), float64(0), float64(0), nil},
+			},
+		},
+	}
+
+	result, err := Categorize(context.Background(), mock, Options{Lookback: "now-1h", Service: "api"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Patterns) != 2 {
+		t.Fatalf("expected 2 patterns (null key skipped), got %d", len(result.Patterns))
+	}
+
+	first := result.Patterns[0]
+	if first.Count != 120 {
+		t.Errorf("expected count 120, got %d", first.Count)
+	}
+	if first.Levels.Error != 100 || first.Levels.Info != 10 || first.Levels.Other != 10 {
+		t.Errorf("unexpected level breakdown: %+v", first.Levels)
+	}
+	if !first.FirstSeen.Equal(time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected first seen: %v", first.FirstSeen)
+	}
+	if first.Template() != "Connection refused" {
+		t.Errorf("unexpected template: %q", first.Template())
+	}
+
+	for _, want := range []string{"FROM logs-*", "CATEGORIZE(msg)", `service.name == "api"`, "LIMIT 30"} {
+		if !strings.Contains(mock.lastESQLQuery, want) {
+			t.Errorf("expected query to contain %q, got:\n%s", want, mock.lastESQLQuery)
+		}
+	}
+	if result.Query != mock.lastESQLQuery {
+		t.Errorf("expected result query to match executed query")
+	}
+}
+
+func TestCategorize_EmptyState(t *testing.T) {
+	mock := &mockExecutor{
+		index:   "logs-*",
+		esqlErr: &shared.ESQLUnknownIndexError{Index: "logs-*"},
+	}
+
+	result, err := Categorize(context.Background(), mock, Options{})
+	if err != nil {
+		t.Fatalf("expected empty state, got error: %v", err)
+	}
+	if len(result.Patterns) != 0 {
+		t.Errorf("expected no patterns, got %d", len(result.Patterns))
+	}
+}
+
+func TestCategorize_Error(t *testing.T) {
+	mock := &mockExecutor{
+		index:   "logs-*",
+		esqlErr: errors.New("boom"),
+	}
+
+	if _, err := Categorize(context.Background(), mock, Options{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestTokens(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		want    []string
+	}{
+		{"simple", ".*?Connection.+?refused.*?", []string{"Connection", "refused"}},
+		{"backslash escapes", `.*?GET.+?/api/users\?id.*?`, []string{"GET", "/api/users?id"}},
+		{"quoted block", `.*?\Q[main]\E.+?started.*?`, []string{"[main]", "started"}},
+		{"only wildcards", ".*?", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Tokens(tt.pattern)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("Tokens(%q) = %v, want %v", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLikeClause(t *testing.T) {
+	got := LikeClause(`.*?user.+?"bob".+?a*b.*?`)
+	want := `COALESCE(` + MessageExpr + `, "") LIKE "*user*\"bob\"*a?b*"`
+	if got != want {
+		t.Errorf("LikeClause() = %q, want %q", got, want)
+	}
+
+	if got := LikeClause(".*?"); got != "" {
+		t.Errorf("expected empty clause for token-less pattern, got %q", got)
+	}
+}
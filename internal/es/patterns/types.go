@@ -0,0 +1,51 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package patterns
+
+import (
+	"time"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+// LevelCounts breaks down a pattern's document count by severity
+type LevelCounts struct {
+	Error int64 // ERROR, FATAL, CRITICAL
+	Warn  int64 // WARN, WARNING
+	Info  int64 // INFO
+	Debug int64 // DEBUG, TRACE
+	Other int64 // Missing or unrecognised level
+}
+
+// PatternAgg represents aggregated statistics for one message category
+type PatternAgg struct {
+	Pattern   string      // Raw CATEGORIZE key (e.g., ".*?Connected.+?to.+?db.*?")
+	Count     int64       // Number of matching documents
+	FirstSeen time.Time   // Timestamp of the oldest matching document
+	LastSeen  time.Time   // Timestamp of the newest matching document
+	Levels    LevelCounts // Per-level breakdown of Count
+}
+
+// Template returns the human-readable form of the pattern (its literal tokens)
+func (p PatternAgg) Template() string {
+	return Template(p.Pattern)
+}
+
+// PatternsResult contains the result of a pattern categorization query
+type PatternsResult struct {
+	Patterns []PatternAgg
+	Query    string // ES|QL query used (for display/Kibana integration)
+}
+
+// Options configures a pattern categorization query
+type Options struct {
+	Lookback       string // ES time range string like "now-1h"
+	Service        string
+	NegateService  bool
+	Resource       string // Filter on resource.attributes.deployment.environment
+	NegateResource bool
+	Level          string
+	FieldFilters   []shared.FieldFilter // Arbitrary field predicates
+	Limit          int                  // Maximum patterns to return (0 = DefaultLimit)
+}
@@ -6,7 +6,6 @@ package perspectives
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/elastic/elasticat/internal/es/shared"
 	"github.com/elastic/elasticat/internal/index"
@@ -37,28 +36,16 @@ func GetByField(ctx context.Context, exec Executor, lookback string, field strin
 | LIMIT 100`, fromPattern, lookbackInterval, field)
 	}
 
-	query := buildQuery(from)
-	var res *shared.ESQLResult
-	for {
-		var err error
-		res, err = exec.ExecuteESQLQuery(ctx, query)
-		if err != nil {
-			// Unknown index: remove that pattern and retry with remaining indices
-			if missing, ok := shared.IsESQLUnknownIndex(err); ok {
-				from = removeIndexPattern(from, missing)
-				if from == "" {
-					return []PerspectiveAgg{}, nil
-				}
-				query = buildQuery(from)
-				continue
-			}
-			// Other empty-state errors (e.g., unsupported field types): return empty
-			if shared.IsESQLEmptyStateError(err) {
-				return []PerspectiveAgg{}, nil
-			}
-			return nil, fmt.Errorf("ES|QL perspective query failed: %w", err)
+	res, _, err := shared.ExecuteESQLDroppingUnknownIndices(ctx, exec, from, buildQuery)
+	if err != nil {
+		// Other empty-state errors (e.g., unsupported field types): return empty
+		if shared.IsESQLEmptyStateError(err) {
+			return []PerspectiveAgg{}, nil
 		}
-		break
+		return nil, fmt.Errorf("ES|QL perspective query failed: %w", err)
+	}
+	if res == nil {
+		return []PerspectiveAgg{}, nil
 	}
 
 	colIndex := map[string]int{}
@@ -106,19 +93,6 @@ func GetByField(ctx context.Context, exec Executor, lookback string, field strin
 	return perspectiveList, nil
 }
 
-func removeIndexPattern(from string, missing string) string {
-	parts := strings.Split(from, ",")
-	out := make([]string, 0, len(parts))
-	for _, p := range parts {
-		p = strings.TrimSpace(p)
-		if p == "" || p == missing {
-			continue
-		}
-		out = append(out, p)
-	}
-	return strings.Join(out, ",")
-}
-
 // GetServices returns aggregated counts per service
 func GetServices(ctx context.Context, exec Executor, lookback string) ([]PerspectiveAgg, error) {
 	return GetByField(ctx, exec, lookback, "service.name")
@@ -112,7 +112,7 @@ func buildTailQuery(opts TailOptions) map[string]interface{} {
 	if opts.Lookback != "" {
 		fb.AddTimeRangeFilter(opts.Lookback, "")
 	} else if !opts.Since.IsZero() {
-		fb.AddTimeRangeFilter(opts.Since.Format(time.RFC3339), "")
+		fb.AddTimeRangeFilter(opts.Since.Format(time.RFC3339Nano), "")
 	}
 	// If both Lookback is empty and Since is zero, no time filter is applied (query all time)
 
@@ -123,6 +123,8 @@ func buildTailQuery(opts TailOptions) map[string]interface{} {
 	fb.AddProcessorEventFilter(opts.ProcessorEvent)
 	fb.AddTransactionNameFilter(opts.TransactionName)
 	fb.AddTraceIDFilter(opts.TraceID)
+	fb.AddSpanIDFilter(opts.SpanID)
+	fb.AddFieldFilters(opts.FieldFilters)
 
 	// Tail-specific filters
 	fb.AddPrefixFilter("container_id", opts.ContainerID)
@@ -150,10 +152,10 @@ func buildSearchQuery(queryStr string, opts SearchOptions) map[string]interface{
 	if opts.Lookback != "" {
 		gte = opts.Lookback
 	} else if !opts.From.IsZero() {
-		gte = opts.From.Format(time.RFC3339)
+		gte = opts.From.Format(time.RFC3339Nano)
 	}
 	if !opts.To.IsZero() {
-		lte = opts.To.Format(time.RFC3339)
+		lte = opts.To.Format(time.RFC3339Nano)
 	}
 	fb.AddTimeRangeFilter(gte, lte)
 
@@ -164,6 +166,8 @@ func buildSearchQuery(queryStr string, opts SearchOptions) map[string]interface{
 	fb.AddProcessorEventFilter(opts.ProcessorEvent)
 	fb.AddTransactionNameFilter(opts.TransactionName)
 	fb.AddTraceIDFilter(opts.TraceID)
+	fb.AddSpanIDFilter(opts.SpanID)
+	fb.AddFieldFilters(opts.FieldFilters)
 
 	if opts.Size == 0 {
 		opts.Size = 100
@@ -179,6 +183,7 @@ func parseSearchResponse(body io.Reader) (*SearchResult, error) {
 				Value int64 `json:"value"`
 			} `json:"total"`
 			Hits []struct {
+				ID     string          `json:"_id"`
 				Source json.RawMessage `json:"_source"`
 			} `json:"hits"`
 		} `json:"hits"`
@@ -203,6 +208,7 @@ func parseSearchResponse(body io.Reader) (*SearchResult, error) {
 			entry := extractLogEntry(raw)
 			// Store raw JSON (compact) for NDJSON output
 			entry.RawJSON = string(hit.Source)
+			entry.ID = hit.ID
 			result.Logs = append(result.Logs, entry)
 		}
 	}
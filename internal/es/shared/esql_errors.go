@@ -3,7 +3,10 @@
 
 package shared
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // ESQLUnknownIndexError indicates an ES|QL query failed because the FROM index pattern
 // matched no existing indices/data streams (ES returns a 400 verification_exception).
@@ -70,6 +73,34 @@ func IsESQLUnsupportedFieldType(err error) (string, string, bool) {
 	return "", "", false
 }
 
+// ESQLQueryError is any other ES|QL request failure. For parsing and
+// verification errors ES reports where in the query the problem is; Line and
+// Column (both 1-based) are zero when it doesn't.
+type ESQLQueryError struct {
+	Line    int    // line of the first reported problem
+	Column  int    // column of the first reported problem
+	Message string // the problem, without its "line L:C:" prefix
+	Status  string // HTTP status string, e.g. "400 Bad Request"
+	Body    string // raw ES error body (best-effort)
+	Query   string // the failed query
+}
+
+func (e *ESQLQueryError) Error() string {
+	if e == nil {
+		return "ES|QL query failed"
+	}
+	return fmt.Sprintf("ES|QL query failed: %s\nError: %s\n\nQuery:\n%s", e.Status, e.Body, e.Query)
+}
+
+// IsESQLQueryError returns the query error if err is (or wraps) an ESQLQueryError.
+func IsESQLQueryError(err error) (*ESQLQueryError, bool) {
+	var q *ESQLQueryError
+	if errors.As(err, &q) && q != nil {
+		return q, true
+	}
+	return nil, false
+}
+
 // IsESQLEmptyStateError returns true if the error represents an expected
 // empty-state condition (no data yet, unsupported field type, etc.)
 // rather than an actual failure. Callers can use this to return empty
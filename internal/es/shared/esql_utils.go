@@ -3,7 +3,11 @@
 
 package shared
 
-import "strings"
+import (
+	"context"
+	"strings"
+	"time"
+)
 
 // LookbackToESQLInterval converts a lookback string (e.g., "now-5m") to ES|QL format (e.g., "5 minutes").
 // ES|QL requires full unit names, not abbreviations.
@@ -36,8 +40,70 @@ func LookbackToESQLInterval(lookback string) string {
 	}
 }
 
+// LookbackToDuration returns the length of the time range of a lookback
+// string, with the same presets and 24 hour default as LookbackToESQLInterval.
+func LookbackToDuration(lookback string) time.Duration {
+	switch lookback {
+	case "now-5m":
+		return 5 * time.Minute
+	case "now-10m":
+		return 10 * time.Minute
+	case "now-15m":
+		return 15 * time.Minute
+	case "now-30m":
+		return 30 * time.Minute
+	case "now-1h":
+		return time.Hour
+	case "now-3h":
+		return 3 * time.Hour
+	case "now-6h":
+		return 6 * time.Hour
+	case "now-12h":
+		return 12 * time.Hour
+	case "now-1w":
+		return 7 * 24 * time.Hour
+	default:
+		return 24 * time.Hour // now-24h, now-1d and the default
+	}
+}
+
 // EscapeESQLString escapes special characters in a string for use in ES|QL queries.
 // Currently escapes double quotes which need to be escaped in ES|QL string literals.
 func EscapeESQLString(s string) string {
 	return strings.ReplaceAll(s, "\"", "\\\"")
 }
+
+// RemoveIndexPattern drops the missing pattern from a comma-separated list of
+// index patterns. It returns "" when no pattern is left.
+func RemoveIndexPattern(from string, missing string) string {
+	parts := strings.Split(from, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" || p == missing {
+			continue
+		}
+		out = append(out, p)
+	}
+	return strings.Join(out, ",")
+}
+
+// ExecuteESQLDroppingUnknownIndices runs the query build makes for the
+// comma-separated index patterns in from. ES|QL fails the whole query when one
+// pattern matches no index, so that pattern is dropped and the query run again
+// over the others. It returns the last query run, and a nil result without an
+// error when no pattern is left.
+func ExecuteESQLDroppingUnknownIndices(ctx context.Context, exec ESQLExecutor, from string, build func(from string) string) (*ESQLResult, string, error) {
+	query := build(from)
+	for {
+		res, err := exec.ExecuteESQLQuery(ctx, query)
+		if missing, ok := IsESQLUnknownIndex(err); ok {
+			if from = RemoveIndexPattern(from, missing); from == "" {
+				return nil, query, nil
+			}
+			query = build(from)
+			continue
+		}
+		return res, query, err
+	}
+}
@@ -4,7 +4,11 @@
 package shared
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestLookbackToESQLInterval(t *testing.T) {
@@ -52,6 +56,25 @@ func TestLookbackToESQLInterval(t *testing.T) {
 	}
 }
 
+func TestLookbackToDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]time.Duration{
+		"now-5m":  5 * time.Minute,
+		"now-1h":  time.Hour,
+		"now-12h": 12 * time.Hour,
+		"now-1d":  24 * time.Hour,
+		"now-1w":  7 * 24 * time.Hour,
+		"now-2h":  24 * time.Hour,
+		"":        24 * time.Hour,
+	}
+	for lookback, want := range tests {
+		if got := LookbackToDuration(lookback); got != want {
+			t.Errorf("LookbackToDuration(%q) = %v, want %v", lookback, got, want)
+		}
+	}
+}
+
 func TestEscapeESQLString(t *testing.T) {
 	t.Parallel()
 
@@ -416,3 +439,135 @@ func TestSplitPath(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveIndexPattern(t *testing.T) {
+	t.Parallel()
+
+	t.Run("removes single pattern", func(t *testing.T) {
+		t.Parallel()
+
+		result := RemoveIndexPattern("logs-*,traces-*,metrics-*", "traces-*")
+		if result != "logs-*,metrics-*" {
+			t.Errorf("expected 'logs-*,metrics-*', got %q", result)
+		}
+	})
+
+	t.Run("removes first pattern", func(t *testing.T) {
+		t.Parallel()
+
+		result := RemoveIndexPattern("logs-*,traces-*", "logs-*")
+		if result != "traces-*" {
+			t.Errorf("expected 'traces-*', got %q", result)
+		}
+	})
+
+	t.Run("removes last pattern", func(t *testing.T) {
+		t.Parallel()
+
+		result := RemoveIndexPattern("logs-*,traces-*", "traces-*")
+		if result != "logs-*" {
+			t.Errorf("expected 'logs-*', got %q", result)
+		}
+	})
+
+	t.Run("returns empty when all removed", func(t *testing.T) {
+		t.Parallel()
+
+		result := RemoveIndexPattern("logs-*", "logs-*")
+		if result != "" {
+			t.Errorf("expected empty string, got %q", result)
+		}
+	})
+
+	t.Run("handles whitespace", func(t *testing.T) {
+		t.Parallel()
+
+		result := RemoveIndexPattern("logs-* , traces-* , metrics-*", "traces-*")
+		if result != "logs-*,metrics-*" {
+			t.Errorf("expected 'logs-*,metrics-*', got %q", result)
+		}
+	})
+
+	t.Run("handles empty parts", func(t *testing.T) {
+		t.Parallel()
+
+		result := RemoveIndexPattern("logs-*,,traces-*", "")
+		if result != "logs-*,traces-*" {
+			t.Errorf("expected 'logs-*,traces-*', got %q", result)
+		}
+	})
+
+	t.Run("pattern not found returns original", func(t *testing.T) {
+		t.Parallel()
+
+		result := RemoveIndexPattern("logs-*,traces-*", "metrics-*")
+		if result != "logs-*,traces-*" {
+			t.Errorf("expected 'logs-*,traces-*', got %q", result)
+		}
+	})
+}
+
+// esqlExecutorFunc adapts a function to an ESQLExecutor.
+type esqlExecutorFunc func(ctx context.Context, query string) (*ESQLResult, error)
+
+func (f esqlExecutorFunc) ExecuteESQLQuery(ctx context.Context, query string) (*ESQLResult, error) {
+	return f(ctx, query)
+}
+
+func TestExecuteESQLDroppingUnknownIndices(t *testing.T) {
+	t.Parallel()
+
+	build := func(from string) string { return "FROM " + from }
+	// unknown fails the queries reading any of the given patterns
+	unknown := func(patterns ...string) (esqlExecutorFunc, *[]string) {
+		var queries []string
+		return func(_ context.Context, query string) (*ESQLResult, error) {
+			queries = append(queries, query)
+			for _, p := range patterns {
+				if strings.Contains(query, p) {
+					return nil, &ESQLUnknownIndexError{Index: p}
+				}
+			}
+			return EmptyESQLResult(), nil
+		}, &queries
+	}
+
+	t.Run("drops unknown patterns and retries", func(t *testing.T) {
+		t.Parallel()
+
+		exec, queries := unknown("traces-*", "metrics-*")
+		res, query, err := ExecuteESQLDroppingUnknownIndices(context.Background(), exec, "logs-*,traces-*,metrics-*", build)
+		if err != nil || res == nil {
+			t.Fatalf("expected a result, got %v, %v", res, err)
+		}
+		if query != "FROM logs-*" {
+			t.Errorf("query = %q, want FROM logs-*", query)
+		}
+		if len(*queries) != 3 {
+			t.Errorf("expected 3 attempts, got %q", *queries)
+		}
+	})
+
+	t.Run("returns no result when no pattern is left", func(t *testing.T) {
+		t.Parallel()
+
+		exec, _ := unknown("logs-*")
+		res, query, err := ExecuteESQLDroppingUnknownIndices(context.Background(), exec, "logs-*", build)
+		if err != nil || res != nil {
+			t.Fatalf("expected neither result nor error, got %v, %v", res, err)
+		}
+		if query != "FROM logs-*" {
+			t.Errorf("query = %q, want the last query run", query)
+		}
+	})
+
+	t.Run("returns other errors", func(t *testing.T) {
+		t.Parallel()
+
+		failed := errors.New("boom")
+		exec := esqlExecutorFunc(func(context.Context, string) (*ESQLResult, error) { return nil, failed })
+		if _, _, err := ExecuteESQLDroppingUnknownIndices(context.Background(), exec, "logs-*", build); !errors.Is(err, failed) {
+			t.Errorf("err = %v, want %v", err, failed)
+		}
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FieldOp is the kind of comparison a FieldFilter applies.
+type FieldOp string
+
+const (
+	FieldEquals FieldOp = "equals" // Field has Value
+	FieldExists FieldOp = "exists" // Field is present
+	FieldRange  FieldOp = "range"  // Field lies within [Gte, Lte]; either bound may be empty
+	FieldPrefix FieldOp = "prefix" // Field starts with Value
+)
+
+// FieldFilter is a predicate on an arbitrary document field.
+// Negate excludes matching documents instead, so a not-equals filter is
+// FieldEquals with Negate set. Excluded documents include those missing the
+// field, matching Kibana's "is not" filters.
+type FieldFilter struct {
+	Field  string  `json:"field" yaml:"field"`
+	Op     FieldOp `json:"op" yaml:"op"`
+	Value  string  `json:"value,omitempty" yaml:"value,omitempty"`
+	Gte    string  `json:"gte,omitempty" yaml:"gte,omitempty"`
+	Lte    string  `json:"lte,omitempty" yaml:"lte,omitempty"`
+	Negate bool    `json:"negate,omitempty" yaml:"negate,omitempty"`
+}
+
+// String renders the filter in KQL-like form for display, e.g. "NOT http.status: 500".
+func (f FieldFilter) String() string {
+	var s string
+	switch f.Op {
+	case FieldExists:
+		s = f.Field + ": *"
+	case FieldRange:
+		gte, lte := f.Gte, f.Lte
+		if gte == "" {
+			gte = "*"
+		}
+		if lte == "" {
+			lte = "*"
+		}
+		s = fmt.Sprintf("%s: [%s TO %s]", f.Field, gte, lte)
+	case FieldPrefix:
+		s = f.Field + ": " + f.Value + "*"
+	default:
+		s = f.Field + ": " + f.Value
+	}
+	if f.Negate {
+		return "NOT " + s
+	}
+	return s
+}
+
+// ESQL renders the filter as an ES|QL WHERE condition.
+// Values are compared as strings via TO_STRING so the same filter works for
+// keyword, numeric and boolean fields; ranges compare numerically when both
+// bounds are numbers.
+func (f FieldFilter) ESQL() string {
+	field := ESQLFieldName(f.Field)
+	asString := fmt.Sprintf("TO_STRING(%s)", field)
+
+	var cond string
+	switch f.Op {
+	case FieldExists:
+		if f.Negate {
+			return field + " IS NULL"
+		}
+		return field + " IS NOT NULL"
+	case FieldRange:
+		var parts []string
+		for _, bound := range []struct{ op, value string }{{">=", f.Gte}, {"<=", f.Lte}} {
+			if bound.value == "" {
+				continue
+			}
+			if isNumber(f.Gte) && isNumber(f.Lte) {
+				parts = append(parts, fmt.Sprintf("%s %s %s", field, bound.op, bound.value))
+			} else {
+				parts = append(parts, fmt.Sprintf("%s %s \"%s\"", asString, bound.op, EscapeESQLString(bound.value)))
+			}
+		}
+		if len(parts) == 0 {
+			cond = field + " IS NOT NULL"
+		} else {
+			cond = strings.Join(parts, " AND ")
+		}
+	case FieldPrefix:
+		cond = fmt.Sprintf("STARTS_WITH(%s, \"%s\")", asString, EscapeESQLString(f.Value))
+	default:
+		cond = fmt.Sprintf("%s == \"%s\"", asString, EscapeESQLString(f.Value))
+	}
+
+	if f.Negate {
+		return fmt.Sprintf("(%s IS NULL OR NOT (%s))", field, cond)
+	}
+	return "(" + cond + ")"
+}
+
+// ESQLFieldName quotes a field name with backticks for use in ES|QL.
+func ESQLFieldName(field string) string {
+	return "`" + strings.ReplaceAll(field, "`", "``") + "`"
+}
+
+// isNumber reports whether s is empty or parses as a number (empty bounds are open).
+func isNumber(s string) bool {
+	if s == "" {
+		return true
+	}
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import "testing"
+
+func TestFieldFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		filter   FieldFilter
+		wantStr  string
+		wantESQL string
+	}{
+		{
+			name:     "equals",
+			filter:   FieldFilter{Field: "http.response.status_code", Op: FieldEquals, Value: "500"},
+			wantStr:  "http.response.status_code: 500",
+			wantESQL: "(TO_STRING(`http.response.status_code`) == \"500\")",
+		},
+		{
+			name:     "not equals keeps documents without the field",
+			filter:   FieldFilter{Field: "k8s.pod.name", Op: FieldEquals, Value: `say "hi"`, Negate: true},
+			wantStr:  `NOT k8s.pod.name: say "hi"`,
+			wantESQL: "(`k8s.pod.name` IS NULL OR NOT (TO_STRING(`k8s.pod.name`) == \"say \\\"hi\\\"\"))",
+		},
+		{
+			name:     "exists",
+			filter:   FieldFilter{Field: "user.id", Op: FieldExists},
+			wantStr:  "user.id: *",
+			wantESQL: "`user.id` IS NOT NULL",
+		},
+		{
+			name:     "missing",
+			filter:   FieldFilter{Field: "user.id", Op: FieldExists, Negate: true},
+			wantStr:  "NOT user.id: *",
+			wantESQL: "`user.id` IS NULL",
+		},
+		{
+			name:     "numeric range",
+			filter:   FieldFilter{Field: "duration", Op: FieldRange, Gte: "10", Lte: "20.5"},
+			wantStr:  "duration: [10 TO 20.5]",
+			wantESQL: "(`duration` >= 10 AND `duration` <= 20.5)",
+		},
+		{
+			name:     "open string range",
+			filter:   FieldFilter{Field: "version", Op: FieldRange, Gte: "v2"},
+			wantStr:  "version: [v2 TO *]",
+			wantESQL: "(TO_STRING(`version`) >= \"v2\")",
+		},
+		{
+			name:     "prefix",
+			filter:   FieldFilter{Field: "host.name", Op: FieldPrefix, Value: "web-"},
+			wantStr:  "host.name: web-*",
+			wantESQL: "(STARTS_WITH(TO_STRING(`host.name`), \"web-\"))",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tc.filter.String(); got != tc.wantStr {
+				t.Errorf("String() = %q, want %q", got, tc.wantStr)
+			}
+			if got := tc.filter.ESQL(); got != tc.wantESQL {
+				t.Errorf("ESQL() = %q, want %q", got, tc.wantESQL)
+			}
+		})
+	}
+}
+
+func TestESQLFieldName(t *testing.T) {
+	t.Parallel()
+
+	if got := ESQLFieldName("a`b"); got != "`a``b`" {
+		t.Errorf("ESQLFieldName() = %q", got)
+	}
+}
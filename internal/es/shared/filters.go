@@ -131,6 +131,18 @@ func (fb *FilterBuilder) AddTraceIDFilter(traceID string) *FilterBuilder {
 	})
 }
 
+// AddSpanIDFilter adds a span ID filter.
+func (fb *FilterBuilder) AddSpanIDFilter(spanID string) *FilterBuilder {
+	if spanID == "" {
+		return fb
+	}
+	return fb.AddMust(map[string]interface{}{
+		"term": map[string]interface{}{
+			"span_id": spanID,
+		},
+	})
+}
+
 // AddTimeRangeFilter adds a time range filter using ES time expressions.
 // gte/lte can be ES time expressions like "now-1h" or RFC3339 timestamps.
 func (fb *FilterBuilder) AddTimeRangeFilter(gte, lte string) *FilterBuilder {
@@ -175,6 +187,50 @@ func (fb *FilterBuilder) AddPrefixFilter(field, prefix string) *FilterBuilder {
 	})
 }
 
+// AddFieldFilter adds a clause for an arbitrary field predicate.
+// Equality is an exact term match, like the ES|QL == comparison of FieldFilter.ESQL,
+// so the CLI and TUI return the same documents for a filter.
+func (fb *FilterBuilder) AddFieldFilter(f FieldFilter) *FilterBuilder {
+	if f.Field == "" {
+		return fb
+	}
+	var clause map[string]interface{}
+	switch f.Op {
+	case FieldExists:
+		clause = map[string]interface{}{
+			"exists": map[string]interface{}{"field": f.Field},
+		}
+	case FieldRange:
+		bounds := map[string]interface{}{}
+		if f.Gte != "" {
+			bounds["gte"] = f.Gte
+		}
+		if f.Lte != "" {
+			bounds["lte"] = f.Lte
+		}
+		clause = map[string]interface{}{
+			"range": map[string]interface{}{f.Field: bounds},
+		}
+	case FieldPrefix:
+		clause = map[string]interface{}{
+			"prefix": map[string]interface{}{f.Field: f.Value},
+		}
+	default:
+		clause = map[string]interface{}{
+			"term": map[string]interface{}{f.Field: f.Value},
+		}
+	}
+	return fb.AddClause(clause, f.Negate)
+}
+
+// AddFieldFilters adds a clause for each field predicate, in order.
+func (fb *FilterBuilder) AddFieldFilters(filters []FieldFilter) *FilterBuilder {
+	for _, f := range filters {
+		fb.AddFieldFilter(f)
+	}
+	return fb
+}
+
 // AddQueryString adds a full-text query_string clause.
 func (fb *FilterBuilder) AddQueryString(query string, fields []string) *FilterBuilder {
 	if query == "" {
@@ -5,6 +5,7 @@ package shared
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -236,6 +237,7 @@ func TestFilterBuilder_ChainedCalls(t *testing.T) {
 		AddLevelFilter("WARN").
 		AddTimeRangeFilter("now-1h", "").
 		AddTraceIDFilter("trace-123").
+		AddSpanIDFilter("span-456").
 		Build()
 
 	// Should produce valid JSON
@@ -257,6 +259,7 @@ func TestFilterBuilder_EmptyFilters(t *testing.T) {
 	fb.AddProcessorEventFilter("")
 	fb.AddTransactionNameFilter("")
 	fb.AddTraceIDFilter("")
+	fb.AddSpanIDFilter("")
 	fb.AddExistsFilter("")
 	fb.AddPrefixFilter("", "")
 	fb.AddQueryString("", nil)
@@ -268,3 +271,32 @@ func TestFilterBuilder_EmptyFilters(t *testing.T) {
 		t.Errorf("expected 0 must_not clauses for empty filters, got %d", len(fb.MustNot()))
 	}
 }
+
+func TestFilterBuilder_FieldFilters(t *testing.T) {
+	t.Parallel()
+
+	fb := NewFilterBuilder()
+	fb.AddFieldFilters([]FieldFilter{
+		{Field: "http.response.status_code", Op: FieldEquals, Value: "500"},
+		{Field: "k8s.pod.name", Op: FieldPrefix, Value: "api-"},
+		{Field: "duration", Op: FieldRange, Gte: "10"},
+		{Field: "user.id", Op: FieldExists, Negate: true},
+		{Op: FieldExists}, // No field: ignored
+	})
+
+	if len(fb.Must()) != 3 || len(fb.MustNot()) != 1 {
+		t.Fatalf("expected 3 must and 1 must_not clauses, got %d and %d", len(fb.Must()), len(fb.MustNot()))
+	}
+
+	got, _ := json.Marshal(fb.Build())
+	for _, want := range []string{
+		`{"term":{"http.response.status_code":"500"}}`,
+		`{"prefix":{"k8s.pod.name":"api-"}}`,
+		`{"range":{"duration":{"gte":"10"}}}`,
+		`"must_not":[{"exists":{"field":"user.id"}}]`,
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("expected %s in %s", want, got)
+		}
+	}
+}
@@ -44,6 +44,7 @@ type FieldCapsInfo struct {
 	Type             string
 	Aggregatable     bool
 	TimeSeriesMetric string
+	Meta             map[string][]string // Mapping meta, e.g. unit or temporality
 }
 
 // ESQLExecutor is the base interface for executing ES|QL queries.
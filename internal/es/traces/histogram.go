@@ -0,0 +1,133 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package traces
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+// DefaultHistogramBuckets is the number of latency buckets when LatencyHistogramOptions.Buckets is unset
+const DefaultHistogramBuckets = 20
+
+// GetLatencyHistogram returns the duration distribution of one transaction name
+// as equal-width buckets spanning opts.MinDuration to opts.MaxDuration.
+// The bounds come from the transaction name stats, so no extra query is needed to find them.
+func GetLatencyHistogram(ctx context.Context, exec Executor, opts LatencyHistogramOptions) (*LatencyHistogram, error) {
+	n := opts.Buckets
+	if n <= 0 {
+		n = DefaultHistogramBuckets
+	}
+	buckets := newLatencyBuckets(opts.MinDuration, opts.MaxDuration, n)
+	width := buckets[0].Max - buckets[0].Min
+
+	query := fmt.Sprintf(`FROM %s
+| WHERE %s
+| EVAL bucket = TO_INTEGER(FLOOR((transaction.duration.us - %f) / %f))
+| EVAL bucket = CASE(bucket >= %d, %d, bucket < 0, 0, bucket)
+| STATS count = COUNT(*) BY bucket
+| SORT bucket`,
+		exec.GetIndex(), histogramFilter(opts),
+		opts.MinDuration*1_000_000, width*1_000_000, // ms to nano
+		n, n-1)
+
+	res, err := exec.ExecuteESQLQuery(ctx, query)
+	if err != nil {
+		if shared.IsESQLEmptyStateError(err) {
+			return &LatencyHistogram{Buckets: buckets, Query: query}, nil
+		}
+		return nil, fmt.Errorf("failed to execute latency histogram query: %w", err)
+	}
+
+	colIndex := map[string]int{}
+	for i, col := range res.Columns {
+		colIndex[col.Name] = i
+	}
+	countIdx, okCount := colIndex["count"]
+	bucketIdx, okBucket := colIndex["bucket"]
+	if okCount && okBucket {
+		for _, row := range res.Values {
+			if countIdx >= len(row) || bucketIdx >= len(row) {
+				continue
+			}
+			b, okB := row[bucketIdx].(float64)
+			c, okC := row[countIdx].(float64)
+			if !okB || !okC || b < 0 || int(b) >= n {
+				continue
+			}
+			buckets[int(b)].Count += int64(c)
+		}
+	}
+
+	return &LatencyHistogram{Buckets: buckets, Query: query}, nil
+}
+
+// GetExampleTrace returns the trace ID of the slowest transaction whose
+// duration falls into bucket, or "" when there is none.
+func GetExampleTrace(ctx context.Context, exec Executor, opts LatencyHistogramOptions, bucket LatencyBucket) (string, error) {
+	query := fmt.Sprintf(`FROM %s
+| WHERE %s
+  AND transaction.duration.us >= %f
+  AND transaction.duration.us <= %f
+| SORT transaction.duration.us DESC
+| KEEP trace.id
+| LIMIT 1`,
+		exec.GetIndex(), histogramFilter(opts),
+		bucket.Min*1_000_000, bucket.Max*1_000_000) // ms to nano
+
+	res, err := exec.ExecuteESQLQuery(ctx, query)
+	if err != nil {
+		if shared.IsESQLEmptyStateError(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to execute example trace query: %w", err)
+	}
+	if len(res.Values) == 0 || len(res.Values[0]) == 0 {
+		return "", nil
+	}
+	traceID, _ := res.Values[0][0].(string)
+	return traceID, nil
+}
+
+// newLatencyBuckets splits [minMs, maxMs] into n equal-width buckets.
+// A zero-width range (all transactions equally fast) becomes a single 1ms-wide window.
+func newLatencyBuckets(minMs, maxMs float64, n int) []LatencyBucket {
+	if maxMs <= minMs {
+		maxMs = minMs + 1
+	}
+	width := (maxMs - minMs) / float64(n)
+	buckets := make([]LatencyBucket, n)
+	for i := range buckets {
+		buckets[i].Min = minMs + width*float64(i)
+		buckets[i].Max = minMs + width*float64(i+1)
+	}
+	// Avoid float drift excluding the slowest transaction from the last bucket
+	buckets[n-1].Max = math.Max(buckets[n-1].Max, maxMs)
+	return buckets
+}
+
+func histogramFilter(opts LatencyHistogramOptions) string {
+	filter := fmt.Sprintf(`processor.event == "transaction"
+  AND @timestamp >= NOW() - %s
+  AND transaction.name == "%s"`,
+		LookbackToESQLInterval(opts.Lookback), shared.EscapeESQLString(opts.TransactionName))
+	if opts.Service != "" {
+		op := "=="
+		if opts.NegateService {
+			op = "!="
+		}
+		filter += fmt.Sprintf("\n  AND service.name %s \"%s\"", op, shared.EscapeESQLString(opts.Service))
+	}
+	if opts.Resource != "" {
+		op := "=="
+		if opts.NegateResource {
+			op = "!="
+		}
+		filter += fmt.Sprintf("\n  AND resource.attributes.deployment.environment %s \"%s\"", op, shared.EscapeESQLString(opts.Resource))
+	}
+	return filter
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package traces
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+func TestGetLatencyHistogram_Success(t *testing.T) {
+	mock := &mockExecutor{
+		index: "traces-*",
+		esqlResult: &shared.ESQLResult{
+			Columns: []shared.ESQLColumn{
+				{Name: "count", Type: "long"},
+				{Name: "bucket", Type: "integer"},
+			},
+			Values: [][]interface{}{
+				{float64(40), float64(0)},
+				{float64(3), float64(8)},
+				{float64(7), float64(9)},
+			},
+		},
+	}
+
+	result, err := GetLatencyHistogram(context.Background(), mock, LatencyHistogramOptions{
+		Lookback:        "now-1h",
+		TransactionName: `GET "/api"`,
+		Service:         "frontend",
+		MinDuration:     0,
+		MaxDuration:     100,
+		Buckets:         10,
+	})
+	if err != nil {
+		t.Fatalf("GetLatencyHistogram failed: %v", err)
+	}
+
+	if len(result.Buckets) != 10 {
+		t.Fatalf("Expected 10 buckets, got %d", len(result.Buckets))
+	}
+	// Empty buckets are kept so the bars line up with the duration axis
+	if result.Buckets[0].Count != 40 || result.Buckets[1].Count != 0 || result.Buckets[9].Count != 7 {
+		t.Errorf("Unexpected counts: %+v", result.Buckets)
+	}
+	if result.Buckets[9].Min != 90 || result.Buckets[9].Max != 100 {
+		t.Errorf("Last bucket = [%v, %v], want [90, 100]", result.Buckets[9].Min, result.Buckets[9].Max)
+	}
+
+	for _, want := range []string{`transaction.name == "GET \"/api\""`, `service.name == "frontend"`, "/ 10000000.000000", "@timestamp >= NOW() - 1 hour"} {
+		if !strings.Contains(mock.lastESQLQuery, want) {
+			t.Errorf("Query missing %q:\n%s", want, mock.lastESQLQuery)
+		}
+	}
+}
+
+func TestGetLatencyHistogram_EmptyState(t *testing.T) {
+	mock := &mockExecutor{
+		index:   "traces-*",
+		esqlErr: &shared.ESQLUnknownIndexError{Index: "traces-*", Status: "400 Bad Request"},
+	}
+
+	result, err := GetLatencyHistogram(context.Background(), mock, LatencyHistogramOptions{TransactionName: "GET /", MinDuration: 5, MaxDuration: 5})
+	if err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+	if len(result.Buckets) != DefaultHistogramBuckets {
+		t.Fatalf("Expected %d empty buckets, got %d", DefaultHistogramBuckets, len(result.Buckets))
+	}
+	if last := result.Buckets[len(result.Buckets)-1]; last.Max != 6 {
+		t.Errorf("Expected a 1ms window for equal bounds, got max %v", last.Max)
+	}
+}
+
+func TestGetExampleTrace(t *testing.T) {
+	mock := &mockExecutor{
+		index: "traces-*",
+		esqlResult: &shared.ESQLResult{
+			Columns: []shared.ESQLColumn{{Name: "trace.id", Type: "keyword"}},
+			Values:  [][]interface{}{{"abc123"}},
+		},
+	}
+
+	traceID, err := GetExampleTrace(context.Background(), mock, LatencyHistogramOptions{TransactionName: "GET /"}, LatencyBucket{Min: 200, Max: 250})
+	if err != nil {
+		t.Fatalf("GetExampleTrace failed: %v", err)
+	}
+	if traceID != "abc123" {
+		t.Errorf("traceID = %q, want abc123", traceID)
+	}
+	if !strings.Contains(mock.lastESQLQuery, "transaction.duration.us >= 200000000.000000") ||
+		!strings.Contains(mock.lastESQLQuery, "SORT transaction.duration.us DESC") {
+		t.Errorf("Unexpected query:\n%s", mock.lastESQLQuery)
+	}
+
+	mock.esqlErr = io.EOF
+	if _, err := GetExampleTrace(context.Background(), mock, LatencyHistogramOptions{TransactionName: "GET /"}, LatencyBucket{}); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+}
@@ -305,7 +305,11 @@ func GetNamesESSQL(ctx context.Context, exec Executor, lookback, service, resour
     avg_duration = AVG(transaction.duration.us),
     max_duration = MAX(transaction.duration.us),
     error_count = COUNT(CASE(event.outcome == "failure", 1, null)),
-    last_seen = MAX(@timestamp)
+    last_seen = MAX(@timestamp),
+    p50_duration = PERCENTILE(transaction.duration.us, 50),
+    p90_duration = PERCENTILE(transaction.duration.us, 90),
+    p95_duration = PERCENTILE(transaction.duration.us, 95),
+    p99_duration = PERCENTILE(transaction.duration.us, 99)
   BY transaction.name
 | EVAL error_rate = error_count / tx_count * 100
 | SORT tx_count DESC
@@ -322,46 +326,59 @@ func GetNamesESSQL(ctx context.Context, exec Executor, lookback, service, resour
 		return nil, fmt.Errorf("failed to execute transaction stats query: %w", err)
 	}
 
-	// Parse transaction stats
-	txStats := make([]TransactionNameAgg, 0, len(statsResult.Values))
-	txNameToIndex := make(map[string]int) // Map tx name -> index in txStats slice
-
-	for _, row := range statsResult.Values {
-		if len(row) < 9 {
-			continue // Skip malformed rows
-		}
-
-		agg := TransactionNameAgg{}
+	// Parse transaction stats by column name (STATS columns, then BY, then EVAL)
+	colIndex := make(map[string]int, len(statsResult.Columns))
+	for i, col := range statsResult.Columns {
+		colIndex[col.Name] = i
+	}
 
-		// Parse columns (order matches STATS ... BY clause above)
-		if v, ok := row[0].(float64); ok {
-			agg.Count = int64(v)
+	getFloat := func(row []interface{}, name string) float64 {
+		idx, ok := colIndex[name]
+		if !ok || idx >= len(row) {
+			return 0
 		}
-		if v, ok := row[1].(float64); ok {
-			agg.TraceCount = int64(v)
+		if v, ok := row[idx].(float64); ok {
+			return v
 		}
-		if v, ok := row[2].(float64); ok {
-			agg.MinDuration = v / 1_000_000 // nano to ms
+		return 0
+	}
+
+	getString := func(row []interface{}, name string) string {
+		idx, ok := colIndex[name]
+		if !ok || idx >= len(row) {
+			return ""
 		}
-		if v, ok := row[3].(float64); ok {
-			agg.AvgDuration = v / 1_000_000 // nano to ms
+		if v, ok := row[idx].(string); ok {
+			return v
 		}
-		if v, ok := row[4].(float64); ok {
-			agg.MaxDuration = v / 1_000_000 // nano to ms
+		return ""
+	}
+
+	txStats := make([]TransactionNameAgg, 0, len(statsResult.Values))
+	txNameToIndex := make(map[string]int) // Map tx name -> index in txStats slice
+
+	for _, row := range statsResult.Values {
+		agg := TransactionNameAgg{
+			Name:        getString(row, "transaction.name"),
+			Count:       int64(getFloat(row, "tx_count")),
+			TraceCount:  int64(getFloat(row, "unique_traces")),
+			MinDuration: getFloat(row, "min_duration") / 1_000_000, // nano to ms
+			AvgDuration: getFloat(row, "avg_duration") / 1_000_000, // nano to ms
+			MaxDuration: getFloat(row, "max_duration") / 1_000_000, // nano to ms
+			P50Duration: getFloat(row, "p50_duration") / 1_000_000, // nano to ms
+			P90Duration: getFloat(row, "p90_duration") / 1_000_000, // nano to ms
+			P95Duration: getFloat(row, "p95_duration") / 1_000_000, // nano to ms
+			P99Duration: getFloat(row, "p99_duration") / 1_000_000, // nano to ms
+			ErrorRate:   getFloat(row, "error_rate"),
+		}
+		if agg.Name == "" {
+			continue // Skip malformed rows
 		}
-		// Skip row[5] - error_count (we use error_rate instead)
-		// row[6] - last_seen timestamp
-		if ts, ok := row[6].(string); ok {
+		if ts := getString(row, "last_seen"); ts != "" {
 			if parsed, err := time.Parse(time.RFC3339Nano, ts); err == nil {
 				agg.LastSeen = parsed
 			}
 		}
-		if v, ok := row[7].(string); ok {
-			agg.Name = v
-		}
-		if v, ok := row[8].(float64); ok {
-			agg.ErrorRate = v
-		}
 
 		txNameToIndex[agg.Name] = len(txStats)
 		txStats = append(txStats, agg)
@@ -364,6 +364,45 @@ func TestGetNamesESSQL_Success(t *testing.T) {
 	}
 }
 
+func TestGetNamesESSQL_Percentiles(t *testing.T) {
+	mock := &mockExecutor{
+		index: "traces-*",
+		esqlResults: []*shared.ESQLResult{
+			{
+				Columns: []shared.ESQLColumn{
+					{Name: "tx_count", Type: "long"},
+					{Name: "p50_duration", Type: "double"},
+					{Name: "p90_duration", Type: "double"},
+					{Name: "p95_duration", Type: "double"},
+					{Name: "p99_duration", Type: "double"},
+					{Name: "transaction.name", Type: "keyword"},
+				},
+				Values: [][]interface{}{
+					{float64(100), float64(10_000_000), float64(80_000_000), float64(120_000_000), float64(450_000_000), "GET /api/users"},
+				},
+			},
+			{Values: [][]interface{}{}},
+			{Values: [][]interface{}{}},
+		},
+	}
+
+	result, err := GetNamesESSQL(context.Background(), mock, "now-1h", "", "", false, false)
+	if err != nil {
+		t.Fatalf("GetNamesESSQL failed: %v", err)
+	}
+	if len(result.Names) != 1 {
+		t.Fatalf("Expected 1 result, got %d", len(result.Names))
+	}
+	if !strings.Contains(result.Query, "PERCENTILE(transaction.duration.us, 99)") {
+		t.Errorf("Query should compute percentiles: %s", result.Query)
+	}
+
+	got := result.Names[0]
+	if got.P50Duration != 10 || got.P90Duration != 80 || got.P95Duration != 120 || got.P99Duration != 450 {
+		t.Errorf("Percentiles = %v/%v/%v/%v, want 10/80/120/450", got.P50Duration, got.P90Duration, got.P95Duration, got.P99Duration)
+	}
+}
+
 func TestGetNamesESSQL_WithFilters(t *testing.T) {
 	mock := &mockExecutor{
 		index: "traces-*",
@@ -0,0 +1,268 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package traces
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+// DefaultServiceMapSpanLimit is the number of spans sampled when ServiceMapOptions.Limit is unset
+const DefaultServiceMapSpanLimit = 10000
+
+// GetServiceMap derives service dependencies from trace data.
+//
+// Spans and transactions are sampled with ES|QL and correlated client-side:
+//   - a span whose parent belongs to another service is a call parent.service -> span.service
+//   - an exit span with span.destination.service.resource and no child in another
+//     service is a call to that (uninstrumented) resource, e.g. a database
+//
+// Call counts, errors and latency are taken from the callee side of each call.
+func GetServiceMap(ctx context.Context, exec Executor, opts ServiceMapOptions) (*ServiceMapResult, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultServiceMapSpanLimit
+	}
+
+	query := fmt.Sprintf(`FROM %s
+| WHERE processor.event IN ("transaction", "span")
+  AND @timestamp >= NOW() - %s
+| EVAL duration_ns = COALESCE(transaction.duration.us, span.duration.us)
+| KEEP span.id, parent.id, service.name, event.outcome, span.destination.service.resource, duration_ns
+| LIMIT %d`,
+		exec.GetIndex(), LookbackToESQLInterval(opts.Lookback), limit)
+
+	res, err := exec.ExecuteESQLQuery(ctx, query)
+	if err != nil {
+		if shared.IsESQLEmptyStateError(err) {
+			return &ServiceMapResult{Edges: []ServiceEdge{}, Query: query}, nil
+		}
+		return nil, fmt.Errorf("failed to execute service map query: %w", err)
+	}
+
+	spans := parseServiceMapSpans(res)
+	return &ServiceMapResult{
+		Edges:     buildServiceEdges(spans),
+		Services:  collectServices(spans),
+		Query:     query,
+		Truncated: len(res.Values) >= limit,
+	}, nil
+}
+
+// serviceMapSpan is the subset of a span needed to derive dependencies
+type serviceMapSpan struct {
+	ID          string
+	ParentID    string
+	Service     string
+	Destination string
+	Failed      bool
+	DurationMs  float64
+}
+
+func parseServiceMapSpans(res *shared.ESQLResult) []serviceMapSpan {
+	colIndex := map[string]int{}
+	for i, col := range res.Columns {
+		colIndex[col.Name] = i
+	}
+
+	getString := func(row []interface{}, name string) string {
+		idx, ok := colIndex[name]
+		if !ok || idx >= len(row) {
+			return ""
+		}
+		if v, ok := row[idx].(string); ok {
+			return v
+		}
+		return ""
+	}
+
+	getFloat := func(row []interface{}, name string) float64 {
+		idx, ok := colIndex[name]
+		if !ok || idx >= len(row) {
+			return 0
+		}
+		if v, ok := row[idx].(float64); ok {
+			return v
+		}
+		return 0
+	}
+
+	spans := make([]serviceMapSpan, 0, len(res.Values))
+	for _, row := range res.Values {
+		spans = append(spans, serviceMapSpan{
+			ID:          getString(row, "span.id"),
+			ParentID:    getString(row, "parent.id"),
+			Service:     getString(row, "service.name"),
+			Destination: getString(row, "span.destination.service.resource"),
+			Failed:      getString(row, "event.outcome") == "failure",
+			DurationMs:  getFloat(row, "duration_ns") / 1_000_000, // nano to ms
+		})
+	}
+	return spans
+}
+
+func buildServiceEdges(spans []serviceMapSpan) []ServiceEdge {
+	byID := make(map[string]serviceMapSpan, len(spans))
+	for _, s := range spans {
+		if s.ID != "" {
+			byID[s.ID] = s
+		}
+	}
+
+	// Exit spans whose call is already visible as a child in another service
+	crossServiceParents := make(map[string]bool)
+	for _, s := range spans {
+		if parent, ok := byID[s.ParentID]; ok && s.ParentID != "" && parent.Service != s.Service {
+			crossServiceParents[parent.ID] = true
+		}
+	}
+
+	type edgeKey struct{ source, target string }
+	type edgeAcc struct {
+		edge      ServiceEdge
+		totalMs   float64
+		durationN int64
+	}
+	acc := make(map[edgeKey]*edgeAcc)
+	add := func(source, target string, external bool, s serviceMapSpan) {
+		if source == "" || target == "" || source == target {
+			return
+		}
+		key := edgeKey{source, target}
+		a, ok := acc[key]
+		if !ok {
+			a = &edgeAcc{edge: ServiceEdge{Source: source, Target: target, External: external}}
+			acc[key] = a
+		}
+		a.edge.Calls++
+		if s.Failed {
+			a.edge.Errors++
+		}
+		if s.DurationMs > 0 {
+			a.totalMs += s.DurationMs
+			a.durationN++
+			if s.DurationMs > a.edge.MaxLatency {
+				a.edge.MaxLatency = s.DurationMs
+			}
+		}
+	}
+
+	for _, s := range spans {
+		if parent, ok := byID[s.ParentID]; ok && s.ParentID != "" && parent.Service != s.Service {
+			add(parent.Service, s.Service, false, s)
+			continue
+		}
+		if s.Destination != "" && !crossServiceParents[s.ID] {
+			add(s.Service, s.Destination, true, s)
+		}
+	}
+
+	edges := make([]ServiceEdge, 0, len(acc))
+	for _, a := range acc {
+		if a.durationN > 0 {
+			a.edge.AvgLatency = a.totalMs / float64(a.durationN)
+		}
+		edges = append(edges, a.edge)
+	}
+
+	// Group by source, busiest edges first
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Source != edges[j].Source {
+			return edges[i].Source < edges[j].Source
+		}
+		if edges[i].Calls != edges[j].Calls {
+			return edges[i].Calls > edges[j].Calls
+		}
+		return edges[i].Target < edges[j].Target
+	})
+	return edges
+}
+
+func collectServices(spans []serviceMapSpan) []string {
+	seen := make(map[string]bool)
+	var services []string
+	for _, s := range spans {
+		if s.Service != "" && !seen[s.Service] {
+			seen[s.Service] = true
+			services = append(services, s.Service)
+		}
+	}
+	sort.Strings(services)
+	return services
+}
+
+// FormatDOT renders the service map as a Graphviz DOT digraph.
+// Edge labels carry call counts, error rate and average latency.
+func FormatDOT(result *ServiceMapResult) string {
+	var b strings.Builder
+	b.WriteString("digraph services {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, svc := range result.Services {
+		fmt.Fprintf(&b, "  %q;\n", svc)
+	}
+	for _, target := range externalTargets(result.Edges) {
+		fmt.Fprintf(&b, "  %q [shape=cylinder];\n", target)
+	}
+	for _, e := range result.Edges {
+		attrs := fmt.Sprintf("label=%q", edgeLabel(e))
+		if e.Errors > 0 {
+			attrs += ", color=red"
+		}
+		fmt.Fprintf(&b, "  %q -> %q [%s];\n", e.Source, e.Target, attrs)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// FormatMermaid renders the service map as a Mermaid flowchart.
+func FormatMermaid(result *ServiceMapResult) string {
+	ids := make(map[string]string)
+	nodeID := func(name string) string {
+		if id, ok := ids[name]; ok {
+			return id
+		}
+		id := fmt.Sprintf("n%d", len(ids))
+		ids[name] = id
+		return id
+	}
+
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for _, svc := range result.Services {
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", nodeID(svc), mermaidEscape(svc))
+	}
+	for _, target := range externalTargets(result.Edges) {
+		fmt.Fprintf(&b, "  %s[(\"%s\")]\n", nodeID(target), mermaidEscape(target))
+	}
+	for _, e := range result.Edges {
+		fmt.Fprintf(&b, "  %s -->|\"%s\"| %s\n", nodeID(e.Source), mermaidEscape(edgeLabel(e)), nodeID(e.Target))
+	}
+	return b.String()
+}
+
+func externalTargets(edges []ServiceEdge) []string {
+	seen := make(map[string]bool)
+	var targets []string
+	for _, e := range edges {
+		if e.External && !seen[e.Target] {
+			seen[e.Target] = true
+			targets = append(targets, e.Target)
+		}
+	}
+	sort.Strings(targets)
+	return targets
+}
+
+func edgeLabel(e ServiceEdge) string {
+	return fmt.Sprintf("%d calls, %.1f%% err, %.1fms avg", e.Calls, e.ErrorRate(), e.AvgLatency)
+}
+
+func mermaidEscape(s string) string {
+	return strings.ReplaceAll(s, `"`, "#quot;")
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package traces
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+func serviceMapFixture() *shared.ESQLResult {
+	return &shared.ESQLResult{
+		Columns: []shared.ESQLColumn{
+			{Name: "span.id", Type: "keyword"},
+			{Name: "parent.id", Type: "keyword"},
+			{Name: "service.name", Type: "keyword"},
+			{Name: "event.outcome", Type: "keyword"},
+			{Name: "span.destination.service.resource", Type: "keyword"},
+			{Name: "duration_ns", Type: "long"},
+		},
+		Values: [][]interface{}{
+			// gateway transaction with an exit span to portfolio
+			{"gw-tx", nil, "gateway", "success", nil, float64(50_000_000)},
+			{"gw-exit", "gw-tx", "gateway", "success", "portfolio:8080", float64(40_000_000)},
+			// portfolio transaction (child of the gateway exit span)
+			{"pf-tx", "gw-exit", "portfolio", "failure", nil, float64(30_000_000)},
+			// portfolio calls stock twice, one failing
+			{"st-tx1", "pf-tx", "stock", "success", nil, float64(10_000_000)},
+			{"st-tx2", "pf-tx", "stock", "failure", nil, float64(20_000_000)},
+			// stock queries an uninstrumented database
+			{"st-db", "st-tx1", "stock", "success", "postgresql", float64(5_000_000)},
+		},
+	}
+}
+
+func TestGetServiceMap_Success(t *testing.T) {
+	mock := &mockExecutor{index: "traces-*", esqlResult: serviceMapFixture()}
+
+	result, err := GetServiceMap(context.Background(), mock, ServiceMapOptions{Lookback: "now-1h"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(mock.lastESQLQuery, "FROM traces-*") || !strings.Contains(mock.lastESQLQuery, "LIMIT 10000") {
+		t.Errorf("unexpected query:\n%s", mock.lastESQLQuery)
+	}
+
+	want := []ServiceEdge{
+		{Source: "gateway", Target: "portfolio", Calls: 1, Errors: 1, AvgLatency: 30, MaxLatency: 30},
+		{Source: "portfolio", Target: "stock", Calls: 2, Errors: 1, AvgLatency: 15, MaxLatency: 20},
+		{Source: "stock", Target: "postgresql", External: true, Calls: 1, AvgLatency: 5, MaxLatency: 5},
+	}
+	if len(result.Edges) != len(want) {
+		t.Fatalf("expected %d edges (exit span to portfolio not double counted), got %+v", len(want), result.Edges)
+	}
+	for i, w := range want {
+		if result.Edges[i] != w {
+			t.Errorf("edge %d = %+v, want %+v", i, result.Edges[i], w)
+		}
+	}
+
+	if strings.Join(result.Services, ",") != "gateway,portfolio,stock" {
+		t.Errorf("unexpected services: %v", result.Services)
+	}
+	if result.Edges[1].ErrorRate() != 50 {
+		t.Errorf("expected 50%% error rate, got %.1f", result.Edges[1].ErrorRate())
+	}
+}
+
+func TestGetServiceMap_EmptyState(t *testing.T) {
+	mock := &mockExecutor{
+		index:   "traces-*",
+		esqlErr: &shared.ESQLUnknownIndexError{Index: "traces-*", Status: "400 Bad Request"},
+	}
+
+	result, err := GetServiceMap(context.Background(), mock, ServiceMapOptions{})
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(result.Edges) != 0 {
+		t.Fatalf("expected no edges, got %d", len(result.Edges))
+	}
+}
+
+func TestGetServiceMap_Error(t *testing.T) {
+	mock := &mockExecutor{index: "traces-*", esqlErr: io.EOF}
+
+	if _, err := GetServiceMap(context.Background(), mock, ServiceMapOptions{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestFormatServiceMap(t *testing.T) {
+	mock := &mockExecutor{index: "traces-*", esqlResult: serviceMapFixture()}
+	result, err := GetServiceMap(context.Background(), mock, ServiceMapOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dot := FormatDOT(result)
+	for _, want := range []string{
+		"digraph services {",
+		`"postgresql" [shape=cylinder];`,
+		`"portfolio" -> "stock" [label="2 calls, 50.0% err, 15.0ms avg", color=red];`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("expected DOT to contain %q, got:\n%s", want, dot)
+		}
+	}
+
+	mermaid := FormatMermaid(result)
+	for _, want := range []string{
+		"flowchart LR",
+		`n0["gateway"]`,
+		`n3[("postgresql")]`,
+		`n1 -->|"2 calls, 50.0% err, 15.0ms avg"| n2`,
+	} {
+		if !strings.Contains(mermaid, want) {
+			t.Errorf("expected Mermaid to contain %q, got:\n%s", want, mermaid)
+		}
+	}
+}
@@ -12,6 +12,10 @@ type TransactionNameAgg struct {
 	AvgDuration float64   // Average duration in milliseconds
 	MinDuration float64   // Minimum duration in milliseconds
 	MaxDuration float64   // Maximum duration in milliseconds
+	P50Duration float64   // Median duration in milliseconds
+	P90Duration float64   // 90th percentile duration in milliseconds
+	P95Duration float64   // 95th percentile duration in milliseconds
+	P99Duration float64   // 99th percentile duration in milliseconds
 	TraceCount  int64     // Number of unique traces
 	AvgSpans    float64   // Average number of spans per trace
 	ErrorRate   float64   // Percentage of errors (0-100)
@@ -24,5 +28,64 @@ type TransactionNamesResult struct {
 	Query string // ES|QL query used (for display/Kibana integration)
 }
 
+// LatencyHistogramOptions selects the transactions of a latency histogram
+type LatencyHistogramOptions struct {
+	Lookback        string  // ES time range string like "now-1h"
+	TransactionName string  // Transaction name to bucket
+	Service         string  // Service filter
+	NegateService   bool    // If true, exclude Service
+	Resource        string  // Resource (deployment environment) filter
+	NegateResource  bool    // If true, exclude Resource
+	MinDuration     float64 // Lower bound of the first bucket in milliseconds
+	MaxDuration     float64 // Upper bound of the last bucket in milliseconds
+	Buckets         int     // Number of buckets (0 = DefaultHistogramBuckets)
+}
+
+// LatencyBucket is one bar of a latency histogram
+type LatencyBucket struct {
+	Min   float64 // Lower bound in milliseconds
+	Max   float64 // Upper bound in milliseconds
+	Count int64   // Number of transactions in the bucket
+}
+
+// LatencyHistogram is the duration distribution of a transaction name
+type LatencyHistogram struct {
+	Buckets []LatencyBucket
+	Query   string // ES|QL query used (for display/Kibana integration)
+}
+
+// ServiceEdge is a dependency between two services: Source calls Target
+type ServiceEdge struct {
+	Source     string  // Calling service
+	Target     string  // Called service, or destination resource when External
+	External   bool    // Target is an uninstrumented resource (database, queue, external host)
+	Calls      int64   // Number of calls
+	Errors     int64   // Number of failed calls
+	AvgLatency float64 // Average call duration in milliseconds
+	MaxLatency float64 // Maximum call duration in milliseconds
+}
+
+// ErrorRate returns the percentage of failed calls (0-100)
+func (e ServiceEdge) ErrorRate() float64 {
+	if e.Calls == 0 {
+		return 0
+	}
+	return float64(e.Errors) / float64(e.Calls) * 100
+}
+
+// ServiceMapOptions configures the service map aggregation
+type ServiceMapOptions struct {
+	Lookback string // ES time range string like "now-1h"
+	Limit    int    // Maximum number of spans sampled (0 = DefaultServiceMapSpanLimit)
+}
+
+// ServiceMapResult contains the service dependency graph
+type ServiceMapResult struct {
+	Edges     []ServiceEdge // Edges grouped by source, busiest first
+	Services  []string      // Instrumented services seen in the sample
+	Query     string        // ES|QL query used (for display/Kibana integration)
+	Truncated bool          // The span sample hit the limit
+}
+
 // Note: ESQLResult and ESQLColumn have been moved to es/types.go
 // as they are shared across traces, metrics, and perspectives packages.
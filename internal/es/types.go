@@ -20,6 +20,7 @@ type (
 	FieldCapsResponse = shared.FieldCapsResponse
 	FieldCapsInfo     = shared.FieldCapsInfo
 	ESQLExecutor      = shared.ESQLExecutor
+	FieldFilter       = shared.FieldFilter
 )
 
 // Client wraps the Elasticsearch client with elasticat-specific functionality
@@ -40,14 +41,16 @@ type LogEntry struct {
 	Attributes  map[string]interface{} `json:"attributes,omitempty"`
 	Resource    map[string]interface{} `json:"resource,omitempty"`
 	RawJSON     string                 `json:"-"` // Original JSON from ES (not serialized)
+	ID          string                 `json:"-"` // Document _id, when the query returns it
 
 	// Trace-specific fields
-	TraceID  string                 `json:"trace_id,omitempty"`
-	SpanID   string                 `json:"span_id,omitempty"`
-	Name     string                 `json:"name,omitempty"`     // Span name
-	Duration int64                  `json:"duration,omitempty"` // Duration in nanoseconds
-	Kind     string                 `json:"kind,omitempty"`
-	Status   map[string]interface{} `json:"status,omitempty"`
+	TraceID      string                 `json:"trace_id,omitempty"`
+	SpanID       string                 `json:"span_id,omitempty"`
+	ParentSpanID string                 `json:"parent_span_id,omitempty"`
+	Name         string                 `json:"name,omitempty"`     // Span name
+	Duration     int64                  `json:"duration,omitempty"` // Duration in nanoseconds
+	Kind         string                 `json:"kind,omitempty"`
+	Status       map[string]interface{} `json:"status,omitempty"`
 
 	// Metrics-specific fields
 	Metrics map[string]interface{} `json:"metrics,omitempty"`
@@ -70,13 +73,20 @@ type TailOptions struct {
 	NegateResource  bool   // If true, exclude Resource instead of filtering to it
 	Level           string
 	Since           time.Time
+	From            time.Time     // Narrowed time window start (zero = use Lookback)
+	To              time.Time     // Narrowed time window end
+	After           *TailPosition // Only documents sorted after it, oldest first (ES|QL only)
 	ContainerID     string
-	SortAsc         bool   // true = oldest first, false = newest first (default)
-	Lookback        string // ES time range string like "now-1h", "now-24h", or "" for no filter
-	ProcessorEvent  string // Filter on attributes.processor.event (e.g., "transaction" for traces)
-	TransactionName string // Filter on transaction name (for traces)
-	TraceID         string // Filter on trace_id (for viewing spans)
-	MetricField     string // Filter for docs containing this metric field (for metric detail view)
+	SortAsc         bool            // true = oldest first, false = newest first (default)
+	Lookback        string          // ES time range string like "now-1h", "now-24h", or "" for no filter
+	ProcessorEvent  string          // Filter on attributes.processor.event (e.g., "transaction" for traces)
+	TransactionName string          // Filter on transaction name (for traces)
+	TraceID         string          // Filter on trace_id (for viewing spans)
+	SpanID          string          // Filter on span_id (for logs of a single span)
+	MetricField     string          // Filter for docs containing this metric field (for metric detail view)
+	Pattern         string          // Filter on message pattern (CATEGORIZE key from patterns.Categorize)
+	Exception       ExceptionFilter // Filter on an exception group (from Client.Exceptions)
+	FieldFilters    []FieldFilter   // Arbitrary field predicates, applied in order
 }
 
 // SearchOptions configures the search query
@@ -89,12 +99,17 @@ type SearchOptions struct {
 	Level           string
 	From            time.Time
 	To              time.Time
-	SortAsc         bool     // true = oldest first, false = newest first (default)
-	SearchFields    []string // ES fields to search (if empty, uses default body/message fields)
-	Lookback        string   // ES time range string like "now-1h", "now-24h", or "" for no filter
-	ProcessorEvent  string   // Filter on attributes.processor.event (e.g., "transaction" for traces)
-	TransactionName string   // Filter on transaction name (for traces)
-	TraceID         string   // Filter on trace_id (for viewing spans)
+	After           *TailPosition   // Only documents sorted after it, oldest first (ES|QL only)
+	SortAsc         bool            // true = oldest first, false = newest first (default)
+	SearchFields    []string        // ES fields to search (if empty, uses default body/message fields)
+	Lookback        string          // ES time range string like "now-1h", "now-24h", or "" for no filter
+	ProcessorEvent  string          // Filter on attributes.processor.event (e.g., "transaction" for traces)
+	TransactionName string          // Filter on transaction name (for traces)
+	TraceID         string          // Filter on trace_id (for viewing spans)
+	SpanID          string          // Filter on span_id (for logs of a single span)
+	Pattern         string          // Filter on message pattern (CATEGORIZE key from patterns.Categorize)
+	Exception       ExceptionFilter // Filter on an exception group (from Client.Exceptions)
+	FieldFilters    []FieldFilter   // Arbitrary field predicates, applied in order
 }
 
 // FieldInfo represents metadata about an Elasticsearch field
@@ -110,6 +125,7 @@ type FieldInfo struct {
 // - Trace types: traces/types.go (TransactionNameAgg)
 // - Metric types: metrics/types.go (MetricFieldInfo, MetricBucket, AggregatedMetric, etc.)
 // - Perspective types: perspectives/types.go (PerspectiveAgg)
+// - Log pattern types: patterns/types.go (PatternAgg)
 //
 // Shared types kept here:
 // - SearchResponse: raw ES search response (used by traces, metrics, perspectives)
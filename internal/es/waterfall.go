@@ -0,0 +1,182 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package es
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// SpanNode is a span in a trace tree built from parent span IDs
+type SpanNode struct {
+	Span     LogEntry
+	Children []*SpanNode
+}
+
+// WaterfallRow is one visible row of a flattened span tree
+type WaterfallRow struct {
+	Span        LogEntry
+	Depth       int  // Nesting level (0 = root)
+	HasChildren bool // Span has child spans
+	Collapsed   bool // Children are hidden
+	Hidden      int  // Number of descendants hidden by collapsing
+}
+
+// Timeline is the time window used to scale waterfall bars
+type Timeline struct {
+	Start    time.Time
+	Duration time.Duration
+}
+
+// BuildSpanTree links spans into trees using their parent span IDs.
+// Spans without a parent, or whose parent is not part of the set, become roots.
+// Roots and siblings are ordered by start time.
+func BuildSpanTree(spans []LogEntry) []*SpanNode {
+	nodes := make([]*SpanNode, len(spans))
+	byID := make(map[string]*SpanNode, len(spans))
+	for i := range spans {
+		nodes[i] = &SpanNode{Span: spans[i]}
+		if id := spans[i].SpanID; id != "" {
+			if _, exists := byID[id]; !exists {
+				byID[id] = nodes[i]
+			}
+		}
+	}
+
+	var roots []*SpanNode
+	for _, node := range nodes {
+		parent, ok := byID[node.Span.ParentSpanID]
+		if node.Span.ParentSpanID == "" || !ok || parent == node || isDescendant(node, parent) {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	sortSpanNodes(roots)
+	return roots
+}
+
+// isDescendant reports whether candidate is already below node, which would
+// create a cycle if node were attached to candidate.
+func isDescendant(node, candidate *SpanNode) bool {
+	for _, child := range node.Children {
+		if child == candidate || isDescendant(child, candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortSpanNodes(nodes []*SpanNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Span.Timestamp.Before(nodes[j].Span.Timestamp)
+	})
+	for _, node := range nodes {
+		sortSpanNodes(node.Children)
+	}
+}
+
+// FlattenSpanTree walks the trees depth-first and returns the visible rows.
+// Children of spans whose ID is in collapsed are skipped.
+func FlattenSpanTree(roots []*SpanNode, collapsed map[string]bool) []WaterfallRow {
+	var rows []WaterfallRow
+	var walk func(node *SpanNode, depth int)
+	walk = func(node *SpanNode, depth int) {
+		row := WaterfallRow{
+			Span:        node.Span,
+			Depth:       depth,
+			HasChildren: len(node.Children) > 0,
+		}
+		if row.HasChildren && node.Span.SpanID != "" && collapsed[node.Span.SpanID] {
+			row.Collapsed = true
+			row.Hidden = countDescendants(node)
+			rows = append(rows, row)
+			return
+		}
+		rows = append(rows, row)
+		for _, child := range node.Children {
+			walk(child, depth+1)
+		}
+	}
+	for _, root := range roots {
+		walk(root, 0)
+	}
+	return rows
+}
+
+func countDescendants(node *SpanNode) int {
+	n := len(node.Children)
+	for _, child := range node.Children {
+		n += countDescendants(child)
+	}
+	return n
+}
+
+// NewTimeline returns the window covering every span in the trees.
+// For a well-formed trace this is the root span's start and duration.
+func NewTimeline(roots []*SpanNode) Timeline {
+	var start, end time.Time
+	var visit func(node *SpanNode)
+	visit = func(node *SpanNode) {
+		spanStart := node.Span.Timestamp
+		spanEnd := spanStart.Add(time.Duration(node.Span.Duration))
+		if start.IsZero() || spanStart.Before(start) {
+			start = spanStart
+		}
+		if end.IsZero() || spanEnd.After(end) {
+			end = spanEnd
+		}
+		for _, child := range node.Children {
+			visit(child)
+		}
+	}
+	for _, root := range roots {
+		visit(root)
+	}
+	return Timeline{Start: start, Duration: end.Sub(start)}
+}
+
+// Bar returns the offset and length, in cells, of a span's bar when the
+// timeline is drawn across width cells. Bars are at least one cell long.
+func (t Timeline) Bar(span LogEntry, width int) (offset, length int) {
+	if width <= 0 {
+		return 0, 0
+	}
+	total := t.Duration.Nanoseconds()
+	if total <= 0 {
+		return 0, width
+	}
+
+	offset = int(float64(span.Timestamp.Sub(t.Start).Nanoseconds()) / float64(total) * float64(width))
+	length = int(float64(span.Duration) / float64(total) * float64(width))
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > width-1 {
+		offset = width - 1
+	}
+	if length < 1 {
+		length = 1
+	}
+	if offset+length > width {
+		length = width - offset
+	}
+	return offset, length
+}
+
+// FormatSpanDuration formats a span duration in nanoseconds as milliseconds,
+// using more precision for short spans.
+func FormatSpanDuration(ns int64) string {
+	ms := float64(ns) / 1_000_000.0
+	switch {
+	case ms < 1:
+		return fmt.Sprintf("%.2fms", ms)
+	case ms < 100:
+		return fmt.Sprintf("%.1fms", ms)
+	default:
+		return fmt.Sprintf("%.0fms", ms)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package es
+
+import (
+	"testing"
+	"time"
+)
+
+func waterfallSpans() []LogEntry {
+	base := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	ms := int64(time.Millisecond)
+	return []LogEntry{
+		{SpanID: "db", ParentSpanID: "api", Name: "SELECT", Timestamp: base.Add(20 * time.Millisecond), Duration: 30 * ms},
+		{SpanID: "root", Name: "GET /", Timestamp: base, Duration: 100 * ms},
+		{SpanID: "api", ParentSpanID: "root", Name: "call api", Timestamp: base.Add(10 * time.Millisecond), Duration: 60 * ms},
+		{SpanID: "cache", ParentSpanID: "root", Name: "cache", Timestamp: base.Add(5 * time.Millisecond), Duration: 2 * ms},
+		{SpanID: "orphan", ParentSpanID: "missing", Name: "orphan", Timestamp: base.Add(50 * time.Millisecond), Duration: 10 * ms},
+	}
+}
+
+func TestBuildSpanTree(t *testing.T) {
+	roots := BuildSpanTree(waterfallSpans())
+
+	if len(roots) != 2 {
+		t.Fatalf("expected 2 roots (root + orphan), got %d", len(roots))
+	}
+	if roots[0].Span.SpanID != "root" || roots[1].Span.SpanID != "orphan" {
+		t.Errorf("unexpected root order: %s, %s", roots[0].Span.SpanID, roots[1].Span.SpanID)
+	}
+
+	rows := FlattenSpanTree(roots, nil)
+	var got []string
+	var depths []int
+	for _, row := range rows {
+		got = append(got, row.Span.SpanID)
+		depths = append(depths, row.Depth)
+	}
+	wantIDs := []string{"root", "cache", "api", "db", "orphan"}
+	wantDepths := []int{0, 1, 1, 2, 0}
+	for i := range wantIDs {
+		if i >= len(got) || got[i] != wantIDs[i] || depths[i] != wantDepths[i] {
+			t.Fatalf("rows = %v (depths %v), want %v (depths %v)", got, depths, wantIDs, wantDepths)
+		}
+	}
+}
+
+func TestBuildSpanTree_Cycle(t *testing.T) {
+	spans := []LogEntry{
+		{SpanID: "a", ParentSpanID: "b"},
+		{SpanID: "b", ParentSpanID: "a"},
+	}
+
+	rows := FlattenSpanTree(BuildSpanTree(spans), nil)
+	if len(rows) != 2 {
+		t.Fatalf("expected both spans to be rendered once, got %d rows", len(rows))
+	}
+}
+
+func TestFlattenSpanTree_Collapsed(t *testing.T) {
+	roots := BuildSpanTree(waterfallSpans())
+
+	rows := FlattenSpanTree(roots, map[string]bool{"root": true})
+	if len(rows) != 2 {
+		t.Fatalf("expected collapsed root + orphan, got %d rows", len(rows))
+	}
+	if !rows[0].Collapsed || rows[0].Hidden != 3 {
+		t.Errorf("expected root collapsed hiding 3 spans, got %+v", rows[0])
+	}
+	if rows[1].Collapsed || rows[1].HasChildren {
+		t.Errorf("expected leaf orphan row, got %+v", rows[1])
+	}
+}
+
+func TestTimeline_Bar(t *testing.T) {
+	roots := BuildSpanTree(waterfallSpans())
+	timeline := NewTimeline(roots)
+
+	if timeline.Duration != 100*time.Millisecond {
+		t.Fatalf("timeline duration = %v, want 100ms", timeline.Duration)
+	}
+
+	spans := waterfallSpans()
+	tests := []struct {
+		span       LogEntry
+		wantOffset int
+		wantLength int
+	}{
+		{spans[1], 0, 100}, // root covers the whole width
+		{spans[0], 20, 30}, // db
+		{spans[3], 5, 2},   // cache
+		{LogEntry{Timestamp: timeline.Start.Add(99 * time.Millisecond)}, 99, 1}, // zero duration keeps one cell
+	}
+	for _, tt := range tests {
+		offset, length := timeline.Bar(tt.span, 100)
+		if offset != tt.wantOffset || length != tt.wantLength {
+			t.Errorf("Bar(%s) = (%d, %d), want (%d, %d)", tt.span.SpanID, offset, length, tt.wantOffset, tt.wantLength)
+		}
+	}
+}
+
+func TestFormatSpanDuration(t *testing.T) {
+	tests := []struct {
+		ns   int64
+		want string
+	}{
+		{250_000, "0.25ms"},
+		{12_340_000, "12.3ms"},
+		{1_234_000_000, "1234ms"},
+	}
+	for _, tt := range tests {
+		if got := FormatSpanDuration(tt.ns); got != tt.want {
+			t.Errorf("FormatSpanDuration(%d) = %q, want %q", tt.ns, got, tt.want)
+		}
+	}
+}
@@ -48,6 +48,30 @@ const (
 	ActionCreds         // X - show credentials modal
 	ActionOtelConfig    // O - open OTel collector config
 	ActionCopyOriginal  // Y - copy log.record.original to clipboard
+	ActionPatterns      // P - log message patterns
+	ActionJumpTrace     // T - open the trace of a log entry
+	ActionJumpLogs      // L - logs of a span
+	ActionServiceMap    // M - service dependency map
+	ActionLatency       // H - latency distribution of a transaction name
+	ActionGroupBy       // B - group a metric by a dimension
+	ActionCompare       // V - compare marked metrics
+	ActionVolume        // Z - focus the log volume histogram
+	ActionFilter        // F - filter by field value / focus filter chips
+	ActionViews         // W - saved views
+	ActionSplit         // | - toggle the split list/detail layout
+	ActionSplitShrink   // < - give the list less of the split
+	ActionSplitGrow     // > - give the list more of the split
+	ActionNewTab        // ctrl+t - open a copy of the current tab
+	ActionCloseTab      // ctrl+w - close the current tab
+	ActionNextTab       // tab - show the next tab
+	ActionPrevTab       // shift+tab - show the previous tab
+	ActionRenameTab     // f2 - rename the current tab
+	ActionLiveTail      // w - follow new logs as they arrive
+	ActionBookmark      // b - bookmark the selected document
+	ActionBookmarks     // ' - bookmarks list
+	ActionContext       // x - logs around the selected entry
+	ActionExceptions    // E - exceptions grouped by type and message
+	ActionPalette       // : - command palette
 )
 
 // DefaultKeyBindings maps keys to their primary action.
@@ -84,6 +108,7 @@ var DefaultKeyBindings = map[string]Action{
 	"Q":         ActionQuery,
 	"a":         ActionAutoRefresh,
 	"space":     ActionToggle,
+	" ":         ActionToggle, // bubbletea reports the space bar as " "
 	"s":         ActionSort,
 
 	// Uppercase keys for specific view actions
@@ -96,6 +121,35 @@ var DefaultKeyBindings = map[string]Action{
 	"X": ActionCreds,        // Show credentials modal
 	"O": ActionOtelConfig,   // Open OTel collector config
 	"Y": ActionCopyOriginal, // Copy log.record.original in detail view
+	"P": ActionPatterns,     // Log message patterns
+	"T": ActionJumpTrace,    // Jump from a log to its trace
+	"L": ActionJumpLogs,     // Jump from a span to its logs
+	"M": ActionServiceMap,   // Service dependency map (traces)
+	"H": ActionLatency,      // Latency histogram of a transaction name
+	"B": ActionGroupBy,      // Break a metric down by a dimension
+	"V": ActionCompare,      // Compare the marked metrics on one time axis
+	"Z": ActionVolume,       // Focus the log volume histogram to narrow the time window
+	"F": ActionFilter,       // Filter by a field value (detail) or edit filter chips (list)
+	"W": ActionViews,        // Saved views
+	"|": ActionSplit,        // Split list/detail layout
+	"<": ActionSplitShrink,  // Narrower list in the split layout
+	">": ActionSplitGrow,    // Wider list in the split layout
+	"w": ActionLiveTail,     // Live tail of the logs list
+	"b": ActionBookmark,     // Bookmark a log or span
+	"'": ActionBookmarks,    // Bookmarks list
+	"x": ActionContext,      // Surrounding logs of an entry
+	"E": ActionExceptions,   // Errors view: grouped exceptions
+	":": ActionPalette,      // Command palette
+
+	// Tabs
+	"ctrl+t":    ActionNewTab,
+	"ctrl+w":    ActionCloseTab,
+	"tab":       ActionNextTab,
+	"shift+tab": ActionPrevTab,
+	"f2":        ActionRenameTab,
+
+	// Command palette
+	"ctrl+p": ActionPalette,
 
 	// Context-dependent keys (handled specially in some views)
 	// "d" - dashboard/documents toggle (not in default map)
@@ -162,6 +216,30 @@ var ActionDisplay = map[Action]ActionInfo{
 	ActionCreds:         {DisplayKeys: []string{"X"}, Label: "creds"},
 	ActionOtelConfig:    {DisplayKeys: []string{"O"}, Label: "edit otel collector config"},
 	ActionCopyOriginal:  {DisplayKeys: []string{"Y"}, Label: "copy 'log.original'"},
+	ActionPatterns:      {DisplayKeys: []string{"P"}, Label: "patterns"},
+	ActionJumpTrace:     {DisplayKeys: []string{"T"}, Label: "trace"},
+	ActionJumpLogs:      {DisplayKeys: []string{"L"}, Label: "span logs"},
+	ActionServiceMap:    {DisplayKeys: []string{"M"}, Label: "service map"},
+	ActionLatency:       {DisplayKeys: []string{"H"}, Label: "latency histogram"},
+	ActionGroupBy:       {DisplayKeys: []string{"B"}, Label: "group by"},
+	ActionCompare:       {DisplayKeys: []string{"V"}, Label: "compare marked"},
+	ActionVolume:        {DisplayKeys: []string{"Z"}, Label: "volume histogram"},
+	ActionFilter:        {DisplayKeys: []string{"F"}, Label: "field filters"},
+	ActionViews:         {DisplayKeys: []string{"W"}, Label: "saved views"},
+	ActionSplit:         {DisplayKeys: []string{"|"}, Label: "split"},
+	ActionSplitShrink:   {DisplayKeys: []string{"<"}, Label: "shrink list"},
+	ActionSplitGrow:     {DisplayKeys: []string{">"}, Label: "grow list"},
+	ActionNewTab:        {DisplayKeys: []string{"ctrl+t"}, Label: "new tab"},
+	ActionCloseTab:      {DisplayKeys: []string{"ctrl+w"}, Label: "close tab"},
+	ActionNextTab:       {DisplayKeys: []string{"tab"}, Label: "next tab"},
+	ActionPrevTab:       {DisplayKeys: []string{"shift+tab"}, Label: "prev tab"},
+	ActionRenameTab:     {DisplayKeys: []string{"f2"}, Label: "rename tab"},
+	ActionLiveTail:      {DisplayKeys: []string{"w"}, Label: "live tail"},
+	ActionBookmark:      {DisplayKeys: []string{"b"}, Label: "bookmark"},
+	ActionBookmarks:     {DisplayKeys: []string{"'"}, Label: "bookmarks"},
+	ActionContext:       {DisplayKeys: []string{"x"}, Label: "context"},
+	ActionExceptions:    {DisplayKeys: []string{"E"}, Label: "errors"},
+	ActionPalette:       {DisplayKeys: []string{":", "ctrl+p"}, Label: "command palette"},
 }
 
 // ScrollDisplayKeys returns the combined display for scroll up/down
@@ -0,0 +1,97 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+// contextAnchorMark replaces the row padding of the anchor of a context list.
+const contextAnchorMark = "▶"
+
+// openContext lists the logs around the selected entry, like grep -C: the
+// ones just before and after it from the same service and container,
+// whatever the search and filters. Esc returns to the entry.
+func (m *Model) openContext() tea.Cmd {
+	entry, ok := m.selectedEntry()
+	if !ok {
+		return nil
+	}
+	if entry.ID == "" {
+		m.UI.StatusMessage = "This document has no _id to show the context of"
+		m.UI.StatusTime = time.Now()
+		return nil
+	}
+
+	index := m.client.GetIndex()
+	m.pushSignalJump(signalLogs)
+	m.client.SetIndex(index)
+	m.Logs.Context = &ContextState{Anchor: entry}
+	m.Logs.SelectID = entry.ID
+	m.UI.Loading = true
+	return m.fetchLogs()
+}
+
+// toggleContextScope switches the context list between the anchor's service
+// and container and all services.
+func (m *Model) toggleContextScope() tea.Cmd {
+	c := *m.Logs.Context
+	c.AllServices = !c.AllServices
+	m.Logs.Context = &c
+	m.Logs.SelectID = c.Anchor.ID
+	m.UI.Loading = true
+	return m.fetchLogs()
+}
+
+// isContextAnchor reports whether an entry is the one a context list is
+// centred on.
+func (m Model) isContextAnchor(log es.LogEntry) bool {
+	return m.Logs.Context != nil && log.ID != "" && log.ID == m.Logs.Context.Anchor.ID
+}
+
+// fetchContext fetches the logs around the anchor of the context list, in the
+// list's sort order.
+func (m *Model) fetchContext() tea.Cmd {
+	anchor := m.Logs.Context.Anchor
+	opts := es.SurroundingOptions{
+		AnchorID: anchor.ID,
+		At:       anchor.Timestamp,
+		Size:     m.tuiConfig.ContextSize,
+	}
+	if !m.Logs.Context.AllServices {
+		opts.Service = anchor.ServiceName
+		opts.ContainerID = anchor.ContainerID
+	}
+	sortAsc := m.UI.SortAscending
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestLogs, m.tuiConfig.LogsTimeout)
+		defer done()
+
+		index := m.client.GetIndex()
+		logs, queries, err := m.client.SurroundingESQL(ctx, opts)
+		if err != nil {
+			return logsMsg{err: err}
+		}
+		if !sortAsc {
+			slices.Reverse(logs)
+		}
+		return logsMsg{logs: logs, total: int64(len(logs)), queryJSON: queries, index: index}
+	}
+}
+
+// contextScope describes a context list in the status bar.
+func contextScope(c ContextState) string {
+	around := "around " + c.Anchor.Timestamp.Local().Format("15:04:05.000")
+	switch {
+	case c.AllServices:
+		return around + ", all services"
+	case c.Anchor.ServiceName != "":
+		return around + ", " + c.Anchor.ServiceName + " only"
+	}
+	return around
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestContext(t *testing.T) {
+	ts := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	anchor := es.LogEntry{ID: "anchor", Timestamp: ts, Body: "db timeout", ServiceName: "checkout", ContainerID: "c1"}
+	m, src := newTestModel(signalLogs, viewDetail)
+	src.index = "logs-checkout*"
+	src.surrounding = []es.LogEntry{
+		{ID: "before", Timestamp: ts.Add(-time.Second), Body: "retrying"},
+		anchor,
+		{ID: "after", Timestamp: ts.Add(time.Second), Body: "giving up"},
+	}
+	m.tuiConfig = config.TUIConfig{ContextSize: 10}
+	m.Filters.Query = "timeout"
+	m.Logs.Entries = []es.LogEntry{anchor}
+	load := func(cmd tea.Cmd) {
+		t.Helper()
+		if cmd == nil {
+			t.Fatal("expected a fetch")
+		}
+		m, _ = m.handleLogsMsg(cmd().(logsMsg))
+	}
+
+	// The context ignores the search and keeps the index of the list
+	load(press(t, &m, "x"))
+	if m.UI.Mode != viewLogs || !m.inSignalJump() || m.Filters.Query != "" || src.index != "logs-checkout*" {
+		t.Fatalf("expected an unfiltered jump to the logs list, got mode=%v query=%q index=%q", m.UI.Mode, m.Filters.Query, src.index)
+	}
+	want := es.SurroundingOptions{AnchorID: "anchor", At: ts, Size: 10, Service: "checkout", ContainerID: "c1"}
+	if src.surroundingOpts != want {
+		t.Errorf("options = %+v, want %+v", src.surroundingOpts, want)
+	}
+	// Newest first, with the anchor selected and marked
+	if len(m.Logs.Entries) != 3 || m.Logs.Entries[0].ID != "after" || m.Logs.SelectedIndex != 1 {
+		t.Fatalf("expected the anchor selected between its neighbours, got %+v at %d", m.Logs.Entries, m.Logs.SelectedIndex)
+	}
+	if !strings.Contains(m.renderLogEntry(m.Logs.Entries[1], true), contextAnchorMark) ||
+		strings.Contains(m.renderLogEntry(m.Logs.Entries[0], false), contextAnchorMark) {
+		t.Error("expected only the anchor to be marked")
+	}
+
+	load(press(t, &m, "x"))
+	if !m.Logs.Context.AllServices || src.surroundingOpts.Service != "" || src.surroundingOpts.ContainerID != "" {
+		t.Errorf("expected all services, got %+v", src.surroundingOpts)
+	}
+	if m.Logs.SelectedIndex != 1 {
+		t.Errorf("selected %d, want the anchor", m.Logs.SelectedIndex)
+	}
+
+	press(t, &m, "esc")
+	if m.UI.Mode != viewDetail || m.Logs.Context != nil || m.Filters.Query != "timeout" {
+		t.Errorf("expected esc to return to the detail view, got mode=%v", m.UI.Mode)
+	}
+}
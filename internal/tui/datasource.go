@@ -5,9 +5,11 @@ package tui
 
 import (
 	"context"
+	"time"
 
 	"github.com/elastic/elasticat/internal/es"
 	"github.com/elastic/elasticat/internal/es/metrics"
+	"github.com/elastic/elasticat/internal/es/patterns"
 	"github.com/elastic/elasticat/internal/es/perspectives"
 	"github.com/elastic/elasticat/internal/es/traces"
 )
@@ -32,12 +34,51 @@ type DataSource interface {
 	// Used by auto lookback detection to avoid full fetches.
 	CountESQL(ctx context.Context, opts es.TailOptions) (int64, string, error)
 
+	// CountSearchESQL counts the documents of the given index pattern that match a search.
+	// Used for the new-document counters of inactive tabs.
+	CountSearchESQL(ctx context.Context, index, queryStr string, opts es.SearchOptions) (int64, string, error)
+
+	// SurroundingESQL fetches the documents before and after an anchor document.
+	// Returns them oldest first plus the ES|QL queries for display.
+	SurroundingESQL(ctx context.Context, opts es.SurroundingOptions) ([]es.LogEntry, string, error)
+
+	// DocumentsByID fetches the documents of the given index pattern with the given IDs.
+	// Used to export bookmarks.
+	DocumentsByID(ctx context.Context, index string, ids []string) ([]es.LogEntry, error)
+
+	// LogVolume counts documents per time bucket and level for the volume histogram.
+	// Returns the histogram plus the ES|QL query string for display.
+	LogVolume(ctx context.Context, queryStr string, opts es.SearchOptions, interval time.Duration) (*es.VolumeHistogram, string, error)
+
+	// Exceptions groups the OTel exceptions among the matching documents by type and
+	// masked message or top stack frame, with per-bucket counts for sparklines.
+	// Returns the groups plus the ES|QL query string for display.
+	Exceptions(ctx context.Context, queryStr string, opts es.SearchOptions, by es.ExceptionGroupBy, buckets int) (*es.ExceptionGroups, string, error)
+
 	// AggregateMetrics retrieves aggregated statistics for all discovered metrics.
 	AggregateMetrics(ctx context.Context, opts metrics.AggregateMetricsOptions) (*metrics.MetricsAggResult, error)
 
+	// GetMetricDimensions discovers the attributes.* keyword fields present on documents carrying a metric.
+	GetMetricDimensions(ctx context.Context, metricName string, opts metrics.AggregateMetricsOptions) ([]metrics.Dimension, error)
+
+	// GetMetricDistribution returns percentiles and a value heatmap over time for a histogram metric.
+	GetMetricDistribution(ctx context.Context, metricName string, opts metrics.AggregateMetricsOptions, rows int) (*metrics.HistogramDistribution, error)
+
 	// GetTransactionNamesESQL retrieves transaction aggregations using ES|QL.
 	GetTransactionNamesESQL(ctx context.Context, lookback, service, resource string, negateService, negateResource bool) (*traces.TransactionNamesResult, error)
 
+	// GetServiceMap derives service dependencies (call counts, errors, latency) from trace data.
+	GetServiceMap(ctx context.Context, opts traces.ServiceMapOptions) (*traces.ServiceMapResult, error)
+
+	// GetLatencyHistogram returns the bucketed duration distribution of a transaction name.
+	GetLatencyHistogram(ctx context.Context, opts traces.LatencyHistogramOptions) (*traces.LatencyHistogram, error)
+
+	// GetExampleTrace returns the trace ID of the slowest transaction in a latency bucket.
+	GetExampleTrace(ctx context.Context, opts traces.LatencyHistogramOptions, bucket traces.LatencyBucket) (string, error)
+
+	// GetLogPatterns groups log messages into patterns using ES|QL CATEGORIZE.
+	GetLogPatterns(ctx context.Context, opts patterns.Options) (*patterns.PatternsResult, error)
+
 	// GetServices returns aggregated counts per service.
 	GetServices(ctx context.Context, lookback string) ([]perspectives.PerspectiveAgg, error)
 
@@ -47,6 +88,12 @@ type DataSource interface {
 	// GetFieldCaps retrieves available fields from the index.
 	GetFieldCaps(ctx context.Context) ([]es.FieldInfo, error)
 
+	// ExecuteESQLQuery runs an arbitrary ES|QL query, as typed in the query editor.
+	ExecuteESQLQuery(ctx context.Context, query string) (*es.ESQLResult, error)
+
+	// GetFieldFacets returns the top values of a field for the current filters.
+	GetFieldFacets(ctx context.Context, field string, numeric bool, queryStr string, opts es.SearchOptions) (*es.FieldFacets, error)
+
 	// Ping checks if Elasticsearch is reachable.
 	Ping(ctx context.Context) error
 
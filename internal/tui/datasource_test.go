@@ -0,0 +1,130 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"context"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+// stubSource is the DataSource of the view tests. It tracks the index
+// pattern, records the requests it answers and returns the canned results
+// set on it. Methods it doesn't implement panic on the nil DataSource.
+type stubSource struct {
+	DataSource
+	index string
+
+	// Canned results
+	esqlResult  *es.ESQLResult
+	esqlErr     error
+	count       int64
+	surrounding []es.LogEntry
+	exceptions  *es.ExceptionGroups
+
+	// Recorded requests
+	tailOpts        es.TailOptions
+	queries         []string
+	facetField      string
+	facetNumeric    bool
+	counted         []string
+	countOpts       []es.SearchOptions
+	surroundingOpts es.SurroundingOptions
+	exceptionsBy    es.ExceptionGroupBy
+}
+
+func (s *stubSource) GetIndex() string      { return s.index }
+func (s *stubSource) SetIndex(index string) { s.index = index }
+
+func (s *stubSource) TailESQL(_ context.Context, opts es.TailOptions) (*es.SearchResult, string, error) {
+	s.tailOpts = opts
+	return &es.SearchResult{}, "", nil
+}
+
+func (s *stubSource) ExecuteESQLQuery(_ context.Context, query string) (*es.ESQLResult, error) {
+	s.queries = append(s.queries, query)
+	return s.esqlResult, s.esqlErr
+}
+
+func (s *stubSource) GetFieldFacets(_ context.Context, field string, numeric bool, _ string, _ es.SearchOptions) (*es.FieldFacets, error) {
+	s.facetField, s.facetNumeric = field, numeric
+	return &es.FieldFacets{Field: field}, nil
+}
+
+func (s *stubSource) CountSearchESQL(_ context.Context, index, _ string, opts es.SearchOptions) (int64, string, error) {
+	s.counted = append(s.counted, index)
+	s.countOpts = append(s.countOpts, opts)
+	return s.count, "", nil
+}
+
+func (s *stubSource) SurroundingESQL(_ context.Context, opts es.SurroundingOptions) ([]es.LogEntry, string, error) {
+	s.surroundingOpts = opts
+	return s.surrounding, "", nil
+}
+
+func (s *stubSource) Exceptions(_ context.Context, _ string, _ es.SearchOptions, by es.ExceptionGroupBy, _ int) (*es.ExceptionGroups, string, error) {
+	s.exceptionsBy = by
+	return s.exceptions, "FROM logs-*", nil
+}
+
+// newTestModel returns a 120×40 model in the given view of a signal, with
+// the signal's default columns, backed by a stubSource on its index.
+func newTestModel(signal SignalType, mode viewMode) (Model, *stubSource) {
+	src := &stubSource{index: signal.IndexPattern()}
+	return Model{
+		ctx:      context.Background(),
+		client:   src,
+		requests: newRequestManager(),
+		UI:       UIState{Mode: mode, Width: 120, Height: 40},
+		Filters:  FilterState{Signal: signal},
+		Fields:   FieldsState{Display: DefaultFields(signal)},
+	}, src
+}
+
+// testKeys are the special keys the view tests press, by name.
+var testKeys = map[string]tea.KeyType{
+	"enter":     tea.KeyEnter,
+	"esc":       tea.KeyEsc,
+	"tab":       tea.KeyTab,
+	"shift+tab": tea.KeyShiftTab,
+	"space":     tea.KeySpace,
+	"backspace": tea.KeyBackspace,
+	"up":        tea.KeyUp,
+	"down":      tea.KeyDown,
+	"left":      tea.KeyLeft,
+	"right":     tea.KeyRight,
+	"f2":        tea.KeyF2,
+	"ctrl+f":    tea.KeyCtrlF,
+	"ctrl+p":    tea.KeyCtrlP,
+	"ctrl+r":    tea.KeyCtrlR,
+	"ctrl+t":    tea.KeyCtrlT,
+	"ctrl+w":    tea.KeyCtrlW,
+}
+
+// keyMsg returns the press of a key named as bubbletea reports it ("enter",
+// "ctrl+r"); any other name is typed as text.
+func keyMsg(key string) tea.KeyMsg {
+	if t, ok := testKeys[key]; ok {
+		return tea.KeyMsg{Type: t}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+}
+
+// press sends a key, named as in keymaps ("enter", "ctrl+r", "G"), through
+// handleKey and returns the command it gave.
+func press(t *testing.T, m *Model, key string) tea.Cmd {
+	t.Helper()
+	next, cmd := m.handleKey(keyMsg(key))
+	*m = next.(Model)
+	return cmd
+}
+
+// typeText types text into the focused input through handleKey.
+func typeText(t *testing.T, m *Model, text string) {
+	t.Helper()
+	next, _ := m.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+	*m = next.(Model)
+}
@@ -4,8 +4,12 @@
 package tui
 
 import (
+	"fmt"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/lipgloss"
 	"github.com/elastic/elasticat/internal/es/metrics"
 )
 
@@ -116,3 +120,218 @@ func (m Model) renderLargeChart(buckets []metrics.MetricBucket, minVal, maxVal f
 
 	return b.String()
 }
+
+// renderMultiSeriesChart renders several time series on a shared scale and time
+// axis, one colour per series, followed by a legend. Where series overlap, the
+// one listed first in the legend is drawn.
+func (m Model) renderMultiSeriesChart(series []metrics.MetricSeries, width, height int) string {
+	// Align series on the union of their bucket timestamps (groups may have gaps)
+	seen := make(map[time.Time]bool)
+	var times []time.Time
+	values := make([]map[time.Time]float64, len(series))
+	first := true
+	var minVal, maxVal float64
+	for i, s := range series {
+		values[i] = make(map[time.Time]float64, len(s.Buckets))
+		for _, bucket := range s.Buckets {
+			values[i][bucket.Timestamp] = bucket.Value
+			if !seen[bucket.Timestamp] {
+				seen[bucket.Timestamp] = true
+				times = append(times, bucket.Timestamp)
+			}
+			if first || bucket.Value < minVal {
+				minVal = bucket.Value
+			}
+			if first || bucket.Value > maxVal {
+				maxVal = bucket.Value
+			}
+			first = false
+		}
+	}
+	if len(times) == 0 {
+		return DetailMutedStyle.Render("No data points")
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	yLabelWidth := 10
+	chartWidth := width - yLabelWidth - 2
+	if chartWidth < 10 {
+		chartWidth = 10
+	}
+
+	valRange := maxVal - minVal
+	if valRange == 0 {
+		valRange = 1
+		minVal = minVal - 0.5
+	}
+
+	// Map each chart column to the row each series occupies there (-1 = no data)
+	step := float64(len(times)) / float64(chartWidth)
+	rows := make([][]int, len(series))
+	for i := range series {
+		rows[i] = make([]int, chartWidth)
+		for col := 0; col < chartWidth; col++ {
+			idx := int(float64(col) * step)
+			if idx >= len(times) {
+				idx = len(times) - 1
+			}
+			v, ok := values[i][times[idx]]
+			if !ok {
+				rows[i][col] = -1
+				continue
+			}
+			rows[i][col] = int((v - minVal) / valRange * float64(height-1))
+		}
+	}
+
+	var b strings.Builder
+	for row := height - 1; row >= 0; row-- {
+		rowValue := minVal + (valRange * float64(row) / float64(height-1))
+		b.WriteString(DetailMutedStyle.Render(PadLeft(formatMetricValue(rowValue), yLabelWidth)))
+		b.WriteString(" │")
+		for col := 0; col < chartWidth; col++ {
+			cell := " "
+			for i := range series {
+				if rows[i][col] == row {
+					cell = seriesStyle(i).Render("•")
+					break
+				}
+			}
+			b.WriteString(cell)
+		}
+		b.WriteString("\n")
+	}
+
+	// X-axis
+	b.WriteString(strings.Repeat(" ", yLabelWidth))
+	b.WriteString(" └")
+	b.WriteString(strings.Repeat("─", chartWidth))
+	b.WriteString("\n")
+	startTime := times[0].Format("15:04:05")
+	endTime := times[len(times)-1].Format("15:04:05")
+	padding := chartWidth - len(startTime) - len(endTime)
+	if padding < 0 {
+		padding = 0
+	}
+	b.WriteString(strings.Repeat(" ", yLabelWidth+2))
+	b.WriteString(DetailMutedStyle.Render(startTime))
+	b.WriteString(strings.Repeat(" ", padding))
+	b.WriteString(DetailMutedStyle.Render(endTime))
+	b.WriteString("\n\n")
+
+	// Legend: colour, value, then the series' average and latest value
+	for i, s := range series {
+		var sum, latest float64
+		for _, bucket := range s.Buckets {
+			sum += bucket.Value
+		}
+		avg := 0.0
+		if len(s.Buckets) > 0 {
+			avg = sum / float64(len(s.Buckets))
+			latest = s.Buckets[len(s.Buckets)-1].Value
+		}
+		b.WriteString(seriesStyle(i).Render("██ "))
+		b.WriteString(DetailValueStyle.Render(PadOrTruncate(s.Key, 30)))
+		b.WriteString(DetailMutedStyle.Render(fmt.Sprintf("  avg %s  latest %s  (%d docs)",
+			formatMetricValue(avg), formatMetricValue(latest), s.Count)))
+		if i < len(series)-1 {
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+// heatmapShades shade heatmap cells, from no values to the busiest cell
+var heatmapShades = []rune{' ', '░', '▒', '▓', '█'}
+
+// renderHeatmap renders value counts over time as shaded blocks: one row per
+// value range with the highest values on top, one column per time bucket.
+// Time buckets are merged or stretched to fill the chart width.
+func (m Model) renderHeatmap(h metrics.Heatmap, width int) string {
+	if len(h.Times) == 0 || len(h.Counts) == 0 {
+		return DetailMutedStyle.Render("No data points")
+	}
+
+	yLabelWidth := 10
+	chartWidth := width - yLabelWidth - 2
+	if chartWidth < 10 {
+		chartWidth = 10
+	}
+
+	// Map time buckets onto chart columns
+	n := len(h.Times)
+	cells := make([][]int64, len(h.Counts))
+	var maxCount int64
+	for row, counts := range h.Counts {
+		cells[row] = make([]int64, chartWidth)
+		for col := 0; col < chartWidth; col++ {
+			if n > chartWidth {
+				// Several buckets per column: sum them
+				for i := col * n / chartWidth; i < (col+1)*n/chartWidth; i++ {
+					cells[row][col] += counts[i]
+				}
+			} else {
+				cells[row][col] = counts[col*n/chartWidth]
+			}
+			if cells[row][col] > maxCount {
+				maxCount = cells[row][col]
+			}
+		}
+	}
+
+	var b strings.Builder
+	for row := len(cells) - 1; row >= 0; row-- {
+		rowValue := h.Min + h.Interval*float64(row)
+		b.WriteString(DetailMutedStyle.Render(PadLeft(formatMetricValue(rowValue), yLabelWidth)))
+		b.WriteString(" │")
+		var line strings.Builder
+		for _, c := range cells[row] {
+			line.WriteRune(heatmapShade(c, maxCount))
+		}
+		b.WriteString(SparklineStyle.Render(line.String()))
+		b.WriteString("\n")
+	}
+
+	// X-axis
+	b.WriteString(strings.Repeat(" ", yLabelWidth))
+	b.WriteString(" └")
+	b.WriteString(strings.Repeat("─", chartWidth))
+	b.WriteString("\n")
+	startTime := h.Times[0].Format("15:04:05")
+	endTime := h.Times[n-1].Format("15:04:05")
+	padding := chartWidth - len(startTime) - len(endTime)
+	if padding < 0 {
+		padding = 0
+	}
+	b.WriteString(strings.Repeat(" ", yLabelWidth+2))
+	b.WriteString(DetailMutedStyle.Render(startTime))
+	b.WriteString(strings.Repeat(" ", padding))
+	b.WriteString(DetailMutedStyle.Render(endTime))
+	b.WriteString("\n\n")
+
+	// Scale
+	b.WriteString(strings.Repeat(" ", yLabelWidth+2))
+	b.WriteString(SparklineStyle.Render(string(heatmapShades[1:])))
+	b.WriteString(DetailMutedStyle.Render(fmt.Sprintf("  1 to %d values per cell", maxCount)))
+
+	return b.String()
+}
+
+// heatmapShade returns the block character for a cell holding count values.
+// Any non-zero count is visible; the busiest cell is a full block.
+func heatmapShade(count, maxCount int64) rune {
+	if count <= 0 || maxCount <= 0 {
+		return heatmapShades[0]
+	}
+	idx := 1 + int(float64(count)/float64(maxCount)*float64(len(heatmapShades)-2))
+	if idx >= len(heatmapShades) {
+		idx = len(heatmapShades) - 1
+	}
+	return heatmapShades[idx]
+}
+
+// seriesStyle returns the colour of the i-th series of a multi-series chart
+func seriesStyle(i int) lipgloss.Style {
+	return SeriesStyles[i%len(SeriesStyles)]
+}
@@ -59,6 +59,51 @@ func generateSparkline(buckets []metrics.MetricBucket, width int) string {
 	return result.String()
 }
 
+// countSparkline draws counts as a sparkline scaled from zero, one column per
+// count and right-aligned to width, so gaps without any count stay blank.
+func countSparkline(counts []int64, width int) string {
+	if len(counts) > width {
+		counts = counts[len(counts)-width:]
+	}
+	var maxCount int64
+	for _, c := range counts {
+		maxCount = max(maxCount, c)
+	}
+
+	var result strings.Builder
+	result.WriteString(strings.Repeat(" ", width-len(counts)))
+	for _, c := range counts {
+		if c <= 0 {
+			result.WriteRune(' ')
+			continue
+		}
+		result.WriteRune(sparklineChars[c*int64(len(sparklineChars)-1)/maxCount])
+	}
+	return result.String()
+}
+
+// rateUnit returns the unit suffix of a metric's values: "/s" for counters shown as rates
+func rateUnit(metric metrics.AggregatedMetric) string {
+	if metric.Rate {
+		return "/s"
+	}
+	return ""
+}
+
+// rateBound returns "≥" for counter rates summed over only some of their time
+// series, which makes every value a lower bound
+func rateBound(metric metrics.AggregatedMetric) string {
+	if metric.Partial {
+		return "≥"
+	}
+	return ""
+}
+
+// partialRateNote explains why the rates of a partial counter fall short
+func partialRateNote() string {
+	return fmt.Sprintf("partial: only the %d busiest series", metrics.CounterSeriesLimit)
+}
+
 // formatMetricValue formats a float64 for compact display
 func formatMetricValue(v float64) string {
 	if v != v { // NaN check
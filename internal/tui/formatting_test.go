@@ -7,6 +7,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/elastic/elasticat/internal/es/metrics"
 )
 
 func TestPadLeft(t *testing.T) {
@@ -242,3 +244,93 @@ func TestSingleLine(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderMultiSeriesChart(t *testing.T) {
+	base := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	series := []metrics.MetricSeries{
+		{Key: "pod-a", Count: 2, Buckets: []metrics.MetricBucket{
+			{Timestamp: base, Value: 10},
+			{Timestamp: base.Add(time.Minute), Value: 20},
+		}},
+		// pod-b has no data in the first bucket
+		{Key: "pod-b", Count: 1, Buckets: []metrics.MetricBucket{
+			{Timestamp: base.Add(time.Minute), Value: 0},
+		}},
+	}
+
+	out := Model{}.renderMultiSeriesChart(series, 40, 5)
+	lines := strings.Split(out, "\n")
+	// 5 chart rows, axis, time labels, blank line, 2 legend rows
+	if len(lines) != 10 {
+		t.Fatalf("expected 10 lines, got %d:\n%s", len(lines), out)
+	}
+	if !strings.Contains(lines[8], "pod-a") || !strings.Contains(lines[9], "pod-b") {
+		t.Errorf("expected legend for both series:\n%s", out)
+	}
+	if !strings.Contains(lines[8], "latest 20") {
+		t.Errorf("expected latest value in legend, got %q", lines[8])
+	}
+	// Highest value on the top row, lowest on the bottom row
+	if !strings.Contains(lines[0], "•") || !strings.Contains(lines[4], "•") {
+		t.Errorf("expected points on top and bottom rows:\n%s", out)
+	}
+
+	if got := (Model{}).renderMultiSeriesChart(nil, 40, 5); !strings.Contains(got, "No data points") {
+		t.Errorf("expected empty message, got %q", got)
+	}
+}
+
+func TestRenderHeatmap(t *testing.T) {
+	base := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	h := metrics.Heatmap{
+		Times:    []time.Time{base, base.Add(time.Minute)},
+		Min:      0,
+		Interval: 50,
+		Counts: [][]int64{
+			{8, 1}, // 0-50
+			{0, 4}, // 50-100
+		},
+	}
+
+	out := Model{}.renderHeatmap(h, 32)
+	lines := strings.Split(out, "\n")
+	// 2 value rows, axis, time labels, blank line, scale
+	if len(lines) != 6 {
+		t.Fatalf("expected 6 lines, got %d:\n%s", len(lines), out)
+	}
+	// Highest values on top; the first column stretches over half the width
+	if !strings.HasPrefix(strings.TrimSpace(lines[0]), "50.0 │") || !strings.Contains(lines[0], "▒") {
+		t.Errorf("expected upper row for 50+, got %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "█") || !strings.Contains(lines[1], "░") {
+		t.Errorf("expected busiest and quietest cells in the lower row, got %q", lines[1])
+	}
+	if !strings.Contains(lines[5], "1 to 8 values") {
+		t.Errorf("expected scale, got %q", lines[5])
+	}
+
+	if got := heatmapShade(0, 8); got != ' ' {
+		t.Errorf("expected empty cell for no values, got %q", got)
+	}
+	if got := (Model{}).renderHeatmap(metrics.Heatmap{}, 40); !strings.Contains(got, "No data points") {
+		t.Errorf("expected empty message, got %q", got)
+	}
+}
+
+func TestVolumeShade(t *testing.T) {
+	tests := []struct {
+		count, max int64
+		want       rune
+	}{
+		{0, 10, ' '},
+		{1, 100, '▁'},
+		{50, 100, '▄'},
+		{100, 100, '█'},
+		{5, 0, ' '},
+	}
+	for _, tt := range tests {
+		if got := volumeShade(tt.count, tt.max); got != tt.want {
+			t.Errorf("volumeShade(%d, %d) = %q, want %q", tt.count, tt.max, got, tt.want)
+		}
+	}
+}
@@ -54,3 +54,13 @@ func formatRelativeTime(t time.Time) string {
 func formatFullTime(t time.Time) string {
 	return t.Format("2006-01-02 15:04:05")
 }
+
+// formatTimeWindow formats a time range in local time, omitting the date of
+// the end when both fall on the same day.
+func formatTimeWindow(from, to time.Time) string {
+	from, to = from.Local(), to.Local()
+	if from.YearDay() == to.YearDay() && from.Year() == to.Year() {
+		return from.Format("Jan 02 15:04:05") + "–" + formatClockTime(to)
+	}
+	return from.Format("Jan 02 15:04:05") + "–" + to.Format("Jan 02 15:04:05")
+}
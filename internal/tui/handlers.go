@@ -12,6 +12,11 @@ import (
 
 // handleKey routes key events to mode-specific handlers
 func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Remapped keys are handled as the keys they replace, except while typing
+	if !m.isTextInputActive() {
+		msg = m.keymap.translate(m.UI.Mode, msg)
+	}
+
 	// Global keys
 	key := msg.String()
 	action := GetAction(key)
@@ -21,6 +26,13 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, tea.Quit
 	}
 
+	if m.Tabs.Renaming {
+		return m.handleTabNameKey(msg)
+	}
+	if m, cmd, handled := m.handleTabAction(action); handled {
+		return m, cmd
+	}
+
 	// Quit confirmation (unless in text input or already showing quit modal)
 	if key == "q" && m.UI.Mode != viewQuitConfirm && !m.isTextInputActive() {
 		m.pushView(viewQuitConfirm)
@@ -67,6 +79,11 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if !m.isTextInputActive() && m.UI.Mode != viewChat {
 			return m.enterChatWithSelectedItem()
 		}
+	case ActionPalette:
+		// Open the command palette over any view but modals (ctrl+p recalls history in the ES|QL editor)
+		if !m.isTextInputActive() && !m.isModalView() && (key != "ctrl+p" || m.UI.Mode != viewESQL) {
+			return m, m.openPalette()
+		}
 	}
 
 	// Mode-specific keys
@@ -91,6 +108,26 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleTraceNamesKey(msg)
 	case viewPerspectiveList:
 		return m.handlePerspectiveListKey(msg)
+	case viewLogPatterns:
+		return m.handleLogPatternsKey(msg)
+	case viewExceptions:
+		return m.handleExceptionsKey(msg)
+	case viewServiceMap:
+		return m.handleServiceMapKey(msg)
+	case viewLatency:
+		return m.handleLatencyKey(msg)
+	case viewMetricCompare:
+		return m.handleMetricCompareKey(msg)
+	case viewFieldFilter:
+		return m.handleFieldFilterKey(msg)
+	case viewESQL:
+		return m.handleESQLEditorKey(msg)
+	case viewSavedViews:
+		return m.handleSavedViewsKey(msg)
+	case viewBookmarks:
+		return m.handleBookmarksKey(msg)
+	case viewPalette:
+		return m.handlePaletteKey(msg)
 	case viewErrorModal:
 		return m.handleErrorModalKey(msg)
 	case viewQuitConfirm:
@@ -114,11 +151,22 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // isTextInputActive returns true when a text input is active, disabling global hotkeys like h.
 func (m Model) isTextInputActive() bool {
+	if m.Tabs.Renaming {
+		return true
+	}
 	switch m.UI.Mode {
 	case viewSearch, viewIndex, viewQuery:
 		return true
 	case viewFields:
 		return m.Fields.SearchMode
+	case viewESQL:
+		return m.Editor.Editing || m.Editor.Naming
+	case viewSavedViews:
+		return m.SavedViews.Naming
+	case viewBookmarks:
+		return m.Bookmarks.Editing
+	case viewPalette:
+		return true
 	case viewChat:
 		return m.Chat.InsertMode // Fixed: was m.Chat.Input.Focused()
 	default:
@@ -151,6 +199,7 @@ func (m Model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 					m.Fields.Cursor = 0
 				}
 			}
+			return m, m.maybeFetchFieldFacets()
 		case viewMetricsDashboard:
 			// Scroll up in metrics dashboard
 			if m.Metrics.Cursor > 0 {
@@ -175,6 +224,38 @@ func (m Model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 					m.Perspective.Cursor = 0
 				}
 			}
+		case viewLogPatterns:
+			// Scroll up in patterns list
+			if m.Patterns.Cursor > 0 {
+				m.Patterns.Cursor -= 2
+				if m.Patterns.Cursor < 0 {
+					m.Patterns.Cursor = 0
+				}
+			}
+		case viewExceptions:
+			// Scroll up in exception groups
+			m.Exceptions.Cursor = max(m.Exceptions.Cursor-2, 0)
+		case viewPalette:
+			// Move up the palette entries
+			m.Palette.Cursor = max(m.Palette.Cursor-1, 0)
+		case viewServiceMap:
+			// Scroll up in service map edges
+			if m.ServiceMap.Cursor > 0 {
+				m.ServiceMap.Cursor -= 2
+				if m.ServiceMap.Cursor < 0 {
+					m.ServiceMap.Cursor = 0
+				}
+			}
+		case viewLatency:
+			// Move to a faster bucket
+			if m.Latency.Cursor > 0 {
+				m.Latency.Cursor--
+			}
+		case viewFieldFilter:
+			// Scroll up in the filter picker
+			if m.FilterPicker.Cursor > 0 {
+				m.FilterPicker.Cursor--
+			}
 		case viewChat:
 			// Scroll up in chat viewport
 			m.Chat.Viewport.ScrollUp(3)
@@ -202,6 +283,7 @@ func (m Model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 					m.Fields.Cursor = len(sortedFields) - 1
 				}
 			}
+			return m, m.maybeFetchFieldFacets()
 		case viewMetricsDashboard:
 			// Scroll down in metrics dashboard
 			if m.Metrics.Aggregated != nil && m.Metrics.Cursor < len(m.Metrics.Aggregated.Metrics)-1 {
@@ -210,6 +292,7 @@ func (m Model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 					m.Metrics.Cursor = len(m.Metrics.Aggregated.Metrics) - 1
 				}
 			}
+			return m, m.fetchMoreMetrics()
 		case viewTraceNames:
 			// Scroll down in trace names list
 			if m.Traces.NamesCursor < len(m.Traces.TransactionNames)-1 {
@@ -226,6 +309,38 @@ func (m Model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 					m.Perspective.Cursor = len(m.Perspective.Items) - 1
 				}
 			}
+		case viewLogPatterns:
+			// Scroll down in patterns list
+			if m.Patterns.Cursor < len(m.Patterns.Items)-1 {
+				m.Patterns.Cursor += 2
+				if m.Patterns.Cursor >= len(m.Patterns.Items) {
+					m.Patterns.Cursor = len(m.Patterns.Items) - 1
+				}
+			}
+		case viewExceptions:
+			// Scroll down in exception groups
+			m.Exceptions.Cursor = max(min(m.Exceptions.Cursor+2, len(m.exceptionGroups())-1), 0)
+		case viewPalette:
+			// Move down the palette entries
+			m.Palette.Cursor = max(min(m.Palette.Cursor+1, len(m.paletteItems())-1), 0)
+		case viewServiceMap:
+			// Scroll down in service map edges
+			if n := m.serviceMapEdgeCount(); m.ServiceMap.Cursor < n-1 {
+				m.ServiceMap.Cursor += 2
+				if m.ServiceMap.Cursor >= n {
+					m.ServiceMap.Cursor = n - 1
+				}
+			}
+		case viewLatency:
+			// Move to a slower bucket
+			if m.Latency.Cursor < m.latencyBucketCount()-1 {
+				m.Latency.Cursor++
+			}
+		case viewFieldFilter:
+			// Scroll down in the filter picker
+			if m.FilterPicker.Cursor < len(m.FilterPicker.Fields)-1 {
+				m.FilterPicker.Cursor++
+			}
 		case viewChat:
 			// Scroll down in chat viewport
 			m.Chat.Viewport.ScrollDown(3)
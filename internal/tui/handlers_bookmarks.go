@@ -0,0 +1,286 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+// Time around a bookmarked log that jumping to it loads. More is loaded
+// before it, as that is usually what explains it.
+const (
+	bookmarkContextBefore = 5 * time.Minute
+	bookmarkContextAfter  = time.Minute
+)
+
+// openBookmarks lists the bookmarked documents of all signals.
+func (m *Model) openBookmarks() {
+	noteInput := textinput.New()
+	noteInput.Placeholder = "note"
+	noteInput.CharLimit = 200
+	noteInput.Width = 60
+
+	m.Bookmarks = BookmarksState{NoteInput: noteInput}
+	m.loadBookmarks(config.Bookmark{})
+	m.pushView(viewBookmarks)
+}
+
+// loadBookmarks reloads the list, selecting the given bookmark when present.
+func (m *Model) loadBookmarks(selected config.Bookmark) {
+	bookmarks, err := config.LoadBookmarks()
+	m.Bookmarks.Bookmarks = bookmarks
+	m.Bookmarks.Err = err
+	if i := slices.IndexFunc(bookmarks, selected.Same); i >= 0 {
+		m.Bookmarks.Cursor = i
+	}
+	m.Bookmarks.Cursor = max(min(m.Bookmarks.Cursor, len(bookmarks)-1), 0)
+}
+
+// bookmarkSelected bookmarks the selected log or span and asks for a note.
+// Bookmarking a document again edits its note.
+func (m *Model) bookmarkSelected() tea.Cmd {
+	entry, ok := m.selectedEntry()
+	if !ok {
+		return nil
+	}
+	if m.Filters.Signal != signalLogs && m.Filters.Signal != signalTraces {
+		m.UI.StatusMessage = "Only logs and spans can be bookmarked"
+		m.UI.StatusTime = time.Now()
+		return nil
+	}
+	if entry.ID == "" {
+		m.UI.StatusMessage = "This document has no _id to bookmark"
+		m.UI.StatusTime = time.Now()
+		return nil
+	}
+
+	bookmark := config.Bookmark{
+		Index:     m.client.GetIndex(),
+		ID:        entry.ID,
+		Timestamp: entry.Timestamp,
+		Signal:    strings.ToLower(m.Filters.Signal.String()),
+		Service:   entry.ServiceName,
+		Summary:   singleLine(entry.GetMessage()),
+		TraceID:   entry.TraceID,
+	}
+	if m.Filters.Signal == signalTraces {
+		bookmark.Summary = entry.Name
+	}
+	bookmarks, err := config.LoadBookmarks()
+	if err == nil {
+		if i := slices.IndexFunc(bookmarks, bookmark.Same); i >= 0 {
+			bookmark.Note = bookmarks[i].Note
+		}
+		err = config.SaveBookmark(bookmark)
+	}
+	if err != nil {
+		m.UI.StatusMessage = "Bookmark failed: " + err.Error()
+		m.UI.StatusTime = time.Now()
+		return nil
+	}
+
+	m.openBookmarks()
+	m.loadBookmarks(bookmark)
+	m.UI.StatusMessage = "Bookmarked"
+	m.UI.StatusTime = time.Now()
+	return m.startBookmarkNote()
+}
+
+func (m *Model) startBookmarkNote() tea.Cmd {
+	b, ok := m.selectedBookmark()
+	if !ok {
+		return nil
+	}
+	m.Bookmarks.Editing = true
+	m.Bookmarks.NoteInput.SetValue(b.Note)
+	m.Bookmarks.NoteInput.CursorEnd()
+	return m.Bookmarks.NoteInput.Focus()
+}
+
+func (m Model) selectedBookmark() (config.Bookmark, bool) {
+	if m.Bookmarks.Cursor >= len(m.Bookmarks.Bookmarks) {
+		return config.Bookmark{}, false
+	}
+	return m.Bookmarks.Bookmarks[m.Bookmarks.Cursor], true
+}
+
+func (m Model) handleBookmarksKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.Bookmarks.Editing {
+		return m.handleBookmarkNoteKey(msg)
+	}
+
+	key := msg.String()
+	if newCursor := listNav(m.Bookmarks.Cursor, len(m.Bookmarks.Bookmarks), key); newCursor >= 0 {
+		m.Bookmarks.Cursor = newCursor
+		return m, nil
+	}
+
+	switch key {
+	case "n":
+		return m, m.startBookmarkNote()
+	case "D":
+		if b, ok := m.selectedBookmark(); ok {
+			if err := config.DeleteBookmark(b); err != nil {
+				m.UI.StatusMessage = "Delete failed: " + err.Error()
+			} else {
+				m.UI.StatusMessage = "Deleted bookmark"
+			}
+			m.UI.StatusTime = time.Now()
+			m.loadBookmarks(config.Bookmark{})
+		}
+		return m, nil
+	case "E":
+		if len(m.Bookmarks.Bookmarks) == 0 || m.Bookmarks.Exporting {
+			return m, nil
+		}
+		m.Bookmarks.Exporting = true
+		m.UI.StatusMessage = "Exporting timeline..."
+		m.UI.StatusTime = time.Now()
+		return m, m.exportBookmarks()
+	}
+
+	switch GetAction(key) {
+	case ActionBack:
+		m.popView()
+	case ActionSelect:
+		if b, ok := m.selectedBookmark(); ok {
+			return m, m.jumpToBookmark(b)
+		}
+	}
+	return m, nil
+}
+
+// handleBookmarkNoteKey handles the note prompt. Esc keeps the bookmark
+// with the note it had.
+func (m Model) handleBookmarkNoteKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.Bookmarks.Editing = false
+		m.Bookmarks.NoteInput.Blur()
+		b, ok := m.selectedBookmark()
+		if !ok {
+			return m, nil
+		}
+		b.Note = strings.TrimSpace(m.Bookmarks.NoteInput.Value())
+		if err := config.SaveBookmark(b); err != nil {
+			m.UI.StatusMessage = "Save failed: " + err.Error()
+			m.UI.StatusTime = time.Now()
+		}
+		m.loadBookmarks(b)
+		return m, nil
+	case "esc":
+		m.Bookmarks.Editing = false
+		m.Bookmarks.NoteInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.Bookmarks.NoteInput, cmd = m.Bookmarks.NoteInput.Update(msg)
+	return m, cmd
+}
+
+// jumpToBookmark shows a bookmarked document among its neighbours: the logs
+// around it, or the waterfall of a span's trace. Esc returns to the view the
+// bookmarks were opened from.
+func (m *Model) jumpToBookmark(b config.Bookmark) tea.Cmd {
+	signal, ok := signalFromViewName(b.Signal)
+	var problem string
+	switch {
+	case !ok || (signal != signalLogs && signal != signalTraces):
+		problem = "Can't open a bookmark of signal " + b.Signal
+	case signal == signalTraces && b.TraceID == "":
+		problem = "This span has no trace ID to open"
+	}
+	if problem != "" {
+		m.UI.StatusMessage = problem
+		m.UI.StatusTime = time.Now()
+		return nil
+	}
+
+	m.popView()
+	m.pushSignalJump(signal)
+	m.client.SetIndex(b.Index)
+	if signal == signalTraces {
+		m.Traces = TracesState{ViewLevel: traceViewSpans, SelectedTraceID: b.TraceID}
+	} else {
+		m.Filters.From = b.Timestamp.Add(-bookmarkContextBefore)
+		m.Filters.To = b.Timestamp.Add(bookmarkContextAfter)
+	}
+	m.Logs.SelectID = b.ID
+	m.UI.Loading = true
+	return m.fetchLogs()
+}
+
+// selectPendingEntry selects the document a bookmark jump asked for, once
+// the list it is in has loaded.
+func (m *Model) selectPendingEntry() {
+	id := m.Logs.SelectID
+	if id == "" {
+		return
+	}
+	m.Logs.SelectID = ""
+	i := slices.IndexFunc(m.Logs.Entries, func(e es.LogEntry) bool { return e.ID == id })
+	if i < 0 {
+		m.UI.StatusMessage = "The bookmarked document is not among the loaded entries"
+		m.UI.StatusTime = time.Now()
+		return
+	}
+	m.Logs.SelectedIndex = i
+	m.Logs.UserHasScrolled = true // Keep it selected across refreshes
+}
+
+// exportBookmarks writes the bookmarks to a Markdown incident timeline in
+// the working directory, with each document's fields as the detail view
+// shows them.
+func (m *Model) exportBookmarks() tea.Cmd {
+	bookmarks := slices.Clone(m.Bookmarks.Bookmarks)
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestBookmarkExport, m.tuiConfig.LogsTimeout)
+		defer done()
+
+		ids := make(map[string][]string)
+		for _, b := range bookmarks {
+			ids[b.Index] = append(ids[b.Index], b.ID)
+		}
+		docs := make(map[string]es.LogEntry)
+		for index, indexIDs := range ids {
+			entries, err := m.client.DocumentsByID(ctx, index, indexIDs)
+			if err != nil {
+				return bookmarkExportMsg{err: err}
+			}
+			for _, e := range entries {
+				docs[index+"\x00"+e.ID] = e
+			}
+		}
+
+		timeline := m.renderBookmarkTimeline(bookmarks, func(b config.Bookmark) (es.LogEntry, bool) {
+			e, ok := docs[b.Index+"\x00"+b.ID]
+			return e, ok
+		}, time.Now())
+		path := "elasticat-timeline-" + time.Now().Format("20060102-150405") + ".md"
+		if err := os.WriteFile(path, []byte(timeline), 0600); err != nil {
+			return bookmarkExportMsg{err: fmt.Errorf("write timeline: %w", err)}
+		}
+		return bookmarkExportMsg{path: path, count: len(bookmarks)}
+	}
+}
+
+func (m Model) handleBookmarkExportMsg(msg bookmarkExportMsg) (Model, tea.Cmd) {
+	m.Bookmarks.Exporting = false
+	if m.handleAsyncError(msg.err) {
+		return m, nil
+	}
+	m.UI.StatusMessage = fmt.Sprintf("Exported %d bookmarks to %s", msg.count, msg.path)
+	m.UI.StatusTime = time.Now()
+	return m, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestBookmarks(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	ts := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	doc := es.LogEntry{ID: "doc-1", Timestamp: ts, Body: "db timeout", ServiceName: "checkout", Level: "ERROR",
+		RawJSON: `{"@timestamp":"2026-05-01T12:00:00Z","body":"db timeout","http":{"status":503}}`}
+	m, _ := newTestModel(signalLogs, viewLogs)
+	m.UI.Width = 160
+	m.Filters.Lookback = lookback1h
+	m.Logs = LogsState{Entries: []es.LogEntry{{Body: "no id"}, doc}, SelectedIndex: 1}
+
+	// Bookmarking opens the list with a note prompt
+	typeText(t, &m, "b")
+	if m.UI.Mode != viewBookmarks || !m.Bookmarks.Editing {
+		t.Fatalf("expected the note prompt, got mode=%v editing=%v", m.UI.Mode, m.Bookmarks.Editing)
+	}
+	typeText(t, &m, "first 503")
+	press(t, &m, "enter")
+
+	bookmarks, err := config.LoadBookmarks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bookmarks) != 1 {
+		t.Fatalf("expected one bookmark, got %+v", bookmarks)
+	}
+	want := config.Bookmark{Index: "logs-*", ID: "doc-1", Timestamp: ts, Signal: "logs", Service: "checkout", Summary: "db timeout", Note: "first 503"}
+	if got := bookmarks[0]; got != want {
+		t.Errorf("bookmark = %+v, want %+v", got, want)
+	}
+
+	timeline := m.renderBookmarkTimeline(bookmarks, func(config.Bookmark) (es.LogEntry, bool) { return doc, true }, ts)
+	for _, part := range []string{"## 2026-05-01 12:00:00.000 UTC · checkout · logs", "> first 503", "Message:\ndb timeout", "http:\n  status: 503"} {
+		if !strings.Contains(timeline, part) {
+			t.Errorf("timeline lacks %q:\n%s", part, timeline)
+		}
+	}
+	if missing := m.renderBookmarkTimeline(bookmarks, func(config.Bookmark) (es.LogEntry, bool) { return es.LogEntry{}, false }, ts); !strings.Contains(missing, "no longer found in logs-*") {
+		t.Errorf("expected a missing document to be noted:\n%s", missing)
+	}
+
+	// Jumping loads the time around the document and selects it
+	press(t, &m, "enter")
+	if m.UI.Mode != viewLogs || !m.inSignalJump() {
+		t.Fatalf("expected the logs list as a jump, got mode=%v", m.UI.Mode)
+	}
+	if !m.Filters.From.Equal(ts.Add(-bookmarkContextBefore)) || !m.Filters.To.Equal(ts.Add(bookmarkContextAfter)) {
+		t.Errorf("window = %v..%v, want the time around the bookmark", m.Filters.From, m.Filters.To)
+	}
+	m, _ = m.handleLogsMsg(logsMsg{logs: []es.LogEntry{{ID: "later"}, doc, {ID: "earlier"}}})
+	if m.Logs.SelectedIndex != 1 {
+		t.Errorf("selected %d, want the bookmarked document", m.Logs.SelectedIndex)
+	}
+
+	press(t, &m, "esc")
+	if m.isTimeNarrowed() || len(m.Logs.Entries) != 2 {
+		t.Error("expected esc to return to the list the bookmark was made from")
+	}
+}
@@ -100,8 +100,12 @@ func (m *Model) popView() bool {
 		return false
 	}
 	n := len(m.UI.ViewStack) - 1
-	m.UI.Mode = m.UI.ViewStack[n].Mode
+	ctx := m.UI.ViewStack[n]
+	m.UI.Mode = ctx.Mode
 	m.UI.ViewStack = m.UI.ViewStack[:n]
+	if ctx.Signal != nil {
+		m.restoreSignalContext(ctx.Signal)
+	}
 	return true
 }
 
@@ -134,8 +138,11 @@ func (m *Model) cycleSignalType() tea.Cmd {
 		m.Filters.Signal = signalLogs
 	}
 
-	// Clear navigation history when switching signals
+	// Clear navigation history and signal-specific filters when switching signals
 	m.clearViewStack()
+	m.Filters.Pattern = ""
+	m.Filters.Exception = es.ExceptionFilter{}
+	m.Filters.LookbackPinned = false
 
 	// Chat doesn't use an index pattern
 	if m.Filters.Signal != signalChat {
@@ -204,6 +211,8 @@ func (m *Model) enterPerspectiveView() tea.Cmd {
 
 // cycleLookback advances to the next lookback duration
 func (m *Model) cycleLookback() {
+	// A new lookback replaces any window narrowed from the volume histogram
+	m.Filters.From, m.Filters.To = time.Time{}, time.Time{}
 	for i, lb := range lookbackDurations {
 		if lb == m.Filters.Lookback {
 			m.Filters.Lookback = lookbackDurations[(i+1)%len(lookbackDurations)]
@@ -238,7 +247,7 @@ func (m Model) isBaseView() bool {
 func (m Model) isModalView() bool {
 	switch m.UI.Mode {
 	case viewErrorModal, viewQuitConfirm, viewHelp, viewCredsModal,
-		viewOtelConfigExplain, viewOtelConfigModal, viewOtelConfigUnavailable:
+		viewOtelConfigExplain, viewOtelConfigModal, viewOtelConfigUnavailable, viewPalette:
 		return true
 	}
 	return false
@@ -265,6 +274,12 @@ func (m Model) handleCommonAction(action Action) (Model, tea.Cmd, bool) {
 			m.showCredsModal()
 		}
 		return m, nil, true
+	case ActionViews:
+		m.openSavedViews()
+		return m, nil, true
+	case ActionBookmarks:
+		m.openBookmarks()
+		return m, nil, true
 	}
 	return m, nil, false
 }
@@ -0,0 +1,123 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es/metrics"
+)
+
+func (m Model) handleMetricCompareKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch GetAction(msg.String()) {
+	case ActionPrevItem:
+		if m.Compare.Cursor > 0 {
+			m.Compare.Cursor--
+		}
+	case ActionNextItem:
+		if m.Compare.Cursor < len(m.Compare.Times)-1 {
+			m.Compare.Cursor++
+		}
+	case ActionGoTop:
+		m.Compare.Cursor = 0
+	case ActionGoBottom:
+		if n := len(m.Compare.Times); n > 0 {
+			m.Compare.Cursor = n - 1
+		}
+	case ActionToggle:
+		m.Compare.Stacked = !m.Compare.Stacked
+	case ActionCycleLookback:
+		m.cycleLookback()
+		m.Compare.Loading = true
+		return m, m.fetchMetricCompare()
+	case ActionRefresh:
+		m.Compare.Loading = true
+		return m, m.fetchMetricCompare()
+	case ActionBack:
+		m.popView()
+	case ActionQuit:
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// toggleMetricMark adds or removes a metric from the comparison set
+func (m *Model) toggleMetricMark(name string) {
+	for i, marked := range m.Metrics.Marked {
+		if marked == name {
+			m.Metrics.Marked = append(m.Metrics.Marked[:i:i], m.Metrics.Marked[i+1:]...)
+			return
+		}
+	}
+	m.Metrics.Marked = append(m.Metrics.Marked, name)
+}
+
+// isMetricMarked reports whether a metric is marked for comparison
+func (m Model) isMetricMarked(name string) bool {
+	for _, marked := range m.Metrics.Marked {
+		if marked == name {
+			return true
+		}
+	}
+	return false
+}
+
+// enterMetricCompare opens the marked metrics on a shared time axis.
+func (m *Model) enterMetricCompare() tea.Cmd {
+	if len(m.Metrics.Marked) < 2 {
+		m.UI.StatusMessage = "Mark at least two metrics to compare"
+		m.UI.StatusTime = time.Now()
+		return nil
+	}
+	m.pushView(viewMetricCompare)
+	m.Compare = CompareState{
+		Names:   append([]string(nil), m.Metrics.Marked...),
+		Stacked: m.Compare.Stacked, // Keep the preferred layout
+		Loading: true,
+	}
+	return m.fetchMetricCompare()
+}
+
+// setCompareResult stores the compared metrics in the order they were marked
+// and aligns them on the union of their bucket timestamps.
+func (m *Model) setCompareResult(result *metrics.MetricsAggResult) {
+	byName := make(map[string]metrics.AggregatedMetric)
+	if result != nil {
+		for _, metric := range result.Metrics {
+			byName[metric.Name] = metric
+		}
+	}
+	m.Compare.Metrics = nil
+	for _, name := range m.Compare.Names {
+		if metric, ok := byName[name]; ok {
+			m.Compare.Metrics = append(m.Compare.Metrics, metric)
+		}
+	}
+
+	m.Compare.Times = compareTimes(m.Compare.Metrics)
+	if m.Compare.Cursor >= len(m.Compare.Times) {
+		m.Compare.Cursor = len(m.Compare.Times) - 1
+	}
+	if m.Compare.Cursor < 0 {
+		m.Compare.Cursor = 0
+	}
+}
+
+// compareTimes returns the union of the metrics' bucket timestamps, oldest first
+func compareTimes(ms []metrics.AggregatedMetric) []time.Time {
+	seen := make(map[time.Time]bool)
+	var times []time.Time
+	for _, metric := range ms {
+		for _, b := range metric.Buckets {
+			if !seen[b.Timestamp] {
+				seen[b.Timestamp] = true
+				times = append(times, b.Timestamp)
+			}
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	return times
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elastic/elasticat/internal/es/metrics"
+)
+
+func TestMetricCompareAlignsMarkedMetrics(t *testing.T) {
+	base := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	m, _ := newTestModel(signalMetrics, viewMetricsDashboard)
+	m.UI.Width, m.UI.Height = 100, 30
+
+	m.toggleMetricMark("metrics.cpu")
+	if cmd := m.enterMetricCompare(); cmd != nil || m.UI.Mode != viewMetricsDashboard {
+		t.Fatal("expected comparison to need two marked metrics")
+	}
+	m.toggleMetricMark("metrics.heap")
+	m.toggleMetricMark("metrics.gc")
+	m.toggleMetricMark("metrics.heap") // Unmark
+	if len(m.Metrics.Marked) != 2 || !m.isMetricMarked("metrics.gc") || m.isMetricMarked("metrics.heap") {
+		t.Fatalf("unexpected marks: %v", m.Metrics.Marked)
+	}
+
+	if cmd := m.enterMetricCompare(); cmd == nil || m.UI.Mode != viewMetricCompare || !m.Compare.Loading {
+		t.Fatalf("expected loading comparison view, got mode=%v", m.UI.Mode)
+	}
+
+	// Results come back in discovery order; gc has no data in the first bucket
+	m, _ = m.handleMetricCompareMsg(metricCompareMsg{result: &metrics.MetricsAggResult{Metrics: []metrics.AggregatedMetric{
+		{Name: "metrics.gc", ShortName: "gc", Buckets: []metrics.MetricBucket{
+			{Timestamp: base.Add(time.Minute), Value: 5},
+		}},
+		{Name: "metrics.cpu", ShortName: "cpu", Buckets: []metrics.MetricBucket{
+			{Timestamp: base, Value: 10},
+			{Timestamp: base.Add(time.Minute), Value: 90},
+		}},
+	}}})
+	if len(m.Compare.Metrics) != 2 || m.Compare.Metrics[0].Name != "metrics.cpu" || len(m.Compare.Times) != 2 {
+		t.Fatalf("expected metrics in marking order on 2 timestamps, got %+v", m.Compare.Metrics)
+	}
+
+	next, _ := m.handleMetricCompareKey(keyMsg("right"))
+	m = next.(Model)
+	if m.Compare.Cursor != 1 {
+		t.Fatalf("expected cursor on the second timestamp, got %d", m.Compare.Cursor)
+	}
+	for _, stacked := range []bool{false, true} {
+		m.Compare.Stacked = stacked
+		out := m.renderMetricCompare(20)
+		if !strings.Contains(out, "90.0") || !strings.Contains(out, "10:01:00") {
+			t.Errorf("expected values at the cursor (stacked=%v):\n%s", stacked, out)
+		}
+	}
+
+	m.popView()
+	if m.UI.Mode != viewMetricsDashboard {
+		t.Fatalf("expected return to the dashboard, got mode=%v", m.UI.Mode)
+	}
+}
@@ -112,6 +112,31 @@ func (m Model) handleDetailKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 		}
 		return m, nil
+	case ActionJumpTrace:
+		if m.Filters.Signal == signalLogs {
+			return m, m.jumpToTrace()
+		}
+		return m, nil
+	case ActionFilter:
+		if m.UI.Mode == viewDetail {
+			m.enterFieldFilterPicker()
+		}
+		return m, nil
+	case ActionContext:
+		if m.Filters.Signal == signalLogs {
+			return m, m.openContext()
+		}
+		return m, nil
+	case ActionBookmark:
+		return m, m.bookmarkSelected()
+	case ActionBookmarks:
+		m.openBookmarks()
+		return m, nil
+	case ActionJumpLogs:
+		if m.Filters.Signal == signalTraces {
+			return m, m.jumpToSpanLogs()
+		}
+		return m, nil
 	case ActionSpans:
 		// Show spans for this trace (only for traces)
 		if m.Filters.Signal == signalTraces && len(m.Logs.Entries) > 0 && m.Logs.SelectedIndex < len(m.Logs.Entries) {
@@ -119,6 +144,7 @@ func (m Model) handleDetailKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			if log.TraceID != "" {
 				m.Traces.SelectedTraceID = log.TraceID
 				m.Traces.ViewLevel = traceViewSpans
+				m.clearTraceWaterfall()
 				m.UI.Mode = viewLogs
 				m.Logs.SelectedIndex = 0
 				m.UI.Loading = true
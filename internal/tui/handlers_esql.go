@@ -0,0 +1,304 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+// esqlEditorInputHeight is the number of lines of the query being edited.
+const esqlEditorInputHeight = 6
+
+// openESQLEditor opens the query editor on the last generated ES|QL query,
+// or on a plain query of the current index when the last query was Query DSL.
+func (m *Model) openESQLEditor() tea.Cmd {
+	query := strings.TrimSpace(m.Query.LastJSON)
+	if !strings.HasPrefix(strings.ToUpper(query), "FROM ") {
+		query = fmt.Sprintf("FROM %s\n| SORT @timestamp DESC\n| LIMIT 100", m.client.GetIndex())
+	}
+	return m.openESQLEditorWith(esqlWithMetadataID(query))
+}
+
+// openESQLEditorWith opens the query editor on the given query.
+func (m *Model) openESQLEditorWith(query string) tea.Cmd {
+	input := textarea.New()
+	input.CharLimit = 0
+	input.MaxHeight = 0
+	input.ShowLineNumbers = true
+	input.SetWidth(m.UI.Width - 8)
+	input.SetHeight(esqlEditorInputHeight)
+	input.SetValue(query)
+
+	nameInput := textinput.New()
+	nameInput.Placeholder = "view name"
+	nameInput.CharLimit = 64
+	nameInput.Width = 40
+
+	history := m.Editor.History
+	m.Editor = ESQLEditorState{
+		Input:      input,
+		NameInput:  nameInput,
+		History:    history,
+		HistoryPos: len(history),
+	}
+	m.pushView(viewESQL)
+	return m.startESQLEditing()
+}
+
+// esqlWithMetadataID requests _id on the FROM line so result rows that are
+// documents can be opened in the detail view.
+func esqlWithMetadataID(query string) string {
+	first, rest, _ := strings.Cut(query, "\n")
+	if strings.Contains(strings.ToUpper(first), "METADATA") {
+		return query
+	}
+	if before, after, ok := strings.Cut(first, "|"); ok {
+		// Single-line query: FROM ends at the first pipe
+		first = strings.TrimRight(before, " ") + " METADATA _id | " + strings.TrimLeft(after, " ")
+	} else {
+		first = strings.TrimRight(first, " ") + " METADATA _id"
+	}
+	if rest == "" {
+		return first
+	}
+	return first + "\n" + rest
+}
+
+func (m *Model) startESQLEditing() tea.Cmd {
+	m.Editor.Editing = true
+	return m.Editor.Input.Focus()
+}
+
+func (m Model) handleESQLEditorKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+
+	if m.Editor.Naming {
+		return m.handleESQLViewNameKey(msg)
+	}
+
+	// Keys shared by both modes
+	switch key {
+	case "ctrl+r":
+		return m, m.runESQLEditor()
+	case "ctrl+p":
+		m.recallESQLHistory(-1)
+		return m, nil
+	case "ctrl+n":
+		m.recallESQLHistory(1)
+		return m, nil
+	}
+
+	if m.Editor.Editing {
+		if key == "esc" {
+			m.Editor.Editing = false
+			m.Editor.Input.Blur()
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.Editor.Input, cmd = m.Editor.Input.Update(msg)
+		return m, cmd
+	}
+
+	rows := m.esqlEditorRowCount()
+	switch key {
+	case "i", "tab":
+		return m, m.startESQLEditing()
+	case "S":
+		m.Editor.Naming = true
+		m.Editor.NameInput.SetValue("")
+		return m, m.Editor.NameInput.Focus()
+	}
+
+	switch GetAction(key) {
+	case ActionBack:
+		m.closeESQLEditor()
+	case ActionRefresh:
+		return m, m.runESQLEditor()
+	case ActionScrollUp:
+		if m.Editor.Row > 0 {
+			m.Editor.Row--
+		}
+	case ActionScrollDown:
+		if m.Editor.Row < rows-1 {
+			m.Editor.Row++
+		}
+	case ActionPageUp:
+		m.Editor.Row = max(m.Editor.Row-10, 0)
+	case ActionPageDown:
+		m.Editor.Row = max(min(m.Editor.Row+10, rows-1), 0)
+	case ActionGoTop:
+		m.Editor.Row = 0
+	case ActionGoBottom:
+		m.Editor.Row = max(rows-1, 0)
+	case ActionPrevItem:
+		if m.Editor.ColOffset > 0 {
+			m.Editor.ColOffset--
+		}
+	case ActionNextItem:
+		if m.Editor.Result != nil && m.Editor.ColOffset < len(m.Editor.Result.Columns)-1 {
+			m.Editor.ColOffset++
+		}
+	case ActionSelect:
+		m.openESQLEditorRow()
+	case ActionCopy:
+		m.copyToClipboard(m.Editor.Input.Value(), "Copied query to clipboard!")
+	}
+	return m, nil
+}
+
+// handleESQLViewNameKey handles the name prompt of "save as view".
+func (m Model) handleESQLViewNameKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		name := strings.TrimSpace(m.Editor.NameInput.Value())
+		if name == "" {
+			return m, nil
+		}
+		m.Editor.Naming = false
+		m.Editor.NameInput.Blur()
+		if err := config.SaveView(config.View{Name: name, ESQL: m.Editor.Input.Value()}); err != nil {
+			m.UI.StatusMessage = "Save failed: " + err.Error()
+		} else {
+			m.UI.StatusMessage = "Saved view " + name
+		}
+		m.UI.StatusTime = time.Now()
+		return m, nil
+	case "esc":
+		m.Editor.Naming = false
+		m.Editor.NameInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.Editor.NameInput, cmd = m.Editor.NameInput.Update(msg)
+	return m, cmd
+}
+
+// runESQLEditor runs the query being edited and records it in the history.
+func (m *Model) runESQLEditor() tea.Cmd {
+	query := strings.TrimSpace(m.Editor.Input.Value())
+	if query == "" {
+		return nil
+	}
+	if n := len(m.Editor.History); n == 0 || m.Editor.History[n-1] != query {
+		m.Editor.History = append(m.Editor.History, query)
+	}
+	m.Editor.HistoryPos = len(m.Editor.History)
+	m.Editor.Draft = ""
+	m.Editor.Running = true
+	return m.fetchESQLEditor(query)
+}
+
+// fetchESQLEditor runs a query typed in the ES|QL editor
+func (m *Model) fetchESQLEditor(query string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestESQLEditor, m.tuiConfig.LogsTimeout)
+		defer done()
+
+		result, err := m.client.ExecuteESQLQuery(ctx, query)
+		return esqlEditorMsg{query: query, result: result, err: err}
+	}
+}
+
+// handleESQLEditorMsg shows the result of a run. Failures are shown inline,
+// next to the query, rather than in the error modal.
+func (m Model) handleESQLEditorMsg(msg esqlEditorMsg) (Model, tea.Cmd) {
+	m.Editor.Running = false
+	if isContextError(msg.err) {
+		return m, nil
+	}
+
+	m.Editor.Ran = msg.query
+	m.Editor.Err = msg.err
+	m.Editor.Result = msg.result
+	m.Editor.Row = 0
+	m.Editor.ColOffset = 0
+	if msg.err == nil && m.UI.Mode == viewESQL {
+		// Move to the results so they can be browsed straight away
+		m.Editor.Editing = false
+		m.Editor.Input.Blur()
+	}
+	return m, nil
+}
+
+// recallESQLHistory steps through the queries run this session; stepping past
+// the newest returns to the query that was being written.
+func (m *Model) recallESQLHistory(step int) {
+	pos := m.Editor.HistoryPos + step
+	if pos < 0 || pos > len(m.Editor.History) {
+		return
+	}
+	if m.Editor.HistoryPos == len(m.Editor.History) {
+		m.Editor.Draft = m.Editor.Input.Value()
+	}
+	m.Editor.HistoryPos = pos
+	if pos == len(m.Editor.History) {
+		m.Editor.Input.SetValue(m.Editor.Draft)
+	} else {
+		m.Editor.Input.SetValue(m.Editor.History[pos])
+	}
+}
+
+// openESQLEditorRow opens the selected result row in the detail view when it
+// is a document. Document rows stand in for the log list while the detail
+// view is open, so ←/→ step through them; closing the editor restores it.
+func (m *Model) openESQLEditorRow() {
+	res := m.Editor.Result
+	if res == nil || m.Editor.Row >= len(res.Values) {
+		return
+	}
+
+	var docs []es.LogEntry
+	selected := -1
+	for i, row := range res.Values {
+		entry, ok := es.ESQLDocument(res.Columns, row)
+		if !ok {
+			continue
+		}
+		if i == m.Editor.Row {
+			selected = len(docs)
+		}
+		docs = append(docs, entry)
+	}
+	if selected < 0 {
+		m.UI.StatusMessage = "Only rows with @timestamp and _id open in the detail view"
+		m.UI.StatusTime = time.Now()
+		return
+	}
+
+	if m.Editor.SavedLogs == nil {
+		saved := m.Logs
+		m.Editor.SavedLogs = &saved
+	}
+	m.Logs.Entries = docs
+	m.Logs.SelectedIndex = selected
+	m.pushView(viewDetail)
+	m.setViewportContent(m.renderLogDetail(docs[selected]))
+	m.Components.Viewport.GotoTop()
+}
+
+// closeESQLEditor leaves the editor, restoring the log list if result rows replaced it.
+func (m *Model) closeESQLEditor() {
+	if m.Editor.SavedLogs != nil {
+		m.Logs = *m.Editor.SavedLogs
+		m.Editor.SavedLogs = nil
+	}
+	m.Editor.Input.Blur()
+	m.popView()
+}
+
+func (m Model) esqlEditorRowCount() int {
+	if m.Editor.Result == nil {
+		return 0
+	}
+	return len(m.Editor.Result.Values)
+}
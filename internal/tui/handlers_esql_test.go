@@ -0,0 +1,119 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es"
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+func TestESQLEditorRunAndOpenRow(t *testing.T) {
+	m, src := newTestModel(signalLogs, viewLogs)
+	src.esqlResult = &es.ESQLResult{
+		Columns: []es.ESQLColumn{{Name: "@timestamp"}, {Name: "_id"}, {Name: "message"}},
+		Values: [][]interface{}{
+			{"2026-01-02T03:04:05.000Z", "a", "first"},
+			{"2026-01-02T03:04:06.000Z", "b", "second"},
+		},
+	}
+	m.Logs.Entries = []es.LogEntry{{Body: "log list"}}
+	m.Query.LastJSON = "FROM logs-*\n| WHERE true\n| LIMIT 100"
+
+	run := func() {
+		t.Helper()
+		cmd := press(t, &m, "ctrl+r")
+		if cmd == nil {
+			t.Fatal("expected ctrl+r to run the query")
+		}
+		m, _ = m.handleESQLEditorMsg(cmd().(esqlEditorMsg))
+	}
+
+	// The editor starts from the generated query, asking for _id
+	m.pushView(viewQuery)
+	press(t, &m, "e")
+	if m.UI.Mode != viewESQL || !m.Editor.Editing {
+		t.Fatalf("expected the editor in insert mode, got mode=%v editing=%v", m.UI.Mode, m.Editor.Editing)
+	}
+	if got := m.Editor.Input.Value(); !strings.HasPrefix(got, "FROM logs-* METADATA _id\n| WHERE true") {
+		t.Fatalf("unexpected starting query: %q", got)
+	}
+
+	run()
+	if len(src.queries) != 1 || m.Editor.Editing {
+		t.Fatalf("expected one run and focus on the results, got %d runs editing=%v", len(src.queries), m.Editor.Editing)
+	}
+	out := m.renderESQLEditor()
+	for _, want := range []string{"2 rows, 3 columns", "message", "second"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected editor to contain %q", want)
+		}
+	}
+
+	// Document rows open in the detail view and stand in for the log list
+	press(t, &m, "down")
+	press(t, &m, "enter")
+	if m.UI.Mode != viewDetail || m.Logs.SelectedIndex != 1 || m.Logs.Entries[1].GetMessage() != "second" {
+		t.Fatalf("expected the second row in the detail view, got mode=%v index=%d", m.UI.Mode, m.Logs.SelectedIndex)
+	}
+	press(t, &m, "esc")
+	press(t, &m, "esc")
+	if m.UI.Mode != viewLogs || len(m.Logs.Entries) != 1 || m.Logs.Entries[0].Body != "log list" {
+		t.Fatalf("expected the log list restored on close, got mode=%v entries=%+v", m.UI.Mode, m.Logs.Entries)
+	}
+}
+
+func TestESQLEditorErrorsAndHistory(t *testing.T) {
+	m, src := newTestModel(signalLogs, viewLogs)
+	m.openESQLEditor()
+	first := m.Editor.Input.Value()
+	if !strings.HasPrefix(first, "FROM logs-* METADATA _id") {
+		t.Fatalf("expected a default query on the current index, got %q", first)
+	}
+
+	// A parse error is shown inline with its position marked
+	m.Editor.Input.SetValue("FROM logs-*\n| WHER x")
+	src.esqlErr = &shared.ESQLQueryError{Line: 2, Column: 3, Message: "mismatched input 'WHER'"}
+	cmd := m.runESQLEditor()
+	m, _ = m.handleESQLEditorMsg(cmd().(esqlEditorMsg))
+	m.Editor.Editing = false
+	if m.UI.Mode != viewESQL {
+		t.Fatalf("expected errors to stay in the editor, got mode=%v", m.UI.Mode)
+	}
+	out := m.renderESQLEditor()
+	if !strings.Contains(out, "line 2:3: mismatched input 'WHER'") {
+		t.Errorf("expected the error position in the editor, got:\n%s", out)
+	}
+	if marked := m.renderESQLQueryText(); len(marked) != 2 || !strings.Contains(marked[1], "WHER") {
+		t.Errorf("unexpected query text: %q", marked)
+	}
+
+	// History recalls runs, newest first, then returns to the draft
+	m.Editor.Input.SetValue("FROM traces-*")
+	m.recallESQLHistory(-1)
+	if got := m.Editor.Input.Value(); got != "FROM logs-*\n| WHER x" {
+		t.Fatalf("expected the last run, got %q", got)
+	}
+	m.recallESQLHistory(-1)
+	m.recallESQLHistory(1)
+	if got := m.Editor.Input.Value(); got != "FROM traces-*" {
+		t.Fatalf("expected the draft back, got %q", got)
+	}
+}
+
+func TestESQLWithMetadataID(t *testing.T) {
+	tests := map[string]string{
+		"FROM logs-*":                      "FROM logs-* METADATA _id",
+		"FROM logs-* | LIMIT 5":            "FROM logs-* METADATA _id | LIMIT 5",
+		"FROM logs-*\n| LIMIT 5":           "FROM logs-* METADATA _id\n| LIMIT 5",
+		"FROM logs-* METADATA _index\n| x": "FROM logs-* METADATA _index\n| x",
+	}
+	for in, want := range tests {
+		if got := esqlWithMetadataID(in); got != want {
+			t.Errorf("esqlWithMetadataID(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func (m Model) handleExceptionsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	action := GetAction(key)
+	groups := m.exceptionGroups()
+
+	// Handle list navigation (adds pgup/pgdown, home/end support)
+	if isNavKey(key) {
+		m.Exceptions.Cursor = listNav(m.Exceptions.Cursor, len(groups), key)
+		return m, nil
+	}
+
+	switch action {
+	case ActionSelect:
+		// Drill down: show the occurrences of the selected group, from which
+		// T jumps to their traces
+		if m.Exceptions.Cursor < len(groups) {
+			g := groups[m.Exceptions.Cursor]
+			m.Filters.Exception = g.ExceptionFilter
+			m.pushView(viewLogs)
+			m.Logs.SelectedIndex = 0
+			m.Logs.UserHasScrolled = false // Reset for tail -f behavior
+			m.UI.StatusMessage = "Occurrences of " + TruncateWithEllipsis(singleLine(g.String()), 40)
+			m.UI.StatusTime = time.Now()
+			m.UI.Loading = true
+			return m, m.fetchLogs()
+		}
+		return m, nil
+	case ActionToggle:
+		if m.Exceptions.By == es.ExceptionsByMessage {
+			m.Exceptions.By = es.ExceptionsByFrame
+		} else {
+			m.Exceptions.By = es.ExceptionsByMessage
+		}
+		m.Exceptions.Cursor = 0
+		m.Exceptions.Loading = true
+		return m, m.fetchExceptions()
+	case ActionCycleLookback:
+		m.cycleLookback()
+		m.Exceptions.Loading = true
+		return m, m.fetchExceptions()
+	case ActionRefresh:
+		m.Exceptions.Loading = true
+		return m, m.fetchExceptions()
+	case ActionKibana:
+		if m.prepareKibanaURL() {
+			m.showCredsModal()
+		}
+		return m, nil
+	case ActionQuery:
+		m.pushView(viewQuery)
+		m.Query.Format = formatKibana
+		return m, nil
+	case ActionBack:
+		m.popView()
+		return m, nil
+	case ActionQuit:
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// exceptionGroups returns the groups of the errors view, most frequent first.
+func (m Model) exceptionGroups() []es.ExceptionGroup {
+	if m.Exceptions.Result == nil {
+		return nil
+	}
+	return m.Exceptions.Result.Groups
+}
+
+// enterExceptionsView opens the errors view for the current log filters.
+// When the log list is itself a drill-down from the errors view, it returns
+// there instead of stacking another copy.
+func (m *Model) enterExceptionsView() tea.Cmd {
+	if m.UI.Mode == viewLogs && m.peekViewStack() == viewExceptions {
+		m.popView()
+	} else {
+		m.pushView(viewExceptions)
+		m.Exceptions.Cursor = 0
+	}
+	m.Exceptions.Loading = true
+	return m.fetchExceptions()
+}
+
+// clearExceptionFilter removes the error group drill-down filter from the log
+// list, returning to the errors view if that's where the drill-down started.
+func (m *Model) clearExceptionFilter() tea.Cmd {
+	m.Filters.Exception = es.ExceptionFilter{}
+	m.Logs.SelectedIndex = 0
+	m.Logs.UserHasScrolled = false
+	if m.peekViewStack() == viewExceptions {
+		m.popView()
+	}
+	m.UI.Loading = true
+	return m.fetchLogs()
+}
+
+func (m Model) handleExceptionsMsg(msg exceptionsMsg) (Model, tea.Cmd) {
+	m.Exceptions.Loading = false
+	if m.handleAsyncError(msg.err) {
+		return m, nil
+	}
+
+	m.Exceptions.Result = msg.result
+	if m.Exceptions.Cursor >= len(m.exceptionGroups()) {
+		m.Exceptions.Cursor = 0
+	}
+	// Store the ES|QL query for display and Kibana integration
+	if msg.query != "" {
+		m.Query.LastJSON = msg.query
+		m.Query.LastIndex = m.client.GetIndex()
+	}
+	m.UI.Err = nil
+	return m, nil
+}
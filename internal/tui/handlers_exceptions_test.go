@@ -0,0 +1,58 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestExceptionsView(t *testing.T) {
+	timeout := es.ExceptionGroup{
+		ExceptionFilter: es.ExceptionFilter{Type: "TimeoutError", Key: "read timed out after <n>ms"},
+		Count:           7,
+		Services:        []string{"api", "worker"},
+		Buckets:         []int64{2, 0, 5},
+	}
+	m, src := newTestModel(signalLogs, viewLogs)
+	src.exceptions = &es.ExceptionGroups{Groups: []es.ExceptionGroup{timeout, {ExceptionFilter: es.ExceptionFilter{Type: "KeyError"}, Count: 1}}}
+	m.UI.Width = 160
+	m.Filters.Lookback = lookback1h
+	load := func(cmd tea.Cmd) {
+		t.Helper()
+		next, _ := m.Update(cmd())
+		m = next.(Model)
+	}
+
+	load(press(t, &m, "E"))
+	if m.UI.Mode != viewExceptions || len(m.exceptionGroups()) != 2 {
+		t.Fatalf("expected the errors view with 2 groups, got mode=%v", m.UI.Mode)
+	}
+	view := m.renderExceptions(20)
+	for _, want := range []string{"EXCEPTION BY MESSAGE", "TimeoutError: read timed out after <n>ms", "api, worker", "▃ █"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view lacks %q:\n%s", want, view)
+		}
+	}
+
+	load(press(t, &m, "space"))
+	if src.exceptionsBy != es.ExceptionsByFrame || !strings.Contains(m.renderExceptions(20), "EXCEPTION BY TOP FRAME") {
+		t.Error("expected space to group by top frame")
+	}
+	load(press(t, &m, "space"))
+
+	// Drill down to the occurrences of the selected group
+	load(press(t, &m, "enter"))
+	if m.UI.Mode != viewLogs || m.Filters.Exception != timeout.ExceptionFilter || src.tailOpts.Exception != timeout.ExceptionFilter {
+		t.Fatalf("expected the occurrences of the timeouts, got mode=%v filter=%+v query=%+v", m.UI.Mode, m.Filters.Exception, src.tailOpts.Exception)
+	}
+
+	load(press(t, &m, "esc"))
+	if m.UI.Mode != viewExceptions || !m.Filters.Exception.IsZero() {
+		t.Errorf("expected esc to clear the filter and return to the errors view, got mode=%v", m.UI.Mode)
+	}
+}
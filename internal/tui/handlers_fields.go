@@ -8,6 +8,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/elastic/elasticat/internal/es"
+	"github.com/elastic/elasticat/internal/es/shared"
 )
 
 func (m Model) handleFieldsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
@@ -23,7 +24,7 @@ func (m Model) handleFieldsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m, nil
 		case ActionSelect:
 			m.Fields.SearchMode = false
-			return m, nil
+			return m, m.maybeFetchFieldFacets()
 		}
 		switch key {
 		case "backspace":
@@ -40,6 +41,10 @@ func (m Model) handleFieldsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	if m.Fields.Facets.Focused {
+		return m.handleFieldFacetsKey(msg)
+	}
+
 	// Get the sorted field list for navigation
 	sortedFields := m.getSortedFieldList()
 
@@ -77,6 +82,11 @@ func (m Model) handleFieldsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			fieldName := sortedFields[m.Fields.Cursor].Name
 			m.toggleField(fieldName)
 		}
+	case ActionNextItem:
+		// Move into the top-values panel
+		if m.facetValueCount() > 0 {
+			m.Fields.Facets.Focused = true
+		}
 	case ActionSearch:
 		m.Fields.SearchMode = true
 		m.Fields.Search = ""
@@ -85,6 +95,72 @@ func (m Model) handleFieldsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.Fields.Display = DefaultFields(m.Filters.Signal)
 	}
 
+	return m, m.maybeFetchFieldFacets()
+}
+
+// handleFieldFacetsKey handles keys while the top-values panel has focus.
+func (m Model) handleFieldFacetsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	facets := &m.Fields.Facets
+	count := m.facetValueCount()
+
+	switch action := GetAction(key); {
+	case action == ActionScrollUp:
+		if facets.Cursor > 0 {
+			facets.Cursor--
+		}
+	case action == ActionScrollDown:
+		if facets.Cursor < count-1 {
+			facets.Cursor++
+		}
+	case action == ActionSelect || key == "+":
+		if facets.Cursor < count {
+			value := facets.Result.Values[facets.Cursor].Value
+			return m, m.applyFieldFilter(es.FieldFilter{Field: facets.Field, Op: shared.FieldEquals, Value: value})
+		}
+	case key == "-":
+		if facets.Cursor < count {
+			value := facets.Result.Values[facets.Cursor].Value
+			return m, m.applyFieldFilter(es.FieldFilter{Field: facets.Field, Op: shared.FieldEquals, Value: value, Negate: true})
+		}
+	case action == ActionPrevItem || action == ActionBack:
+		facets.Focused = false
+	}
+	return m, nil
+}
+
+// maybeFetchFieldFacets loads the top values of the highlighted field unless
+// they are already loaded or loading. Display-only fields have no values.
+func (m *Model) maybeFetchFieldFacets() tea.Cmd {
+	sortedFields := m.getSortedFieldList()
+	if m.Fields.Cursor < 0 || m.Fields.Cursor >= len(sortedFields) {
+		m.Fields.Facets = FacetState{}
+		return nil
+	}
+	field := sortedFields[m.Fields.Cursor]
+	if field.Name == m.Fields.Facets.Field {
+		return nil
+	}
+	m.Fields.Facets = FacetState{Field: field.Name}
+	if field.Type == "display" {
+		return nil
+	}
+	m.Fields.Facets.Loading = true
+	return m.fetchFieldFacets(field)
+}
+
+func (m Model) handleFieldFacetsMsg(msg fieldFacetsMsg) (Model, tea.Cmd) {
+	if msg.field != m.Fields.Facets.Field {
+		// The cursor has moved on to another field
+		return m, nil
+	}
+	m.Fields.Facets.Loading = false
+	if m.handleAsyncError(msg.err) {
+		return m, nil
+	}
+
+	m.Fields.Facets.Result = msg.result
+	m.Fields.Facets.Cursor = 0
 	return m, nil
 }
 
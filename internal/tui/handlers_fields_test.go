@@ -0,0 +1,80 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestFieldFacetsFilterByValue(t *testing.T) {
+	m, src := newTestModel(signalLogs, viewLogs)
+	m.Fields.Display = nil // Shown columns would head the field list
+	m.pushView(viewFields)
+
+	pressInFields := func(key string) tea.Cmd {
+		next, cmd := m.handleFieldsKey(keyMsg(key))
+		m = next.(Model)
+		return cmd
+	}
+
+	// Loading the field list fetches the facets of the first field
+	m, cmd := m.handleFieldCapsMsg(fieldCapsMsg{fields: []es.FieldInfo{
+		{Name: "host.name", Type: "keyword", DocCount: 20},
+		{Name: "http.status", Type: "long", DocCount: 10},
+	}})
+	if cmd == nil {
+		t.Fatal("expected a facets request")
+	}
+	cmd()
+	if src.facetField != "host.name" || src.facetNumeric {
+		t.Fatalf("unexpected facets request: field=%q numeric=%v", src.facetField, src.facetNumeric)
+	}
+
+	// Moving the cursor requests the next field; stale responses are ignored
+	cmd = pressInFields("down")
+	cmd()
+	if src.facetField != "http.status" || !src.facetNumeric {
+		t.Fatalf("expected numeric facets for http.status, got field=%q numeric=%v", src.facetField, src.facetNumeric)
+	}
+	m, _ = m.handleFieldFacetsMsg(fieldFacetsMsg{field: "host.name", result: &es.FieldFacets{Field: "host.name"}})
+	if m.Fields.Facets.Result != nil {
+		t.Fatal("expected the stale host.name facets to be ignored")
+	}
+	m, _ = m.handleFieldFacetsMsg(fieldFacetsMsg{field: "http.status", result: &es.FieldFacets{
+		Field:   "http.status",
+		Total:   200,
+		Present: 150,
+		Values:  []es.FacetValue{{Value: "200", Count: 100}, {Value: "503", Count: 50}},
+		Numeric: &es.NumericSummary{Min: 200, Max: 503, Avg: 301},
+	}})
+
+	out := m.renderFieldSelector()
+	for _, want := range []string{"Top values: http.status", "150 of 200 docs", "min 200", "50.0%", "25.0%"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected field selector to contain %q", want)
+		}
+	}
+
+	// Select the second value and include it
+	pressInFields("right")
+	if !m.Fields.Facets.Focused {
+		t.Fatal("expected → to focus the top values")
+	}
+	pressInFields("down")
+	if m.Fields.Cursor != 1 || m.Fields.Facets.Cursor != 1 {
+		t.Fatalf("expected only the value cursor to move, got field=%d value=%d", m.Fields.Cursor, m.Fields.Facets.Cursor)
+	}
+	cmd = pressInFields("enter")
+	if m.UI.Mode != viewLogs {
+		t.Fatalf("expected return to the list, got mode=%v", m.UI.Mode)
+	}
+	cmd()
+	if len(src.tailOpts.FieldFilters) != 1 || src.tailOpts.FieldFilters[0].String() != "http.status: 503" {
+		t.Fatalf("expected the selected value as a filter, got %+v", src.tailOpts.FieldFilters)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es"
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+// docField is one field/value pair of a document, offered by the filter picker.
+type docField struct {
+	Name  string
+	Value string
+}
+
+// handleFieldFilterKey handles the filter-by-value picker opened from the detail view.
+func (m Model) handleFieldFilterKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	action := GetAction(key)
+
+	if isNavKey(key) {
+		m.FilterPicker.Cursor = listNav(m.FilterPicker.Cursor, len(m.FilterPicker.Fields), key)
+		return m, nil
+	}
+
+	field, ok := m.selectedDocField()
+	switch {
+	case action == ActionSelect || key == "+":
+		if ok {
+			return m, m.applyFieldFilter(es.FieldFilter{Field: field.Name, Op: shared.FieldEquals, Value: field.Value})
+		}
+	case key == "-":
+		if ok {
+			return m, m.applyFieldFilter(es.FieldFilter{Field: field.Name, Op: shared.FieldEquals, Value: field.Value, Negate: true})
+		}
+	case key == "e":
+		if ok {
+			return m, m.applyFieldFilter(es.FieldFilter{Field: field.Name, Op: shared.FieldExists})
+		}
+	case action == ActionBack:
+		m.popView()
+	case action == ActionQuit:
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// enterFieldFilterPicker lists the fields of the selected document for filtering.
+func (m *Model) enterFieldFilterPicker() {
+	if len(m.Logs.Entries) == 0 || m.Logs.SelectedIndex >= len(m.Logs.Entries) {
+		return
+	}
+	fields := docFieldValues(m.Logs.Entries[m.Logs.SelectedIndex].RawJSON)
+	if len(fields) == 0 {
+		m.UI.StatusMessage = "No fields to filter on"
+		m.UI.StatusTime = time.Now()
+		return
+	}
+	m.FilterPicker = FilterPickerState{Fields: fields}
+	m.pushView(viewFieldFilter)
+}
+
+func (m Model) selectedDocField() (docField, bool) {
+	if m.FilterPicker.Cursor < 0 || m.FilterPicker.Cursor >= len(m.FilterPicker.Fields) {
+		return docField{}, false
+	}
+	return m.FilterPicker.Fields[m.FilterPicker.Cursor], true
+}
+
+// applyFieldFilter adds a filter and returns to the list, refetching it.
+// Used by the filter picker and the field selector's top-values panel.
+func (m *Model) applyFieldFilter(f es.FieldFilter) tea.Cmd {
+	m.addFieldFilter(f)
+	for m.UI.Mode == viewFieldFilter || m.UI.Mode == viewFields || m.UI.Mode == viewDetail || m.UI.Mode == viewDetailJSON {
+		if !m.popView() {
+			break
+		}
+	}
+	m.UI.StatusMessage = "Filter: " + f.String()
+	m.UI.StatusTime = time.Now()
+	return m.refetchFiltered()
+}
+
+// addFieldFilter appends a filter, replacing one on the same field and value
+// (so including a value that was excluded flips it). The slice is copied
+// because FilterState snapshots share it via the view stack.
+func (m *Model) addFieldFilter(f es.FieldFilter) {
+	filters := make([]es.FieldFilter, 0, len(m.Filters.FieldFilters)+1)
+	for _, existing := range m.Filters.FieldFilters {
+		if existing.Field == f.Field && existing.Op == f.Op && existing.Value == f.Value {
+			continue
+		}
+		filters = append(filters, existing)
+	}
+	m.Filters.FieldFilters = append(filters, f)
+}
+
+// removeFieldFilter drops the filter at index i.
+func (m *Model) removeFieldFilter(i int) {
+	if i < 0 || i >= len(m.Filters.FieldFilters) {
+		return
+	}
+	filters := make([]es.FieldFilter, 0, len(m.Filters.FieldFilters)-1)
+	filters = append(filters, m.Filters.FieldFilters[:i]...)
+	m.Filters.FieldFilters = append(filters, m.Filters.FieldFilters[i+1:]...)
+}
+
+// toggleFieldFilter flips the filter at index i between include and exclude.
+func (m *Model) toggleFieldFilter(i int) {
+	if i < 0 || i >= len(m.Filters.FieldFilters) {
+		return
+	}
+	filters := append([]es.FieldFilter(nil), m.Filters.FieldFilters...)
+	filters[i].Negate = !filters[i].Negate
+	m.Filters.FieldFilters = filters
+}
+
+// handleFilterChipsKey handles keys while the filter chips in the status bar
+// have focus. Keys it doesn't consume fall through to the log list.
+func (m Model) handleFilterChipsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	count := len(m.Filters.FieldFilters)
+
+	switch GetAction(msg.String()) {
+	case ActionPrevItem:
+		if m.Chips.Cursor > 0 {
+			m.Chips.Cursor--
+		}
+	case ActionNextItem:
+		if m.Chips.Cursor < count-1 {
+			m.Chips.Cursor++
+		}
+	case ActionSelect:
+		m.removeFieldFilter(m.Chips.Cursor)
+		if len(m.Filters.FieldFilters) == 0 {
+			m.Chips.Focused = false
+		}
+		m.Chips.Cursor = min(m.Chips.Cursor, max(len(m.Filters.FieldFilters)-1, 0))
+		return m, m.refetchFiltered(), true
+	case ActionToggle:
+		m.toggleFieldFilter(m.Chips.Cursor)
+		return m, m.refetchFiltered(), true
+	case ActionBack, ActionFilter:
+		m.Chips.Focused = false
+	default:
+		return m, nil, false
+	}
+	return m, nil, true
+}
+
+// refetchFiltered reloads the log list after a filter change.
+func (m *Model) refetchFiltered() tea.Cmd {
+	m.Logs.SelectedIndex = 0
+	m.Logs.UserHasScrolled = false
+	m.UI.Loading = true
+	return m.fetchLogs()
+}
+
+// docFieldValues flattens a document into sorted field/value pairs. Arrays of
+// scalars yield one pair per element; objects recurse with dotted names.
+func docFieldValues(raw string) []docField {
+	if raw == "" {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	dec.UseNumber()
+	var doc map[string]interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil
+	}
+
+	var out []docField
+	var walk func(prefix string, v interface{})
+	walk = func(prefix string, v interface{}) {
+		switch val := v.(type) {
+		case map[string]interface{}:
+			for k, child := range val {
+				name := k
+				if prefix != "" {
+					name = prefix + "." + k
+				}
+				walk(name, child)
+			}
+		case []interface{}:
+			for _, item := range val {
+				walk(prefix, item)
+			}
+		case nil:
+		case string:
+			out = append(out, docField{Name: prefix, Value: val})
+		case json.Number:
+			out = append(out, docField{Name: prefix, Value: val.String()})
+		case bool:
+			out = append(out, docField{Name: prefix, Value: strconv.FormatBool(val)})
+		default:
+			out = append(out, docField{Name: prefix, Value: fmt.Sprint(val)})
+		}
+	}
+	walk("", doc)
+
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
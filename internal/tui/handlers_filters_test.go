@@ -0,0 +1,74 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestFieldFiltersFromDetailView(t *testing.T) {
+	m, src := newTestModel(signalLogs, viewLogs)
+	m.UI.Height = 30
+	m.Logs.Entries = []es.LogEntry{{
+		RawJSON: `{"http":{"response":{"status_code":500}},"k8s":{"pod":{"name":"api-1"}},"tags":["a","b"],"trace_id":null}`,
+	}}
+
+	pressIn := func(handle func(tea.KeyMsg) (tea.Model, tea.Cmd), key string) tea.Cmd {
+		next, cmd := handle(keyMsg(key))
+		m = next.(Model)
+		return cmd
+	}
+
+	// Include the status code from the detail view
+	m.pushView(viewDetail)
+	pressIn(m.handleDetailKey, "F")
+	if m.UI.Mode != viewFieldFilter {
+		t.Fatalf("expected filter picker, got mode=%v", m.UI.Mode)
+	}
+	var names []string
+	for _, f := range m.FilterPicker.Fields {
+		names = append(names, f.Name+"="+f.Value)
+	}
+	if got := strings.Join(names, ","); got != "http.response.status_code=500,k8s.pod.name=api-1,tags=a,tags=b" {
+		t.Fatalf("unexpected picker fields: %s", got)
+	}
+	if cmd := pressIn(m.handleFieldFilterKey, "enter"); cmd == nil {
+		t.Fatal("expected the list to be refetched")
+	}
+	if m.UI.Mode != viewLogs || len(m.UI.ViewStack) != 0 {
+		t.Fatalf("expected return to the list, got mode=%v stack=%d", m.UI.Mode, len(m.UI.ViewStack))
+	}
+
+	// Exclude the pod name
+	m.pushView(viewDetail)
+	pressIn(m.handleDetailKey, "F")
+	pressIn(m.handleFieldFilterKey, "down")
+	cmd := pressIn(m.handleFieldFilterKey, "-")
+	cmd()
+	if len(src.tailOpts.FieldFilters) != 2 || !src.tailOpts.FieldFilters[1].Negate {
+		t.Fatalf("expected include and exclude filters in the query, got %+v", src.tailOpts.FieldFilters)
+	}
+	chips := m.renderFilterChips()
+	if !strings.Contains(chips, "http.response.status_code: 500") || !strings.Contains(chips, "NOT k8s.pod.name: api-1") {
+		t.Fatalf("unexpected chips: %s", chips)
+	}
+
+	// Chips: the last one is selected first; space flips it, enter removes it
+	pressIn(m.handleLogsKey, "F")
+	if !m.Chips.Focused || m.Chips.Cursor != 1 {
+		t.Fatalf("expected focus on the last chip, got %+v", m.Chips)
+	}
+	pressIn(m.handleLogsKey, "space")
+	if m.Filters.FieldFilters[1].Negate {
+		t.Fatal("expected space to turn the exclude filter into an include filter")
+	}
+	pressIn(m.handleLogsKey, "enter")
+	if len(m.Filters.FieldFilters) != 1 || m.Filters.FieldFilters[0].Field != "http.response.status_code" || m.Chips.Cursor != 0 {
+		t.Fatalf("expected the pod filter removed, got %+v (cursor %d)", m.Filters.FieldFilters, m.Chips.Cursor)
+	}
+}
@@ -21,6 +21,10 @@ func (m Model) handleQueryKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.Query.Format = formatCurl
 	case "k":
 		m.Query.Format = formatKibana
+	case "e":
+		// Replace the read-only query with the editor
+		m.popView()
+		return m, m.openESQLEditor()
 	case "y":
 		// Copy query to clipboard
 		m.copyToClipboard(m.getQueryText(), "Copied to clipboard!")
@@ -56,10 +60,8 @@ func (m Model) handleSearchKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Dispatch based on underlying view after popView
 		switch m.UI.Mode {
 		case viewMetricsDashboard:
-			// Local filter on metrics names
-			m.Metrics.NameFilter = query
-			m.Metrics.Cursor = 0 // Reset cursor when filter changes
-			return m, nil
+			// Metric names are filtered server-side
+			return m, m.setMetricsNameFilter(query)
 		case viewTraceNames:
 			// Local filter on transaction names
 			m.Traces.NameFilter = query
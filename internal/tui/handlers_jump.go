@@ -0,0 +1,116 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+// selectedEntry returns the entry under the cursor in the log list or detail view.
+func (m Model) selectedEntry() (es.LogEntry, bool) {
+	if m.Logs.SelectedIndex < 0 || m.Logs.SelectedIndex >= len(m.Logs.Entries) {
+		return es.LogEntry{}, false
+	}
+	return m.Logs.Entries[m.Logs.SelectedIndex], true
+}
+
+// jumpToTrace opens the trace waterfall for the selected entry's trace ID.
+// The current signal state is saved on the view stack so Esc returns here.
+func (m *Model) jumpToTrace() tea.Cmd {
+	entry, ok := m.selectedEntry()
+	if !ok || entry.TraceID == "" {
+		m.UI.StatusMessage = "No trace ID on this entry"
+		m.UI.StatusTime = time.Now()
+		return nil
+	}
+	return m.openTrace(entry.TraceID)
+}
+
+// openTrace shows the waterfall of a trace on top of the current view.
+// The current signal state is saved on the view stack so Esc returns here.
+func (m *Model) openTrace(traceID string) tea.Cmd {
+	m.pushSignalJump(signalTraces)
+	m.Traces = TracesState{
+		ViewLevel:       traceViewSpans,
+		SelectedTraceID: traceID,
+	}
+	m.UI.Loading = true
+	return m.fetchLogs()
+}
+
+// jumpToSpanLogs shows the logs that share the selected span's trace and span ID.
+// The current signal state is saved on the view stack so Esc returns here.
+func (m *Model) jumpToSpanLogs() tea.Cmd {
+	entry, ok := m.selectedEntry()
+	if !ok || entry.TraceID == "" {
+		m.UI.StatusMessage = "No trace ID on this span"
+		m.UI.StatusTime = time.Now()
+		return nil
+	}
+
+	m.pushSignalJump(signalLogs)
+	m.Filters.TraceID = entry.TraceID
+	m.Filters.SpanID = entry.SpanID
+	m.UI.StatusMessage = fmt.Sprintf("Logs for span %s", entry.SpanID)
+	if entry.SpanID == "" {
+		m.UI.StatusMessage = fmt.Sprintf("Logs for trace %s", entry.TraceID)
+	}
+	m.UI.StatusTime = time.Now()
+	m.UI.Loading = true
+	return m.fetchLogs()
+}
+
+// pushSignalJump saves the current signal state on the view stack and switches
+// to the log list of another signal. Filters other than the lookback are reset
+// so the target documents are not hidden by filters of the originating view.
+func (m *Model) pushSignalJump(signal SignalType) {
+	m.UI.ViewStack = append(m.UI.ViewStack, ViewContext{
+		Mode: m.UI.Mode,
+		Signal: &signalContext{
+			Filters: m.Filters,
+			Index:   m.client.GetIndex(),
+			Display: m.Fields.Display,
+			Logs:    m.Logs,
+			Traces:  m.Traces,
+			Query:   m.Query,
+		},
+	})
+
+	m.Filters = FilterState{Signal: signal, Lookback: m.Filters.Lookback}
+	m.client.SetIndex(signal.IndexPattern())
+	m.Fields.Display = DefaultFields(signal)
+	m.Logs = LogsState{}
+	m.UI.Mode = viewLogs
+	m.UI.Err = nil
+}
+
+// restoreSignalContext puts back the signal state saved by pushSignalJump.
+func (m *Model) restoreSignalContext(ctx *signalContext) {
+	// Drop responses still in flight for the view we are leaving
+	m.requests.cancel(requestLogs)
+	m.requests.cancel(requestSpans)
+
+	m.Filters = ctx.Filters
+	m.client.SetIndex(ctx.Index)
+	m.Fields.Display = ctx.Display
+	m.Logs = ctx.Logs
+	m.Traces = ctx.Traces
+	m.Query = ctx.Query
+	m.UI.Loading = false
+	m.UI.Err = nil
+
+	if m.UI.Mode == viewDetail || m.UI.Mode == viewDetailJSON {
+		m.updateDetailContent()
+	}
+}
+
+// inSignalJump reports whether the top of the view stack is a jump from another signal.
+func (m Model) inSignalJump() bool {
+	n := len(m.UI.ViewStack)
+	return n > 0 && m.UI.ViewStack[n-1].Signal != nil
+}
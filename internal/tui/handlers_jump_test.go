@@ -0,0 +1,52 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestSignalJumpRestoresOrigin(t *testing.T) {
+	m, src := newTestModel(signalLogs, viewLogs)
+	m.Filters.Service, m.Filters.Level = "api", "ERROR"
+	m.Logs = LogsState{
+		Entries:       []es.LogEntry{{Body: "first"}, {Body: "boom", TraceID: "t1", SpanID: "s1"}},
+		SelectedIndex: 1,
+	}
+	m.pushView(viewDetail)
+
+	// Log detail -> trace waterfall
+	m.jumpToTrace()
+	if m.Filters.Signal != signalTraces || !m.inTraceWaterfall() || m.Traces.SelectedTraceID != "t1" {
+		t.Fatalf("expected trace waterfall for t1, got signal=%v level=%v trace=%q", m.Filters.Signal, m.Traces.ViewLevel, m.Traces.SelectedTraceID)
+	}
+	if m.Filters.Service != "" || m.Filters.Level != "" {
+		t.Fatalf("expected filters reset for the jump, got %+v", m.Filters)
+	}
+	if src.index != signalTraces.IndexPattern() {
+		t.Fatalf("expected traces index, got %q", src.index)
+	}
+
+	// Span -> logs with the same trace and span ID
+	m.setTraceWaterfall([]es.LogEntry{{TraceID: "t1", SpanID: "s1"}})
+	m.jumpToSpanLogs()
+	if m.Filters.Signal != signalLogs || m.Filters.TraceID != "t1" || m.Filters.SpanID != "s1" {
+		t.Fatalf("expected logs filtered by t1/s1, got %+v", m.Filters)
+	}
+
+	// Esc returns to the waterfall, then to the original log detail
+	m.popView()
+	if !m.inTraceWaterfall() || m.Filters.TraceID != "" {
+		t.Fatalf("expected return to the waterfall, got signal=%v filters=%+v", m.Filters.Signal, m.Filters)
+	}
+	m.popView()
+	if m.UI.Mode != viewDetail || m.Filters.Signal != signalLogs || m.Filters.Service != "api" {
+		t.Fatalf("expected original log detail, got mode=%v filters=%+v", m.UI.Mode, m.Filters)
+	}
+	if m.Logs.SelectedIndex != 1 || src.index != signalLogs.IndexPattern() {
+		t.Fatalf("expected selection and index restored, got %d %q", m.Logs.SelectedIndex, src.index)
+	}
+}
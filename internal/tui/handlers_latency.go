@@ -0,0 +1,65 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es/traces"
+)
+
+func (m Model) handleLatencyKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	action := GetAction(key)
+
+	if isNavKey(key) {
+		m.Latency.Cursor = listNav(m.Latency.Cursor, m.latencyBucketCount(), key)
+		return m, nil
+	}
+
+	switch action {
+	case ActionSelect:
+		// Open the slowest trace of the selected bucket
+		if m.Latency.Loading || m.latencyBucketCount() == 0 {
+			return m, nil
+		}
+		bucket := m.Latency.Histogram.Buckets[m.Latency.Cursor]
+		if bucket.Count == 0 {
+			return m, nil
+		}
+		m.Latency.Loading = true
+		return m, m.fetchExampleTrace(bucket)
+	case ActionCycleLookback:
+		m.cycleLookback()
+		m.Latency.Loading = true
+		return m, m.fetchLatencyHistogram()
+	case ActionRefresh:
+		m.Latency.Loading = true
+		return m, m.fetchLatencyHistogram()
+	case ActionQuery:
+		m.pushView(viewQuery)
+		m.Query.Format = formatKibana
+		return m, nil
+	case ActionBack:
+		m.popView()
+		return m, nil
+	case ActionQuit:
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// enterLatencyView opens the latency distribution of a transaction name.
+func (m *Model) enterLatencyView(tx traces.TransactionNameAgg) tea.Cmd {
+	m.pushView(viewLatency)
+	m.Latency = LatencyState{Tx: tx, Loading: true}
+	return m.fetchLatencyHistogram()
+}
+
+func (m Model) latencyBucketCount() int {
+	if m.Latency.Histogram == nil {
+		return 0
+	}
+	return len(m.Latency.Histogram.Buckets)
+}
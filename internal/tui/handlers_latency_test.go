@@ -0,0 +1,41 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es/traces"
+)
+
+func TestLatencyExampleTraceReturnsToHistogram(t *testing.T) {
+	m, _ := newTestModel(signalTraces, viewTraceNames)
+	m.Filters.Service = "api"
+
+	m.enterLatencyView(traces.TransactionNameAgg{Name: "GET /", MinDuration: 0, MaxDuration: 100})
+	m, _ = m.handleLatencyHistogramMsg(latencyHistogramMsg{result: &traces.LatencyHistogram{Buckets: []traces.LatencyBucket{
+		{Min: 0, Max: 50, Count: 90},
+		{Min: 50, Max: 100, Count: 10},
+	}}})
+	if m.UI.Mode != viewLatency || m.Latency.Loading || m.latencyBucketCount() != 2 {
+		t.Fatalf("expected loaded histogram, got mode=%v loading=%v", m.UI.Mode, m.Latency.Loading)
+	}
+
+	m.Latency.Cursor = 1
+	next, cmd := m.handleLatencyKey(keyMsg("enter"))
+	m = next.(Model)
+	if cmd == nil || !m.Latency.Loading {
+		t.Fatal("expected example trace fetch for the slow bucket")
+	}
+
+	m, _ = m.handleExampleTraceMsg(exampleTraceMsg{traceID: "t1"})
+	if !m.inSignalJump() || m.Traces.ViewLevel != traceViewSpans || m.Traces.SelectedTraceID != "t1" {
+		t.Fatalf("expected waterfall of t1, got level=%v trace=%q", m.Traces.ViewLevel, m.Traces.SelectedTraceID)
+	}
+
+	m.popView()
+	if m.UI.Mode != viewLatency || m.Filters.Service != "api" || m.Latency.Cursor != 1 {
+		t.Fatalf("expected return to histogram, got mode=%v filters=%+v cursor=%d", m.UI.Mode, m.Filters, m.Latency.Cursor)
+	}
+}
@@ -4,6 +4,8 @@
 package tui
 
 import (
+	"time"
+
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -12,6 +14,20 @@ func (m Model) handleLogsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	key := msg.String()
 	action := GetAction(key)
 
+	// The focused volume histogram takes the cursor keys
+	if m.Volume.Focused && m.showLogVolume() {
+		if newM, cmd, handled := m.handleLogVolumeKey(msg); handled {
+			return newM, cmd
+		}
+	}
+
+	// Focused filter chips take the cursor keys
+	if m.Chips.Focused && len(m.Filters.FieldFilters) > 0 {
+		if newM, cmd, handled := m.handleFilterChipsKey(msg); handled {
+			return newM, cmd
+		}
+	}
+
 	// Handle common actions first (signal cycle, lookback, perspective, kibana)
 	if newM, cmd, handled := m.handleCommonAction(action); handled {
 		return newM, cmd
@@ -20,6 +36,11 @@ func (m Model) handleLogsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Handle navigation actions
 	switch action {
 	case ActionBack:
+		// Return to the view we jumped from (log <-> trace)
+		if m.inSignalJump() {
+			m.popView()
+			return m, nil
+		}
 		// For traces, go back up the hierarchy
 		if m.Filters.Signal == signalTraces {
 			switch m.Traces.ViewLevel {
@@ -27,6 +48,7 @@ func (m Model) handleLogsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				// Go back to transactions list
 				m.Traces.ViewLevel = traceViewTransactions
 				m.Traces.SelectedTraceID = ""
+				m.clearTraceWaterfall()
 				m.Logs.SelectedIndex = 0
 				m.UI.Loading = true
 				return m, m.fetchLogs()
@@ -46,6 +68,18 @@ func (m Model) handleLogsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.Metrics.Loading = true
 			return m, m.fetchAggregatedMetrics()
 		}
+		// Clear a time window narrowed from the volume histogram
+		if m.Filters.Signal == signalLogs && m.isTimeNarrowed() {
+			return m, m.clearTimeNarrowing()
+		}
+		// Clear a pattern drill-down (returns to the pattern list when opened from there)
+		if m.Filters.Pattern != "" {
+			return m, m.clearPatternFilter()
+		}
+		// Clear an error group drill-down (returns to the errors view if opened from there)
+		if !m.Filters.Exception.IsZero() {
+			return m, m.clearExceptionFilter()
+		}
 		// Logs is a base view - esc does nothing (user can press 'q' to quit)
 		return m, nil
 	case ActionScrollUp:
@@ -84,9 +118,18 @@ func (m Model) handleLogsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	case ActionRefresh:
 		m.UI.Loading = true
+		m.Volume.Key = "" // A manual refresh also refetches the histogram
 		return m, m.fetchLogs()
 	case ActionAutoRefresh:
 		m.UI.AutoRefresh = !m.UI.AutoRefresh
+	case ActionLiveTail:
+		return m, m.toggleLiveTail()
+	case ActionBookmark:
+		return m, m.bookmarkSelected()
+	case ActionContext:
+		if m.Logs.Context != nil {
+			return m, m.toggleContextScope()
+		}
 	case ActionQuery:
 		m.pushView(viewQuery)
 		m.Query.Format = formatKibana
@@ -95,12 +138,63 @@ func (m Model) handleLogsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.Fields.Cursor = 0
 		m.Fields.Search = ""
 		m.Fields.SearchMode = false
+		m.Fields.Facets = FacetState{}
 		m.Fields.Loading = true
 		return m, m.fetchFieldCaps()
 	case ActionSort:
+		// Live tail appends at the bottom, so it ends with newest-first
+		m.stopLiveTail()
 		m.UI.SortAscending = !m.UI.SortAscending
 		m.UI.Loading = true
 		return m, m.fetchLogs()
+	case ActionSplit:
+		m.toggleSplit()
+	case ActionSplitShrink:
+		m.resizeSplit(-splitRatioStep)
+	case ActionSplitGrow:
+		m.resizeSplit(splitRatioStep)
+	case ActionPatterns:
+		if m.Filters.Signal == signalLogs {
+			return m, m.enterLogPatternsView()
+		}
+	case ActionExceptions:
+		if m.Filters.Signal == signalLogs {
+			return m, m.enterExceptionsView()
+		}
+	case ActionVolume:
+		if m.showLogVolume() && m.logVolumeBucketCount() > 0 {
+			m.Volume.Focused = true
+			m.Chips.Focused = false
+		}
+	case ActionFilter:
+		if len(m.Filters.FieldFilters) > 0 {
+			m.Chips.Focused = true
+			m.Chips.Cursor = len(m.Filters.FieldFilters) - 1
+			m.Volume.Focused = false
+		} else {
+			m.UI.StatusMessage = "No field filters yet (add one from the detail view of a log)"
+			m.UI.StatusTime = time.Now()
+		}
+	case ActionJumpTrace:
+		if m.Filters.Signal == signalLogs {
+			return m, m.jumpToTrace()
+		}
+	case ActionJumpLogs:
+		if m.Filters.Signal == signalTraces {
+			return m, m.jumpToSpanLogs()
+		}
+	case ActionToggle:
+		if m.inTraceWaterfall() {
+			m.toggleSpanCollapsed()
+		}
+	case ActionPrevItem:
+		if m.inTraceWaterfall() {
+			m.setSpanCollapsed(true)
+		}
+	case ActionNextItem:
+		if m.inTraceWaterfall() {
+			m.setSpanCollapsed(false)
+		}
 		// NOTE: ActionCycleLookback, ActionCycleSignal, ActionPerspective, ActionKibana
 		// are now handled by handleCommonAction() above
 	}
@@ -184,6 +278,11 @@ func (m *Model) setSelectedIndex(newIdx int) bool {
 
 	m.Logs.SelectedIndex = newIdx
 	m.Logs.UserHasScrolled = true
+	if m.Logs.Live != nil && newIdx == len(m.Logs.Entries)-1 {
+		// Back at the bottom: follow new entries again
+		m.Logs.UserHasScrolled = false
+		m.Logs.Unseen = 0
+	}
 	return true
 }
 
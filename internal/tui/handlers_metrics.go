@@ -4,6 +4,8 @@
 package tui
 
 import (
+	"time"
+
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/elastic/elasticat/internal/es"
@@ -22,37 +24,43 @@ func (m Model) handleMetricsDashboardKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	filteredMetrics := m.getFilteredMetrics()
 	listLen := len(filteredMetrics)
 
-	// Handle list navigation
+	// Handle list navigation, loading more metrics as the cursor nears the end
 	if isNavKey(key) {
 		m.Metrics.Cursor = listNav(m.Metrics.Cursor, listLen, key)
-		return m, nil
+		return m, m.fetchMoreMetrics()
 	}
 
 	switch action {
 	case ActionBack:
-		// If filter is active, clear it; otherwise do nothing (base view)
+		// If filter is active, clear it; then clear the marks (base view)
 		if m.Metrics.NameFilter != "" {
-			m.Metrics.NameFilter = ""
-			m.Metrics.Cursor = 0
-			return m, nil
+			return m, m.setMetricsNameFilter("")
+		}
+		m.Metrics.Marked = nil
+		return m, nil
+	case ActionToggle:
+		// Mark the metric for comparison
+		if m.Metrics.Cursor < len(filteredMetrics) {
+			m.toggleMetricMark(filteredMetrics[m.Metrics.Cursor].Name)
 		}
 		return m, nil
+	case ActionCompare:
+		return m, m.enterMetricCompare()
 	case ActionSelect:
 		// Enter detail view for the selected metric (from filtered list)
 		if len(filteredMetrics) > 0 && m.Metrics.Cursor < len(filteredMetrics) {
 			// Store the actual metric for detail view
 			m.Metrics.SelectedMetricName = filteredMetrics[m.Metrics.Cursor].Name
+			m.resetMetricBreakdown()
 			m.pushView(viewMetricDetail)
 			m.Metrics.DetailDocCursor = 0
 			m.Metrics.DetailDocsLoading = true
+			distCmd := m.fetchMetricDistribution()
 			m.updateMetricDetailViewport() // Initialize viewport with current content
-			return m, m.fetchMetricDetailDocs()
+			return m, tea.Batch(m.fetchMetricDetailDocs(), distCmd)
 		}
 	case ActionRefresh:
-		m.Metrics.Loading = true
-		m.Metrics.NameFilter = "" // Clear filter on refresh
-		m.Metrics.Cursor = 0
-		return m, m.fetchAggregatedMetrics()
+		return m, m.setMetricsNameFilter("") // Clear filter on refresh
 	case ActionSearch:
 		m.pushView(viewSearch)
 		m.Components.SearchInput.Focus()
@@ -93,19 +101,23 @@ func (m Model) handleMetricDetailKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Previous metric (and re-fetch docs)
 		if m.Metrics.Cursor > 0 {
 			m.Metrics.Cursor--
+			m.resetMetricBreakdown()
 			m.Metrics.DetailDocCursor = 0
 			m.Metrics.DetailDocsLoading = true
+			distCmd := m.fetchMetricDistribution()
 			m.updateMetricDetailViewport()
-			return m, m.fetchMetricDetailDocs()
+			return m, tea.Batch(m.fetchMetricDetailDocs(), distCmd)
 		}
 	case ActionNextItem:
 		// Next metric (and re-fetch docs)
 		if m.Metrics.Aggregated != nil && m.Metrics.Cursor < len(m.Metrics.Aggregated.Metrics)-1 {
 			m.Metrics.Cursor++
+			m.resetMetricBreakdown()
 			m.Metrics.DetailDocCursor = 0
 			m.Metrics.DetailDocsLoading = true
+			distCmd := m.fetchMetricDistribution()
 			m.updateMetricDetailViewport()
-			return m, m.fetchMetricDetailDocs()
+			return m, tea.Batch(m.fetchMetricDetailDocs(), distCmd)
 		}
 	case ActionPrevDoc:
 		// Previous doc (N)
@@ -131,13 +143,15 @@ func (m Model) handleMetricDetailKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Refresh
 		m.Metrics.Loading = true
 		m.Metrics.DetailDocsLoading = true
-		return m, tea.Batch(m.fetchAggregatedMetrics(), m.fetchMetricDetailDocs())
+		return m, tea.Batch(m.fetchAggregatedMetrics(), m.fetchMetricDetailDocs(), m.refreshMetricBreakdown(), m.fetchMetricDistribution())
 	case ActionCycleLookback:
 		// Change lookback - re-fetch metrics with new time range
 		m.cycleLookback()
 		m.Metrics.Loading = true
 		m.Metrics.DetailDocsLoading = true
-		return m, tea.Batch(m.fetchAggregatedMetrics(), m.fetchMetricDetailDocs())
+		return m, tea.Batch(m.fetchAggregatedMetrics(), m.fetchMetricDetailDocs(), m.refreshMetricBreakdown(), m.fetchMetricDistribution())
+	case ActionGroupBy:
+		return m, m.cycleMetricGroupBy()
 	case ActionKibana:
 		// Prepare Kibana URL for this specific metric and show creds modal
 		if m.Metrics.Aggregated != nil && m.Metrics.Cursor < len(m.Metrics.Aggregated.Metrics) {
@@ -174,3 +188,83 @@ func (m *Model) updateMetricDetailViewport() {
 	m.setViewportContent(content)
 	m.Components.Viewport.GotoTop()
 }
+
+// cycleMetricGroupBy steps the detail metric through its dimensions, returning
+// to a single series after the last one. Dimensions are discovered on first use.
+func (m *Model) cycleMetricGroupBy() tea.Cmd {
+	if m.Metrics.Aggregated == nil || m.Metrics.Cursor >= len(m.Metrics.Aggregated.Metrics) {
+		return nil
+	}
+	metric := m.Metrics.Aggregated.Metrics[m.Metrics.Cursor]
+	if m.Metrics.BreakdownMetric != metric.Name {
+		m.resetMetricBreakdown()
+		m.Metrics.BreakdownMetric = metric.Name
+	}
+
+	if m.Metrics.Dimensions == nil {
+		m.Metrics.BreakdownLoading = true
+		return m.fetchMetricDimensions(metric.Name)
+	}
+	if len(m.Metrics.Dimensions) == 0 {
+		m.UI.StatusMessage = "No dimensions found for this metric"
+		m.UI.StatusTime = time.Now()
+		return nil
+	}
+
+	next := ""
+	if i := m.metricGroupByIndex(); i+1 < len(m.Metrics.Dimensions) {
+		next = m.Metrics.Dimensions[i+1].Field
+	}
+	return m.setMetricGroupBy(next)
+}
+
+// setMetricGroupBy switches the detail chart to one series per value of field
+// ("" shows the single aggregated series) and fetches the breakdown.
+func (m *Model) setMetricGroupBy(field string) tea.Cmd {
+	m.Metrics.GroupBy = field
+	m.Metrics.Breakdown = nil
+	if field == "" || m.Metrics.BreakdownMetric == "" {
+		m.updateMetricDetailViewport()
+		return nil
+	}
+	m.Metrics.BreakdownLoading = true
+	m.updateMetricDetailViewport()
+	return m.fetchMetricBreakdown(m.Metrics.BreakdownMetric, field)
+}
+
+// refreshMetricBreakdown re-fetches the active breakdown, if any
+func (m *Model) refreshMetricBreakdown() tea.Cmd {
+	if m.Metrics.GroupBy == "" {
+		return nil
+	}
+	return m.setMetricGroupBy(m.Metrics.GroupBy)
+}
+
+// metricGroupByIndex returns the position of the active group-by in Dimensions, or -1
+func (m Model) metricGroupByIndex() int {
+	for i, d := range m.Metrics.Dimensions {
+		if d.Field == m.Metrics.GroupBy {
+			return i
+		}
+	}
+	return -1
+}
+
+// resetMetricBreakdown drops the dimensions and breakdown of the previous detail metric
+func (m *Model) resetMetricBreakdown() {
+	m.Metrics.BreakdownMetric = ""
+	m.Metrics.Dimensions = nil
+	m.Metrics.GroupBy = ""
+	m.Metrics.Breakdown = nil
+	m.Metrics.BreakdownLoading = false
+}
+
+// setMetricsNameFilter replaces the metric name filter and reloads the dashboard
+// from the first page, since the filter is applied server-side.
+func (m *Model) setMetricsNameFilter(filter string) tea.Cmd {
+	m.Metrics.NameFilter = filter
+	m.Metrics.Cursor = 0
+	m.Metrics.Aggregated = nil
+	m.Metrics.Loading = true
+	return m.fetchAggregatedMetrics()
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es/metrics"
+)
+
+func TestMetricsDashboardLoadsPagesOnScroll(t *testing.T) {
+	page := func(from, n, total int) *metrics.MetricsAggResult {
+		r := &metrics.MetricsAggResult{Total: total, NextOffset: from + n}
+		for i := 0; i < n; i++ {
+			r.Metrics = append(r.Metrics, metrics.AggregatedMetric{Name: fmt.Sprintf("metrics.m%03d", from+i)})
+		}
+		return r
+	}
+	m, _ := newTestModel(signalMetrics, viewMetricsDashboard)
+	m.Metrics.Aggregated = page(0, 50, 120)
+
+	// Far from the end of the loaded list nothing is fetched
+	if cmd := m.fetchMoreMetrics(); cmd != nil || m.Metrics.LoadingMore {
+		t.Fatal("expected no page fetch at the top of the list")
+	}
+
+	m.Metrics.Cursor = 45
+	if cmd := m.fetchMoreMetrics(); cmd == nil || !m.Metrics.LoadingMore {
+		t.Fatal("expected next page fetch near the end of the list")
+	}
+
+	// A page that does not continue the loaded list is dropped
+	m, _ = m.handleMetricsAggMsg(metricsAggMsg{more: true, offset: 10, result: page(10, 50, 120)})
+	if len(m.Metrics.Aggregated.Metrics) != 50 || m.Metrics.LoadingMore {
+		t.Fatalf("expected stale page dropped, got %d metrics", len(m.Metrics.Aggregated.Metrics))
+	}
+
+	m, _ = m.handleMetricsAggMsg(metricsAggMsg{more: true, offset: 50, result: page(50, 50, 120)})
+	if len(m.Metrics.Aggregated.Metrics) != 100 || m.Metrics.Aggregated.Metrics[50].Name != "metrics.m050" {
+		t.Fatalf("expected second page appended, got %d metrics", len(m.Metrics.Aggregated.Metrics))
+	}
+	if !m.Metrics.Aggregated.HasMore() {
+		t.Fatal("expected more metrics to load")
+	}
+}
@@ -0,0 +1,418 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// paletteCommand is a palette command taking an argument, such as
+// "service gateway".
+type paletteCommand struct {
+	Name     string
+	Usage    string
+	Label    string
+	complete func(m Model) []string                      // Arguments offered on tab
+	run      func(m *Model, arg string) (tea.Cmd, error) // Applies the command
+}
+
+var paletteCommands = []paletteCommand{
+	{Name: "service", Usage: "service <name>", Label: "filter by service; empty clears", complete: Model.paletteServices, run: (*Model).paletteService},
+	{Name: "lookback", Usage: "lookback <duration>", Label: "time range, e.g. 6h or 2d", complete: Model.paletteLookbacks, run: (*Model).paletteLookback},
+	{Name: "index", Usage: "index <pattern>", Label: "query another index pattern", complete: Model.paletteIndices, run: (*Model).paletteIndex},
+	{Name: "fields", Usage: "fields add|remove <field>", Label: "show or hide a column", complete: Model.paletteFields, run: (*Model).paletteField},
+}
+
+// paletteItem is an entry of the palette: an action of the view below it, a
+// command, or an argument completing the command being typed.
+type paletteItem struct {
+	Label  string
+	Detail string   // Keymap group, command description or command name
+	Keys   []string // Keys that run the action outside the palette
+	key    string   // Key press the action is run as; empty for the rest
+	fill   string   // Input the entry completes to; empty for actions
+}
+
+func (m *Model) openPalette() tea.Cmd {
+	input := textinput.New()
+	input.Prompt = ":"
+	input.Placeholder = "action, or service/lookback/index/fields <arg>"
+	input.CharLimit = 256
+	input.Width = 50
+	input.Focus()
+
+	m.Palette = PaletteState{Input: input}
+	m.pushView(viewPalette)
+	return textinput.Blink
+}
+
+func (m Model) handlePaletteKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	items := m.paletteItems()
+
+	switch msg.String() {
+	case "esc":
+		m.popView()
+		return m, nil
+	case "backspace":
+		if m.Palette.Input.Value() == "" {
+			m.popView()
+			return m, nil
+		}
+	case "up", "ctrl+p":
+		m.Palette.Cursor = max(m.Palette.Cursor-1, 0)
+		return m, nil
+	case "down", "ctrl+n":
+		m.Palette.Cursor = max(min(m.Palette.Cursor+1, len(items)-1), 0)
+		return m, nil
+	case "tab":
+		if m.Palette.Cursor < len(items) && items[m.Palette.Cursor].fill != "" {
+			return m, m.setPaletteInput(items[m.Palette.Cursor].fill)
+		}
+		return m, nil
+	case "enter":
+		return m.runPalette(items)
+	}
+
+	before := m.Palette.Input.Value()
+	var cmd tea.Cmd
+	m.Palette.Input, cmd = m.Palette.Input.Update(msg)
+	if m.Palette.Input.Value() != before {
+		m.Palette.Cursor = 0
+		m.Palette.Err = nil
+		return m, tea.Batch(cmd, m.maybeFetchPaletteFields())
+	}
+	return m, cmd
+}
+
+// setPaletteInput replaces what was typed, as completing an entry does.
+func (m *Model) setPaletteInput(value string) tea.Cmd {
+	m.Palette.Input.SetValue(value)
+	m.Palette.Input.CursorEnd()
+	m.Palette.Cursor = 0
+	m.Palette.Err = nil
+	return m.maybeFetchPaletteFields()
+}
+
+// maybeFetchPaletteFields loads the field caps the fields command completes
+// from, the first time it is typed.
+func (m *Model) maybeFetchPaletteFields() tea.Cmd {
+	cmd, _, ok := paletteCommandFor(m.Palette.Input.Value())
+	if !ok || cmd.Name != "fields" || len(m.Fields.Available) > 0 || m.Fields.Loading {
+		return nil
+	}
+	m.Fields.Loading = true
+	return m.fetchFieldCaps()
+}
+
+// runPalette runs the command typed, or else the selected entry: actions run
+// as their key pressed in the view below, commands complete to their name.
+func (m Model) runPalette(items []paletteItem) (tea.Model, tea.Cmd) {
+	if cmd, arg, ok := paletteCommandFor(m.Palette.Input.Value()); ok {
+		fetch, err := cmd.run(&m, arg)
+		if err != nil {
+			m.Palette.Err = err
+			return m, nil
+		}
+		m.popView()
+		return m, fetch
+	}
+
+	if m.Palette.Cursor >= len(items) {
+		return m, nil
+	}
+	item := items[m.Palette.Cursor]
+	if item.fill != "" {
+		return m, m.setPaletteInput(item.fill)
+	}
+	m.popView()
+	return m.handleKey(keyMsgFor(item.key))
+}
+
+// paletteCommandFor splits input into a command and its argument once the
+// command's name is followed by a space.
+func paletteCommandFor(input string) (paletteCommand, string, bool) {
+	name, arg, found := strings.Cut(strings.TrimPrefix(strings.TrimLeft(input, " "), ":"), " ")
+	if !found {
+		return paletteCommand{}, "", false
+	}
+	for _, cmd := range paletteCommands {
+		if cmd.Name == name {
+			return cmd, strings.TrimSpace(arg), true
+		}
+	}
+	return paletteCommand{}, "", false
+}
+
+// paletteItems lists the entries matching the input, best match first: the
+// arguments of the command being typed, or else the commands and the actions
+// of the view below the palette.
+func (m Model) paletteItems() []paletteItem {
+	input := m.Palette.Input.Value()
+	if cmd, arg, ok := paletteCommandFor(input); ok {
+		var items []paletteItem
+		for _, candidate := range cmd.complete(m) {
+			items = append(items, paletteItem{Label: candidate, Detail: cmd.Name, fill: cmd.Name + " " + candidate})
+		}
+		return fuzzyFilter(items, arg)
+	}
+
+	var items []paletteItem
+	for _, cmd := range paletteCommands {
+		items = append(items, paletteItem{Label: cmd.Usage, Detail: cmd.Label, fill: cmd.Name + " "})
+	}
+	items = append(items, m.paletteActions()...)
+	return fuzzyFilter(items, strings.TrimPrefix(strings.TrimSpace(input), ":"))
+}
+
+// paletteActions turns the keymap of the view below the palette into
+// entries, skipping keys that only make sense held or paired, like ↑/↓.
+func (m Model) paletteActions() []paletteItem {
+	bindings := append(m.keymap.remapBindings(m.keymapMode(), []KeyBinding{
+		ActionBinding(ActionHelp, KeyKindFull, "Help"),
+		ActionBinding(ActionCycleSignal, KeyKindFull, "View"),
+	}), m.ViewKeymap()...)
+
+	var items []paletteItem
+	seen := make(map[string]bool)
+	for _, b := range bindings {
+		if len(b.Keys) == 0 || slices.Equal(b.Keys, ScrollDisplayKeys) || slices.Equal(b.Keys, PrevNextDisplayKeys) {
+			continue
+		}
+		key := b.Keys[0]
+		if name, ok := displayKeyNames[key]; ok {
+			key = name
+		}
+		if validateKeyName(key) != nil || seen[b.Label+"\x00"+key] {
+			continue
+		}
+		seen[b.Label+"\x00"+key] = true
+		items = append(items, paletteItem{Label: b.Label, Detail: b.Group, Keys: b.Keys, key: key})
+	}
+	return items
+}
+
+// fuzzyFilter keeps the items whose label or detail fuzzily matches query,
+// best match first.
+func fuzzyFilter(items []paletteItem, query string) []paletteItem {
+	type scored struct {
+		item  paletteItem
+		score int
+	}
+	var matches []scored
+	for _, item := range items {
+		score, ok := fuzzyScore(query, item.Label)
+		if !ok {
+			// Matching the group or description ranks below matching the label
+			score, ok = fuzzyScore(query, item.Label+" "+item.Detail)
+			score /= 2
+		}
+		if ok {
+			matches = append(matches, scored{item, score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+
+	out := make([]paletteItem, len(matches))
+	for i, match := range matches {
+		out[i] = match.item
+	}
+	return out
+}
+
+// fuzzyScore matches the characters of query in order anywhere in text,
+// ignoring case. Runs of characters and characters starting a word score
+// higher.
+func fuzzyScore(query, text string) (int, bool) {
+	q := []rune(strings.ToLower(query))
+	t := []rune(strings.ToLower(text))
+	score, qi, prev := 0, 0, -2
+	for ti := 0; ti < len(t) && qi < len(q); ti++ {
+		if t[ti] != q[qi] {
+			continue
+		}
+		score++
+		if ti == prev+1 {
+			score += 2
+		}
+		if ti == 0 || strings.ContainsRune(" ._-/:<", t[ti-1]) {
+			score += 3
+		}
+		prev = ti
+		qi++
+	}
+	return score, qi == len(q)
+}
+
+// === Commands ===
+
+func (m *Model) paletteService(name string) (tea.Cmd, error) {
+	m.Filters.Service = name
+	m.Filters.NegateService = false
+	m.Logs.UserHasScrolled = false // Reset for tail -f behavior
+	if name == "" {
+		m.UI.StatusMessage = "Cleared service filter"
+	} else {
+		m.UI.StatusMessage = fmt.Sprintf("Filtered to service: %s", name)
+	}
+	m.UI.StatusTime = time.Now()
+	return m.fetchCurrentViewData(), nil
+}
+
+func (m *Model) paletteLookback(arg string) (tea.Cmd, error) {
+	lookback, covered, err := parseLookback(arg)
+	if err != nil {
+		return nil, err
+	}
+	// A new lookback replaces any window narrowed from the volume histogram
+	m.Filters.From, m.Filters.To = time.Time{}, time.Time{}
+	m.Filters.Lookback = lookback
+	m.UI.StatusMessage = "Lookback: " + lookback.String()
+	if covered != lookback.Duration() {
+		m.UI.StatusMessage += fmt.Sprintf(" (the shortest covering %s)", arg)
+	}
+	m.UI.StatusTime = time.Now()
+	return m.fetchCurrentViewData(), nil
+}
+
+// parseLookback picks the shortest lookback covering a duration such as
+// "90m", "6h", "2d" or "now-1w"; "all" drops the time filter. Also returns
+// the duration asked for.
+func parseLookback(arg string) (LookbackDuration, time.Duration, error) {
+	s := strings.TrimPrefix(strings.ToLower(arg), "now-")
+	if s == "all" {
+		return lookbackAll, 0, nil
+	}
+
+	var d time.Duration
+	var err error
+	switch {
+	case strings.HasSuffix(s, "d"), strings.HasSuffix(s, "w"):
+		unit := 24 * time.Hour
+		if strings.HasSuffix(s, "w") {
+			unit *= 7
+		}
+		var n int
+		n, err = strconv.Atoi(s[:len(s)-1])
+		d = time.Duration(n) * unit
+	default:
+		d, err = time.ParseDuration(s)
+	}
+	if err != nil || d <= 0 {
+		return 0, 0, fmt.Errorf("invalid lookback %q (expected e.g. 15m, 6h, 2d, 1w or all)", arg)
+	}
+
+	for _, lb := range lookbackDurations {
+		if lb != lookbackAll && lb.Duration() >= d {
+			return lb, d, nil
+		}
+	}
+	return lookbackAll, d, nil
+}
+
+func (m *Model) paletteIndex(pattern string) (tea.Cmd, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("usage: index <pattern>")
+	}
+	m.client.SetIndex(pattern)
+	m.Components.IndexInput.SetValue(pattern)
+	m.Fields.Available = nil // Field caps of the previous index
+	m.UI.StatusMessage = "Index: " + pattern
+	m.UI.StatusTime = time.Now()
+	return m.fetchCurrentViewData(), nil
+}
+
+func (m *Model) paletteField(arg string) (tea.Cmd, error) {
+	op, field, _ := strings.Cut(arg, " ")
+	field = strings.TrimSpace(field)
+	if (op != "add" && op != "remove") || field == "" {
+		return nil, fmt.Errorf("usage: fields add|remove <field>")
+	}
+
+	shown := slices.ContainsFunc(m.Fields.Display, func(f DisplayField) bool { return f.Name == field })
+	if op == "add" {
+		if !shown {
+			m.toggleField(field)
+		}
+		m.UI.StatusMessage = "Showing field: " + field
+	} else {
+		if !shown {
+			return nil, fmt.Errorf("%s is not shown", field)
+		}
+		m.toggleField(field)
+		m.UI.StatusMessage = "Hid field: " + field
+	}
+	m.UI.StatusTime = time.Now()
+	return nil, nil
+}
+
+// === Completions ===
+
+// paletteServices offers the services of the services perspective, when
+// loaded, and of the entries on screen.
+func (m Model) paletteServices() []string {
+	var names []string
+	if m.Perspective.Current == PerspectiveServices {
+		for _, item := range m.Perspective.Items {
+			names = append(names, item.Name)
+		}
+	}
+	for _, entry := range m.Logs.Entries {
+		names = append(names, entry.ServiceName)
+	}
+	names = append(names, m.Filters.Service)
+	return uniqueNonEmpty(names)
+}
+
+func (m Model) paletteLookbacks() []string {
+	names := make([]string, len(lookbackDurations))
+	for i, lb := range lookbackDurations {
+		names[i] = lb.String()
+	}
+	return names
+}
+
+func (m Model) paletteIndices() []string {
+	return uniqueNonEmpty([]string{
+		m.client.GetIndex(),
+		SignalLogs.IndexPattern(),
+		SignalTraces.IndexPattern(),
+		SignalMetrics.IndexPattern(),
+	})
+}
+
+// paletteFields offers the available fields not shown yet, from the field
+// caps, and the fields shown.
+func (m Model) paletteFields() []string {
+	var args []string
+	for _, f := range m.Fields.Available {
+		if !slices.ContainsFunc(m.Fields.Display, func(d DisplayField) bool { return d.Name == f.Name }) {
+			args = append(args, "add "+f.Name)
+		}
+	}
+	for _, f := range m.Fields.Display {
+		args = append(args, "remove "+f.Name)
+	}
+	return args
+}
+
+// uniqueNonEmpty drops empty and repeated names, keeping the first of each.
+func uniqueNonEmpty(names []string) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			out = append(out, name)
+		}
+	}
+	return out
+}
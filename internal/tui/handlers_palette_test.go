@@ -0,0 +1,78 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestCommandPalette(t *testing.T) {
+	m, src := newTestModel(signalLogs, viewLogs)
+	m.UI.Width = 160
+	m.Filters.Lookback = lookback1h
+	m.Fields.Available = []es.FieldInfo{{Name: "http.route"}}
+	m.Perspective = PerspectiveState{Current: PerspectiveServices, Items: []PerspectiveItem{{Name: "gateway"}, {Name: "checkout"}}}
+	run := func(line string) {
+		t.Helper()
+		typeText(t, &m, ":")
+		if m.UI.Mode != viewPalette {
+			t.Fatalf("expected : to open the palette, got mode %v", m.UI.Mode)
+		}
+		typeText(t, &m, line)
+		press(t, &m, "enter")
+	}
+
+	// Actions of the view below, matched fuzzily and run as their key
+	typeText(t, &m, ":")
+	typeText(t, &m, "srt")
+	if items := m.paletteItems(); len(items) == 0 || items[0].Label != "sort" {
+		t.Fatalf("expected sort to match best, got %+v", items)
+	}
+	if view := m.renderPalette(); !strings.Contains(view, "sort") {
+		t.Errorf("palette lacks the sort action:\n%s", view)
+	}
+	press(t, &m, "enter")
+	if m.UI.Mode != viewLogs || !m.UI.SortAscending {
+		t.Fatalf("expected sort to run in the log list, got mode=%v ascending=%v", m.UI.Mode, m.UI.SortAscending)
+	}
+
+	// Tab completes a service from the perspective
+	press(t, &m, "ctrl+p")
+	typeText(t, &m, "service gat")
+	press(t, &m, "tab")
+	if got := m.Palette.Input.Value(); got != "service gateway" {
+		t.Fatalf("expected tab to complete the service, got %q", got)
+	}
+	press(t, &m, "enter")
+	if m.UI.Mode != viewLogs || m.Filters.Service != "gateway" {
+		t.Errorf("expected the gateway filter, got mode=%v service=%q", m.UI.Mode, m.Filters.Service)
+	}
+
+	run("lookback 6h")
+	if m.Filters.Lookback != lookback24h {
+		t.Errorf("expected 6h to round up to 24h, got %v", m.Filters.Lookback)
+	}
+	run("index traces-*")
+	if src.GetIndex() != "traces-*" {
+		t.Errorf("expected the index to change, got %q", src.GetIndex())
+	}
+	run("fields add http.route")
+	if !slices.ContainsFunc(m.Fields.Display, func(f DisplayField) bool { return f.Name == "http.route" }) {
+		t.Error("expected http.route to be shown")
+	}
+
+	// A bad argument keeps the palette open with the error
+	run("lookback soon")
+	if m.UI.Mode != viewPalette || m.Palette.Err == nil {
+		t.Errorf("expected an error in the palette, got mode=%v err=%v", m.UI.Mode, m.Palette.Err)
+	}
+	press(t, &m, "esc")
+	if m.UI.Mode != viewLogs {
+		t.Errorf("expected esc to close the palette, got mode %v", m.UI.Mode)
+	}
+}
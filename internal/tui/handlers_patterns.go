@@ -0,0 +1,89 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m Model) handleLogPatternsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	action := GetAction(key)
+
+	// Handle list navigation (adds pgup/pgdown, home/end support)
+	if isNavKey(key) {
+		m.Patterns.Cursor = listNav(m.Patterns.Cursor, len(m.Patterns.Items), key)
+		return m, nil
+	}
+
+	switch action {
+	case ActionSelect:
+		// Drill down: show the log list filtered to the selected pattern
+		if len(m.Patterns.Items) > 0 && m.Patterns.Cursor < len(m.Patterns.Items) {
+			m.Filters.Pattern = m.Patterns.Items[m.Patterns.Cursor].Pattern
+			m.pushView(viewLogs)
+			m.Logs.SelectedIndex = 0
+			m.Logs.UserHasScrolled = false // Reset for tail -f behavior
+			m.UI.StatusMessage = "Filtered to pattern: " + TruncateWithEllipsis(m.Patterns.Items[m.Patterns.Cursor].Template(), 40)
+			m.UI.StatusTime = time.Now()
+			m.UI.Loading = true
+			return m, m.fetchLogs()
+		}
+		return m, nil
+	case ActionCycleLookback:
+		m.cycleLookback()
+		m.Patterns.Loading = true
+		return m, m.fetchLogPatterns()
+	case ActionRefresh:
+		m.Patterns.Loading = true
+		return m, m.fetchLogPatterns()
+	case ActionKibana:
+		if m.prepareKibanaURL() {
+			m.showCredsModal()
+		}
+		return m, nil
+	case ActionQuery:
+		m.pushView(viewQuery)
+		m.Query.Format = formatKibana
+		return m, nil
+	case ActionCycleSignal:
+		return m, m.cycleSignalType()
+	case ActionBack:
+		m.popView()
+		return m, nil
+	case ActionQuit:
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// enterLogPatternsView opens the pattern list for the current log filters.
+// When the log list is itself a drill-down from the pattern list, it returns
+// there instead of stacking another copy.
+func (m *Model) enterLogPatternsView() tea.Cmd {
+	if m.UI.Mode == viewLogs && m.peekViewStack() == viewLogPatterns {
+		m.popView()
+	} else {
+		m.pushView(viewLogPatterns)
+		m.Patterns.Cursor = 0
+	}
+	m.Patterns.Loading = true
+	return m.fetchLogPatterns()
+}
+
+// clearPatternFilter removes the pattern drill-down filter from the log list,
+// returning to the pattern list if that's where the drill-down started.
+func (m *Model) clearPatternFilter() tea.Cmd {
+	m.Filters.Pattern = ""
+	m.Logs.SelectedIndex = 0
+	m.Logs.UserHasScrolled = false
+	if m.peekViewStack() == viewLogPatterns {
+		m.popView()
+	}
+	m.UI.Loading = true
+	return m.fetchLogs()
+}
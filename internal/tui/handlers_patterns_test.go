@@ -0,0 +1,35 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import "testing"
+
+func TestPatternDrillDownViewStack(t *testing.T) {
+	m, _ := newTestModel(signalLogs, viewLogs)
+
+	m.enterLogPatternsView()
+	if m.UI.Mode != viewLogPatterns || len(m.UI.ViewStack) != 1 {
+		t.Fatalf("expected patterns view on top of logs, got mode=%v stack=%d", m.UI.Mode, len(m.UI.ViewStack))
+	}
+
+	// Drill down into a pattern
+	m.Filters.Pattern = ".*?Connection.+?refused.*?"
+	m.pushView(viewLogs)
+
+	// Re-opening patterns from the drill-down returns instead of stacking
+	m.enterLogPatternsView()
+	if m.UI.Mode != viewLogPatterns || len(m.UI.ViewStack) != 1 {
+		t.Fatalf("expected return to existing patterns view, got mode=%v stack=%d", m.UI.Mode, len(m.UI.ViewStack))
+	}
+
+	// Clearing the pattern from the drill-down pops back to the pattern list
+	m.pushView(viewLogs)
+	m.clearPatternFilter()
+	if m.Filters.Pattern != "" {
+		t.Fatalf("expected pattern filter cleared, got %q", m.Filters.Pattern)
+	}
+	if m.UI.Mode != viewLogPatterns {
+		t.Fatalf("expected patterns view after clearing, got %v", m.UI.Mode)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m Model) handleServiceMapKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	action := GetAction(key)
+
+	if isNavKey(key) {
+		m.ServiceMap.Cursor = listNav(m.ServiceMap.Cursor, m.serviceMapEdgeCount(), key)
+		return m, nil
+	}
+
+	switch action {
+	case ActionSelect:
+		// Drill down: filter the transaction list to the called service
+		if m.serviceMapEdgeCount() == 0 {
+			return m, nil
+		}
+		edge := m.ServiceMap.Result.Edges[m.ServiceMap.Cursor]
+		if edge.External {
+			m.UI.StatusMessage = edge.Target + " is not an instrumented service"
+			m.UI.StatusTime = time.Now()
+			return m, nil
+		}
+		m.Filters.Service = edge.Target
+		m.Filters.NegateService = false
+		m.popView()
+		m.Traces.NamesCursor = 0
+		m.UI.StatusMessage = "Filtered to service: " + edge.Target
+		m.UI.StatusTime = time.Now()
+		m.Traces.Loading = true
+		return m, m.fetchTransactionNames()
+	case ActionCycleLookback:
+		m.cycleLookback()
+		m.ServiceMap.Loading = true
+		return m, m.fetchServiceMap()
+	case ActionRefresh:
+		m.ServiceMap.Loading = true
+		return m, m.fetchServiceMap()
+	case ActionQuery:
+		m.pushView(viewQuery)
+		m.Query.Format = formatKibana
+		return m, nil
+	case ActionBack:
+		m.popView()
+		return m, nil
+	case ActionQuit:
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// enterServiceMapView opens the service dependency map for the current lookback.
+func (m *Model) enterServiceMapView() tea.Cmd {
+	m.pushView(viewServiceMap)
+	m.ServiceMap.Cursor = 0
+	m.ServiceMap.Loading = true
+	return m.fetchServiceMap()
+}
+
+func (m Model) serviceMapEdgeCount() int {
+	if m.ServiceMap.Result == nil {
+		return 0
+	}
+	return len(m.ServiceMap.Result.Edges)
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es/traces"
+)
+
+func TestServiceMapSelectFiltersService(t *testing.T) {
+	m, _ := newTestModel(signalTraces, viewTraceNames)
+
+	m.enterServiceMapView()
+	if m.UI.Mode != viewServiceMap || !m.ServiceMap.Loading {
+		t.Fatalf("expected loading service map, got mode=%v", m.UI.Mode)
+	}
+	m.ServiceMap = ServiceMapState{Result: &traces.ServiceMapResult{Edges: []traces.ServiceEdge{
+		{Source: "frontend", Target: "cart", Calls: 10},
+		{Source: "cart", Target: "redis", External: true, Calls: 5},
+	}}}
+	enter := func() {
+		t.Helper()
+		next, _ := m.handleServiceMapKey(keyMsg("enter"))
+		m = next.(Model)
+	}
+
+	// External targets are not services, so the view stays put
+	m.ServiceMap.Cursor = 1
+	enter()
+	if m.UI.Mode != viewServiceMap || m.Filters.Service != "" {
+		t.Fatalf("expected no drill-down on external target, got mode=%v service=%q", m.UI.Mode, m.Filters.Service)
+	}
+
+	m.ServiceMap.Cursor = 0
+	enter()
+	if m.UI.Mode != viewTraceNames || m.Filters.Service != "cart" {
+		t.Fatalf("expected trace names filtered to cart, got mode=%v service=%q", m.UI.Mode, m.Filters.Service)
+	}
+}
@@ -6,6 +6,7 @@ package tui
 import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es"
 )
 
 func (m Model) handleTraceNamesKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
@@ -51,6 +52,13 @@ func (m Model) handleTraceNamesKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.Traces.NameFilter = "" // Clear filter on refresh
 		m.Traces.NamesCursor = 0
 		return m, m.fetchTransactionNames()
+	case ActionServiceMap:
+		return m, m.enterServiceMapView()
+	case ActionLatency:
+		if len(filteredNames) > 0 && m.Traces.NamesCursor < len(filteredNames) {
+			return m, m.enterLatencyView(filteredNames[m.Traces.NamesCursor])
+		}
+		return m, nil
 	case ActionQuery:
 		m.pushView(viewQuery)
 		m.Query.Format = formatKibana
@@ -67,3 +75,82 @@ func (m Model) handleTraceNamesKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	return m, nil
 }
+
+// inTraceWaterfall reports whether the log list shows a single trace as a waterfall.
+func (m Model) inTraceWaterfall() bool {
+	return m.Filters.Signal == signalTraces && m.Traces.ViewLevel == traceViewSpans
+}
+
+// setTraceWaterfall builds the span tree for the selected trace and shows its visible rows.
+func (m *Model) setTraceWaterfall(spans []es.LogEntry) {
+	m.Traces.SpanTree = es.BuildSpanTree(spans)
+	m.refreshWaterfall()
+}
+
+// refreshWaterfall re-flattens the span tree after a collapse/expand,
+// keeping the cursor on the same span.
+func (m *Model) refreshWaterfall() {
+	selectedID := ""
+	if m.Logs.SelectedIndex >= 0 && m.Logs.SelectedIndex < len(m.Traces.Waterfall) {
+		selectedID = m.Traces.Waterfall[m.Logs.SelectedIndex].Span.SpanID
+	}
+
+	m.Traces.Waterfall = es.FlattenSpanTree(m.Traces.SpanTree, m.Traces.Collapsed)
+	m.Logs.Entries = make([]es.LogEntry, len(m.Traces.Waterfall))
+	for i, row := range m.Traces.Waterfall {
+		m.Logs.Entries[i] = row.Span
+		if selectedID != "" && row.Span.SpanID == selectedID {
+			m.Logs.SelectedIndex = i
+		}
+	}
+}
+
+// clearTraceWaterfall drops waterfall state when leaving the spans level.
+func (m *Model) clearTraceWaterfall() {
+	m.Traces.SpanTree = nil
+	m.Traces.Waterfall = nil
+	m.Traces.Collapsed = nil
+}
+
+// setSpanCollapsed collapses or expands the subtree under the selected span.
+// Collapsing a leaf (or an already collapsed span) moves the cursor to its parent.
+func (m *Model) setSpanCollapsed(collapsed bool) {
+	idx := m.Logs.SelectedIndex
+	if idx < 0 || idx >= len(m.Traces.Waterfall) {
+		return
+	}
+	row := m.Traces.Waterfall[idx]
+
+	if collapsed && (!row.HasChildren || row.Collapsed) {
+		for i := idx - 1; i >= 0; i-- {
+			if m.Traces.Waterfall[i].Depth < row.Depth {
+				m.Logs.SelectedIndex = i
+				m.Logs.UserHasScrolled = true
+				return
+			}
+		}
+		return
+	}
+	if !row.HasChildren || row.Span.SpanID == "" || row.Collapsed == collapsed {
+		return
+	}
+
+	if m.Traces.Collapsed == nil {
+		m.Traces.Collapsed = make(map[string]bool)
+	}
+	if collapsed {
+		m.Traces.Collapsed[row.Span.SpanID] = true
+	} else {
+		delete(m.Traces.Collapsed, row.Span.SpanID)
+	}
+	m.refreshWaterfall()
+}
+
+// toggleSpanCollapsed flips the collapsed state of the selected span's subtree.
+func (m *Model) toggleSpanCollapsed() {
+	idx := m.Logs.SelectedIndex
+	if idx < 0 || idx >= len(m.Traces.Waterfall) || !m.Traces.Waterfall[idx].HasChildren {
+		return
+	}
+	m.setSpanCollapsed(!m.Traces.Waterfall[idx].Collapsed)
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestTraceWaterfallCollapse(t *testing.T) {
+	m, _ := newTestModel(signalTraces, viewLogs)
+	m.Traces.ViewLevel = traceViewSpans
+	m.setTraceWaterfall([]es.LogEntry{
+		{SpanID: "root"},
+		{SpanID: "child", ParentSpanID: "root"},
+		{SpanID: "grandchild", ParentSpanID: "child"},
+	})
+	if len(m.Logs.Entries) != 3 {
+		t.Fatalf("expected 3 visible rows, got %d", len(m.Logs.Entries))
+	}
+
+	// Collapse the child: grandchild is hidden, cursor stays on child
+	m.Logs.SelectedIndex = 1
+	m.setSpanCollapsed(true)
+	if len(m.Logs.Entries) != 2 || m.Logs.Entries[m.Logs.SelectedIndex].SpanID != "child" {
+		t.Fatalf("expected child collapsed and selected, got %d rows, selected %q", len(m.Logs.Entries), m.Logs.Entries[m.Logs.SelectedIndex].SpanID)
+	}
+
+	// Collapsing again moves to the parent
+	m.setSpanCollapsed(true)
+	if m.Logs.SelectedIndex != 0 {
+		t.Fatalf("expected cursor on root, got %d", m.Logs.SelectedIndex)
+	}
+
+	// Toggling the root hides everything below it, toggling again restores the collapsed child
+	m.toggleSpanCollapsed()
+	if len(m.Logs.Entries) != 1 {
+		t.Fatalf("expected only the root, got %d rows", len(m.Logs.Entries))
+	}
+	m.toggleSpanCollapsed()
+	if len(m.Logs.Entries) != 2 {
+		t.Fatalf("expected root and collapsed child, got %d rows", len(m.Logs.Entries))
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+// openSavedViews lists the saved views of the user and the project.
+func (m *Model) openSavedViews() {
+	nameInput := textinput.New()
+	nameInput.Placeholder = "view name"
+	nameInput.CharLimit = 64
+	nameInput.Width = 40
+
+	m.SavedViews = SavedViewsState{NameInput: nameInput}
+	m.loadSavedViews("")
+	m.pushView(viewSavedViews)
+}
+
+// loadSavedViews reloads the list, selecting the named view when given.
+func (m *Model) loadSavedViews(selectName string) {
+	views, err := config.LoadViews()
+	m.SavedViews.Views = views
+	m.SavedViews.Err = err
+	for i, v := range views {
+		if v.Name == selectName {
+			m.SavedViews.Cursor = i
+		}
+	}
+	m.SavedViews.Cursor = max(min(m.SavedViews.Cursor, len(views)-1), 0)
+}
+
+func (m Model) handleSavedViewsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.SavedViews.Naming {
+		return m.handleSavedViewNameKey(msg)
+	}
+
+	key := msg.String()
+	if newCursor := listNav(m.SavedViews.Cursor, len(m.SavedViews.Views), key); newCursor >= 0 {
+		m.SavedViews.Cursor = newCursor
+		return m, nil
+	}
+
+	switch key {
+	case "S":
+		m.SavedViews.Naming = true
+		m.SavedViews.Renaming = ""
+		m.SavedViews.NameInput.SetValue("")
+		return m, m.SavedViews.NameInput.Focus()
+	case "R":
+		if v, ok := m.selectedSavedView(); ok {
+			m.SavedViews.Naming = true
+			m.SavedViews.Renaming = v.Name
+			m.SavedViews.NameInput.SetValue(v.Name)
+			m.SavedViews.NameInput.CursorEnd()
+			return m, m.SavedViews.NameInput.Focus()
+		}
+		return m, nil
+	}
+
+	switch GetAction(key) {
+	case ActionBack:
+		m.popView()
+	case ActionSelect:
+		if v, ok := m.selectedSavedView(); ok {
+			return m, m.applySavedView(v)
+		}
+	}
+	return m, nil
+}
+
+// handleSavedViewNameKey handles the name prompt of saving and renaming.
+func (m Model) handleSavedViewNameKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		name := strings.TrimSpace(m.SavedViews.NameInput.Value())
+		if name == "" {
+			return m, nil
+		}
+		m.SavedViews.Naming = false
+		m.SavedViews.NameInput.Blur()
+
+		var err error
+		if m.SavedViews.Renaming != "" {
+			err = config.RenameView(m.SavedViews.Renaming, name)
+		} else {
+			err = config.SaveView(m.currentView(name))
+		}
+		switch {
+		case err != nil:
+			m.UI.StatusMessage = "Save failed: " + err.Error()
+		case m.SavedViews.Renaming != "":
+			m.UI.StatusMessage = "Renamed view to " + name
+		default:
+			m.UI.StatusMessage = "Saved view " + name
+		}
+		m.UI.StatusTime = time.Now()
+		m.loadSavedViews(name)
+		return m, nil
+	case "esc":
+		m.SavedViews.Naming = false
+		m.SavedViews.NameInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.SavedViews.NameInput, cmd = m.SavedViews.NameInput.Update(msg)
+	return m, cmd
+}
+
+func (m Model) selectedSavedView() (config.View, bool) {
+	if m.SavedViews.Cursor >= len(m.SavedViews.Views) {
+		return config.View{}, false
+	}
+	return m.SavedViews.Views[m.SavedViews.Cursor], true
+}
+
+// currentView captures the signal, filters, lookback and columns in use.
+// Transient narrowing (time window, pattern, trace jumps) is not saved.
+func (m Model) currentView(name string) config.View {
+	return config.View{
+		Name:           name,
+		Signal:         strings.ToLower(m.Filters.Signal.String()),
+		Query:          m.Filters.Query,
+		Lookback:       m.Filters.Lookback.String(),
+		Service:        m.Filters.Service,
+		NegateService:  m.Filters.NegateService,
+		Resource:       m.Filters.Resource,
+		NegateResource: m.Filters.NegateResource,
+		Level:          m.Filters.Level,
+		Filters:        append([]es.FieldFilter(nil), m.Filters.FieldFilters...),
+		Fields:         config.ViewFieldsFrom(m.Fields.Display),
+	}
+}
+
+// applySavedView replaces the filters and columns with those of a view and
+// enters its signal. ES|QL views open the query editor and run the query.
+func (m *Model) applySavedView(v config.View) tea.Cmd {
+	m.clearViewStack()
+	m.setSavedView(v)
+	m.UI.StatusMessage = "Opened view " + v.Name
+	m.UI.StatusTime = time.Now()
+
+	cmd := m.enterSignalView()
+	if v.ESQL != "" {
+		return tea.Batch(cmd, m.openESQLEditorWith(v.ESQL), m.runESQLEditor())
+	}
+	return cmd
+}
+
+// setSavedView sets the state a view describes without fetching anything.
+// A view's lookback is kept rather than auto-detected.
+func (m *Model) setSavedView(v config.View) {
+	signal := m.Filters.Signal
+	if s, ok := signalFromViewName(v.Signal); ok {
+		signal = s
+	}
+	lookback, pinned := m.Filters.Lookback, false
+	if l, ok := lookbackFromViewName(v.Lookback); ok {
+		lookback, pinned = l, true
+	}
+
+	m.Filters = FilterState{
+		Signal:         signal,
+		Lookback:       lookback,
+		LookbackPinned: pinned,
+		Query:          v.Query,
+		Service:        v.Service,
+		NegateService:  v.NegateService,
+		Resource:       v.Resource,
+		NegateResource: v.NegateResource,
+		Level:          v.Level,
+		FieldFilters:   append([]es.FieldFilter(nil), v.Filters...),
+	}
+	m.Components.SearchInput.SetValue(v.Query)
+	m.Chips = ChipsState{}
+	m.Volume.Focused = false
+
+	if signal != SignalChat {
+		m.client.SetIndex(signal.IndexPattern())
+		m.Components.IndexInput.SetValue(m.client.GetIndex())
+	}
+	m.Fields.Display = DefaultFields(signal)
+	if display := v.DisplayFields(); display != nil {
+		m.Fields.Display = display
+	}
+	m.Logs.Entries = []es.LogEntry{}
+	m.Logs.SelectedIndex = 0
+}
+
+// signalFromViewName maps the signal of a saved view to a signal type.
+func signalFromViewName(name string) (SignalType, bool) {
+	for _, s := range []SignalType{SignalLogs, SignalTraces, SignalMetrics} {
+		if strings.EqualFold(s.String(), name) {
+			return s, true
+		}
+	}
+	return SignalLogs, false
+}
+
+// lookbackFromViewName maps the lookback of a saved view to a preset.
+func lookbackFromViewName(name string) (LookbackDuration, bool) {
+	for _, lb := range lookbackDurations {
+		if lb.String() == name {
+			return lb, true
+		}
+	}
+	return lookback24h, false
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es"
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+func TestSavedViewsSaveAndOpen(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	m, src := newTestModel(signalLogs, viewLogs)
+	src.index = signalTraces.IndexPattern()
+	display := append(DefaultFields(signalLogs), DisplayField{Name: "http.status", Label: "STATUS", Width: 6, Selected: true})
+	m.Filters = FilterState{
+		Signal:       signalLogs,
+		Lookback:     lookback1h,
+		Query:        "timeout",
+		Service:      "checkout",
+		Level:        "ERROR",
+		FieldFilters: []es.FieldFilter{{Field: "http.status", Op: shared.FieldEquals, Value: "500"}},
+	}
+	m.Fields.Display = display
+
+	// Save the current filters and columns from the views list
+	typeText(t, &m, "W")
+	if m.UI.Mode != viewSavedViews {
+		t.Fatalf("expected the saved views list, got mode %v", m.UI.Mode)
+	}
+	typeText(t, &m, "S")
+	typeText(t, &m, "checkout-errors")
+	press(t, &m, "enter")
+	if len(m.SavedViews.Views) != 1 || m.SavedViews.Views[0].Name != "checkout-errors" {
+		t.Fatalf("expected the view to be saved, got %+v (status %q)", m.SavedViews.Views, m.UI.StatusMessage)
+	}
+
+	// Rename it
+	typeText(t, &m, "R")
+	for range len("errors") {
+		press(t, &m, "backspace")
+	}
+	typeText(t, &m, "500s")
+	press(t, &m, "enter")
+	if len(m.SavedViews.Views) != 1 || m.SavedViews.Views[0].Name != "checkout-500s" {
+		t.Fatalf("expected the view to be renamed, got %+v (status %q)", m.SavedViews.Views, m.UI.StatusMessage)
+	}
+	if out := m.renderSavedViews(20); !strings.Contains(out, "checkout-500s") || !strings.Contains(out, "service: checkout") {
+		t.Errorf("expected the view in the list, got:\n%s", out)
+	}
+	press(t, &m, "esc")
+
+	// Switch away, then open the view again
+	m.cycleSignalType()
+	m.Filters.Service = ""
+	m.Filters.FieldFilters = nil
+	typeText(t, &m, "W")
+	if cmd := press(t, &m, "enter"); cmd == nil {
+		t.Fatal("expected opening a view to fetch")
+	}
+
+	f := m.Filters
+	if f.Signal != signalLogs || m.UI.Mode != viewLogs || src.index != signalLogs.IndexPattern() {
+		t.Errorf("expected the logs view, got signal=%v mode=%v index=%q", f.Signal, m.UI.Mode, src.index)
+	}
+	if f.Query != "timeout" || f.Service != "checkout" || f.Level != "ERROR" || len(f.FieldFilters) != 1 {
+		t.Errorf("filters not restored: %+v", f)
+	}
+	if f.Lookback != lookback1h || !f.LookbackPinned {
+		t.Errorf("expected the saved lookback to be kept, got %v pinned=%v", f.Lookback, f.LookbackPinned)
+	}
+	if len(m.Fields.Display) != len(display) || m.Fields.Display[len(display)-1].Name != "http.status" {
+		t.Errorf("columns not restored: %+v", m.Fields.Display)
+	}
+	if msg, ok := m.autoDetectLookback()().(autoDetectMsg); !ok || !msg.pinned || msg.lookback != lookback1h {
+		t.Errorf("expected auto-detection to keep the saved lookback, got %+v", msg)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleLogVolumeKey handles keys while the volume histogram above the log list
+// has focus. Keys it doesn't consume fall through to the log list.
+func (m Model) handleLogVolumeKey(msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	count := m.logVolumeBucketCount()
+
+	switch GetAction(msg.String()) {
+	case ActionPrevItem:
+		if m.Volume.Cursor > 0 {
+			m.Volume.Cursor--
+		}
+	case ActionNextItem:
+		if m.Volume.Cursor < count-1 {
+			m.Volume.Cursor++
+		}
+	case ActionGoTop:
+		m.Volume.Cursor = 0
+	case ActionGoBottom:
+		m.Volume.Cursor = max(count-1, 0)
+	case ActionSelect:
+		return m, m.narrowToVolumeBucket(), true
+	case ActionBack, ActionVolume:
+		m.Volume.Focused = false
+	default:
+		return m, nil, false
+	}
+	return m, nil, true
+}
+
+// narrowToVolumeBucket limits the logs view to the selected histogram bucket.
+// The histogram then re-buckets the narrowed window, so it can be narrowed again.
+func (m *Model) narrowToVolumeBucket() tea.Cmd {
+	if m.logVolumeBucketCount() == 0 {
+		return nil
+	}
+	bucket := m.Volume.Histogram.Buckets[m.Volume.Cursor]
+	if bucket.Total() == 0 {
+		return nil
+	}
+	m.Filters.From = bucket.Start
+	m.Filters.To = bucket.Start.Add(m.Volume.Histogram.Interval)
+	m.Volume.Cursor = 0
+	m.Logs.SelectedIndex = 0
+	m.Logs.UserHasScrolled = false
+	m.UI.Loading = true
+	return m.fetchLogs()
+}
+
+// clearTimeNarrowing returns the logs view to the lookback window.
+func (m *Model) clearTimeNarrowing() tea.Cmd {
+	m.Filters.From, m.Filters.To = time.Time{}, time.Time{}
+	m.Logs.UserHasScrolled = false
+	m.UI.Loading = true
+	return m.fetchLogs()
+}
+
+func (m Model) handleLogVolumeMsg(msg logVolumeMsg) (Model, tea.Cmd) {
+	m.Volume.Loading = false
+	if isContextError(msg.err) {
+		m.Volume.Key = "" // Canceled; fetch again with the next logs
+		return m, nil
+	}
+	// The histogram is secondary to the list, so failures stay inline
+	m.Volume.Err = msg.err
+	if msg.err != nil {
+		m.Volume.Histogram = nil
+		return m, nil
+	}
+
+	m.Volume.Histogram = msg.result
+	count := m.logVolumeBucketCount()
+	if !m.Volume.Focused || m.Volume.Cursor >= count {
+		// Start from the most recent bucket
+		m.Volume.Cursor = max(count-1, 0)
+	}
+	return m, nil
+}
+
+// logVolumeKey identifies the filters and time window the histogram depends on.
+// A lookback window slides with the clock, so it is keyed by its length.
+func (m Model) logVolumeKey() string {
+	f := m.Filters
+	return fmt.Sprintf("%s|%v|%s|%s|%t|%s|%t|%s|%s|%s|%s|%v|%v|%v|%v|%v|%d",
+		m.client.GetIndex(), f.Lookback, f.Query, f.Service, f.NegateService, f.Resource, f.NegateResource,
+		f.Level, f.TraceID, f.SpanID, f.Pattern, f.Exception, f.FieldFilters, f.From, f.To,
+		CollectSearchFields(m.Fields.Display), m.logVolumeBuckets())
+}
+
+// showLogVolume reports whether the volume histogram is drawn above the list.
+func (m Model) showLogVolume() bool {
+	return m.Filters.Signal == signalLogs && !m.inTraceWaterfall() && m.Logs.Context == nil
+}
+
+func (m Model) logVolumeBucketCount() int {
+	if m.Volume.Histogram == nil {
+		return 0
+	}
+	return len(m.Volume.Histogram.Buckets)
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestLogVolumeNarrowsTimeWindow(t *testing.T) {
+	base := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	m, _ := newTestModel(signalLogs, viewLogs)
+	m.UI.Width, m.UI.Height = 100, 30
+	m.Filters.Lookback = lookback1h
+
+	m, _ = m.handleLogVolumeMsg(logVolumeMsg{result: &es.VolumeHistogram{
+		Interval: time.Minute,
+		Buckets: []es.VolumeBucket{
+			{Start: base, Other: 4},
+			{Start: base.Add(time.Minute), Error: 2, Warn: 1, Other: 7},
+			{Start: base.Add(2 * time.Minute)},
+		},
+	}})
+	if m.Volume.Cursor != 2 {
+		t.Fatalf("expected cursor on the newest bucket, got %d", m.Volume.Cursor)
+	}
+
+	pressInLogs := func(key string) tea.Cmd {
+		next, cmd := m.handleLogsKey(keyMsg(key))
+		m = next.(Model)
+		return cmd
+	}
+	pressInLogs("Z")
+	if !m.Volume.Focused {
+		t.Fatal("expected Z to focus the histogram")
+	}
+	pressInLogs("left")
+	if out := m.renderLogVolume(); !strings.Contains(out, "10 2e 1w") {
+		t.Errorf("expected readout of the selected bucket, got %q", out)
+	}
+
+	if cmd := pressInLogs("enter"); cmd == nil {
+		t.Fatal("expected narrowing to refetch logs")
+	}
+	if !m.Filters.From.Equal(base.Add(time.Minute)) || !m.Filters.To.Equal(base.Add(2*time.Minute)) {
+		t.Fatalf("expected window of the selected bucket, got %v - %v", m.Filters.From, m.Filters.To)
+	}
+	from, to := m.logsTimeWindow(time.Now())
+	if !from.Equal(m.Filters.From) || !to.Equal(m.Filters.To) {
+		t.Fatalf("expected the narrowed window to replace the lookback, got %v - %v", from, to)
+	}
+
+	// Esc leaves the histogram, a second Esc restores the lookback
+	pressInLogs("esc")
+	if m.Volume.Focused || !m.isTimeNarrowed() {
+		t.Fatal("expected first esc to only unfocus the histogram")
+	}
+	pressInLogs("esc")
+	if m.isTimeNarrowed() {
+		t.Fatalf("expected narrowing cleared, got %v - %v", m.Filters.From, m.Filters.To)
+	}
+}
+
+func TestLogVolumeRefetchesOnlyOnChange(t *testing.T) {
+	m, _ := newTestModel(signalLogs, viewLogs)
+	m.UI.Width, m.UI.Height = 100, 30
+	m.Filters.Lookback = lookback1h
+
+	var cmd tea.Cmd
+	if m, cmd = m.maybeTriggerPostLoadFetches(); cmd == nil || !m.Volume.Loading {
+		t.Fatal("expected the first load to fetch the histogram")
+	}
+	m, _ = m.handleLogVolumeMsg(logVolumeMsg{result: &es.VolumeHistogram{Interval: time.Minute}})
+	if m, cmd = m.maybeTriggerPostLoadFetches(); cmd != nil {
+		t.Fatal("expected a reload of the same window to keep the histogram")
+	}
+	m.Filters.Level = "ERROR"
+	if m, cmd = m.maybeTriggerPostLoadFetches(); cmd == nil {
+		t.Fatal("expected a filter change to refetch the histogram")
+	}
+
+	// Failures stay in the histogram row
+	m, _ = m.handleLogVolumeMsg(logVolumeMsg{err: fmt.Errorf("boom")})
+	if m.UI.Err != nil || m.UI.Mode != viewLogs {
+		t.Fatalf("expected no error modal, got mode %v err %v", m.UI.Mode, m.UI.Err)
+	}
+	if out := m.renderLogVolume(); !strings.Contains(out, "volume: boom") {
+		t.Errorf("expected the error inline, got %q", out)
+	}
+}
@@ -28,22 +28,32 @@ func (m Model) renderTitleHeader() string {
 		infoParts = append(infoParts, "Sort: newest→")
 	}
 
-	if m.UI.AutoRefresh {
+	if m.Logs.Live != nil {
+		infoParts = append(infoParts, "Live")
+	} else if m.UI.AutoRefresh {
 		infoParts = append(infoParts, "Auto: ON")
 	} else {
 		infoParts = append(infoParts, "Auto: OFF")
 	}
 
 	// Make entire operational info white
-	rightInfo := lipgloss.NewStyle().Foreground(lipgloss.Color("255")).Render("[ " + strings.Join(infoParts, " │ ") + " ]")
+	rightInfo := lipgloss.NewStyle().Foreground(fgColor).Render("[ " + strings.Join(infoParts, " │ ") + " ]")
 
 	// Calculate how many characters we need for the line to fill the width
 	// Account for padding in the style (2 chars)
 	// Use lipgloss.Width to get actual rendered width (ignoring ANSI codes)
 	availableWidth := m.UI.Width - 2
-	titleLen := lipgloss.Width(title)
 	rightInfoLen := lipgloss.Width(rightInfo)
 
+	// Tabs go between the title and the line when there is more than one.
+	// Their styles end the header's, so the line is styled on its own.
+	lineStyle := lipgloss.NewStyle()
+	if tabs := m.renderTabs(availableWidth - lipgloss.Width(title) - rightInfoLen - 4); tabs != "" {
+		title += " " + tabs + " "
+		lineStyle = lineStyle.Bold(true).Foreground(primaryColor)
+	}
+	titleLen := lipgloss.Width(title)
+
 	// Check if everything fits
 	if titleLen+rightInfoLen >= availableWidth {
 		// Not enough space, just show title with line
@@ -51,13 +61,13 @@ func (m Model) renderTitleHeader() string {
 		if lineChars < 0 {
 			lineChars = 0
 		}
-		line := strings.Repeat("═", lineChars)
+		line := lineStyle.Render(strings.Repeat("═", lineChars))
 		return TitleHeaderStyle.Width(m.UI.Width).Render(title + line)
 	}
 
 	// Fill the middle with box drawing characters
 	lineChars := availableWidth - titleLen - rightInfoLen
-	line := strings.Repeat("═", lineChars)
+	line := lineStyle.Render(strings.Repeat("═", lineChars))
 
 	fullHeader := title + line + rightInfo
 	return TitleHeaderStyle.Width(m.UI.Width).Render(fullHeader)
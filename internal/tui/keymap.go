@@ -36,10 +36,10 @@ func (m Model) QuickBindings() []KeyBinding {
 
 	if m.HelpEnabled() {
 		// Prepend help then signal, so order is: ? m [rest...]
-		bindings = append([]KeyBinding{
+		bindings = append(m.keymap.remapBindings(m.keymapMode(), []KeyBinding{
 			ActionBinding(ActionHelp, KeyKindQuick, "Help"),
 			ActionBinding(ActionCycleSignal, KeyKindQuick, "View"),
-		}, bindings...)
+		}), bindings...)
 	}
 
 	if len(bindings) > quickLimit {
@@ -48,9 +48,12 @@ func (m Model) QuickBindings() []KeyBinding {
 	return bindings
 }
 
-// FullBindings returns the full set of bindings for the view.
+// FullBindings returns the full set of bindings for the view, plus the
+// command palette, which opens over every view.
 func (m Model) FullBindings() []KeyBinding {
-	return m.ViewKeymap()
+	return append(m.ViewKeymap(), m.keymap.remapBindings(m.keymapMode(), []KeyBinding{
+		ActionBinding(ActionPalette, KeyKindFull, "System"),
+	})...)
 }
 
 func filterByKind(bindings []KeyBinding, kind KeyKind) []KeyBinding {
@@ -99,6 +102,16 @@ func SystemBindings() []KeyBinding {
 	}
 }
 
+// TabBindings returns the keys that open, close, switch and rename tabs.
+func TabBindings() []KeyBinding {
+	return []KeyBinding{
+		ActionBinding(ActionNewTab, KeyKindFull, "Tabs"),
+		ActionBinding(ActionCloseTab, KeyKindFull, "Tabs"),
+		CombinedBinding([]string{"tab", "shift+tab"}, "switch tab", KeyKindFull, "Tabs"),
+		ActionBinding(ActionRenameTab, KeyKindFull, "Tabs"),
+	}
+}
+
 // DetailGlobalBindings returns globals plus CopyOriginal for log detail views.
 func DetailGlobalBindings() []KeyBinding {
 	return []KeyBinding{
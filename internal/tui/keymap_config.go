@@ -0,0 +1,349 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/config"
+)
+
+// actionNames are the names actions are given in the keymap section of the
+// config file.
+var actionNames = map[string]Action{
+	"scroll_up":     ActionScrollUp,
+	"scroll_down":   ActionScrollDown,
+	"page_up":       ActionPageUp,
+	"page_down":     ActionPageDown,
+	"top":           ActionGoTop,
+	"bottom":        ActionGoBottom,
+	"prev":          ActionPrevItem,
+	"next":          ActionNextItem,
+	"select":        ActionSelect,
+	"back":          ActionBack,
+	"quit":          ActionQuit,
+	"help":          ActionHelp,
+	"refresh":       ActionRefresh,
+	"search":        ActionSearch,
+	"lookback":      ActionCycleLookback,
+	"signal":        ActionCycleSignal,
+	"perspective":   ActionPerspective,
+	"copy":          ActionCopy,
+	"json":          ActionJSON,
+	"kibana":        ActionKibana,
+	"sort":          ActionSort,
+	"fields":        ActionFields,
+	"query":         ActionQuery,
+	"auto_refresh":  ActionAutoRefresh,
+	"toggle":        ActionToggle,
+	"spans":         ActionSpans,
+	"next_doc":      ActionNextDoc,
+	"prev_doc":      ActionPrevDoc,
+	"chat":          ActionChat,
+	"send_to_chat":  ActionSendToChat,
+	"creds":         ActionCreds,
+	"otel_config":   ActionOtelConfig,
+	"copy_original": ActionCopyOriginal,
+	"patterns":      ActionPatterns,
+	"jump_trace":    ActionJumpTrace,
+	"jump_logs":     ActionJumpLogs,
+	"service_map":   ActionServiceMap,
+	"latency":       ActionLatency,
+	"group_by":      ActionGroupBy,
+	"compare":       ActionCompare,
+	"volume":        ActionVolume,
+	"filter":        ActionFilter,
+	"views":         ActionViews,
+	"split":         ActionSplit,
+	"split_shrink":  ActionSplitShrink,
+	"split_grow":    ActionSplitGrow,
+	"new_tab":       ActionNewTab,
+	"close_tab":     ActionCloseTab,
+	"next_tab":      ActionNextTab,
+	"prev_tab":      ActionPrevTab,
+	"rename_tab":    ActionRenameTab,
+	"live_tail":     ActionLiveTail,
+	"bookmark":      ActionBookmark,
+	"bookmarks":     ActionBookmarks,
+	"context":       ActionContext,
+	"errors":        ActionExceptions,
+	"palette":       ActionPalette,
+}
+
+// keymapScopes are the views whose keys can be overridden separately from
+// the global scope.
+var keymapScopes = map[string]viewMode{
+	"logs":          viewLogs,
+	"detail":        viewDetail,
+	"detail_json":   viewDetailJSON,
+	"fields":        viewFields,
+	"metrics":       viewMetricsDashboard,
+	"metric_detail": viewMetricDetail,
+	"trace_names":   viewTraceNames,
+	"perspectives":  viewPerspectiveList,
+	"patterns":      viewLogPatterns,
+	"service_map":   viewServiceMap,
+	"latency":       viewLatency,
+	"compare":       viewMetricCompare,
+	"field_filter":  viewFieldFilter,
+	"esql":          viewESQL,
+	"saved_views":   viewSavedViews,
+	"bookmarks":     viewBookmarks,
+	"errors":        viewExceptions,
+	"chat":          viewChat,
+}
+
+// viewKeys are the keys views handle themselves rather than through an
+// action, so a remapped action can't take them over in that view.
+var viewKeys = map[viewMode][]string{
+	viewLogs:             {"0", "1", "2", "3", "4", "d", "i", "t"},
+	viewMetricsDashboard: {"d"},
+	viewFields:           {"+", "-"},
+	viewFieldFilter:      {"+", "-", "e"},
+	viewESQL:             {"S", "i", "tab", "ctrl+n", "ctrl+p", "ctrl+r"},
+	viewSavedViews:       {"R", "S"},
+	viewBookmarks:        {"D", "E", "n"},
+	viewChat:             {"i"},
+}
+
+// reservedKeys can't be rebound.
+var reservedKeys = map[string]bool{"ctrl+c": true}
+
+// displayKeyNames maps the glyphs shown in the help to key names.
+var displayKeyNames = map[string]string{
+	"↑":     "up",
+	"↓":     "down",
+	"←":     "left",
+	"→":     "right",
+	"space": " ",
+}
+
+// keyTypesByName maps the names of special keys to their type, for turning a
+// key name back into a key press.
+var keyTypesByName = func() map[string]tea.KeyType {
+	names := make(map[string]tea.KeyType)
+	for t := tea.KeyType(-128); t < 128; t++ {
+		if name := t.String(); name != "" {
+			if _, ok := names[name]; !ok {
+				names[name] = t
+			}
+		}
+	}
+	names["space"] = tea.KeySpace
+	return names
+}()
+
+// Keymap translates keys remapped in the config file to the keys the views
+// handle. Keys that were not remapped keep working.
+type Keymap struct {
+	global keyRemap
+	views  map[viewMode]keyRemap
+}
+
+// keyRemap is the remapping in effect in one view.
+type keyRemap struct {
+	pressed map[string]string   // Remapped key → default key of its action
+	shown   map[string][]string // Default key of a remapped action → its keys
+}
+
+// NewKeymap builds the keymap from the config file, rejecting unknown names
+// and keys bound to two actions in the same view.
+func NewKeymap(cfg config.KeymapConfig) (*Keymap, error) {
+	for scope := range cfg {
+		if _, ok := keymapScopes[scope]; !ok && scope != config.KeymapGlobalScope {
+			return nil, fmt.Errorf("keymap: unknown view %q (expected global or one of %s)", scope, strings.Join(sortedKeys(keymapScopes), ", "))
+		}
+	}
+
+	global, err := resolveKeyOverrides(config.KeymapGlobalScope, cfg[config.KeymapGlobalScope], nil)
+	if err != nil {
+		return nil, err
+	}
+	k := &Keymap{global: global, views: make(map[viewMode]keyRemap)}
+	for scope, overrides := range cfg {
+		if scope == config.KeymapGlobalScope {
+			continue
+		}
+		remap, err := resolveKeyOverrides(scope, overrides, cfg[config.KeymapGlobalScope])
+		if err != nil {
+			return nil, err
+		}
+		k.views[keymapScopes[scope]] = remap
+	}
+	return k, nil
+}
+
+// resolveKeyOverrides checks the overrides of one scope on top of the global
+// ones, which the scope's own overrides replace action by action.
+func resolveKeyOverrides(scope string, overrides, global map[string]config.KeyList) (keyRemap, error) {
+	merged := make(map[Action]config.KeyList)
+	for _, set := range []map[string]config.KeyList{global, overrides} {
+		for name, keys := range set {
+			action, ok := actionNames[name]
+			if !ok {
+				return keyRemap{}, fmt.Errorf("keymap %s: unknown action %q", scope, name)
+			}
+			merged[action] = keys
+		}
+	}
+
+	remap := keyRemap{pressed: make(map[string]string), shown: make(map[string][]string)}
+	boundTo := make(map[string]Action)
+	for _, name := range sortedKeys(actionNames) {
+		action := actionNames[name]
+		keys, ok := merged[action]
+		if !ok {
+			continue
+		}
+		if len(keys) == 0 {
+			return keyRemap{}, fmt.Errorf("keymap %s: no keys for %s", scope, name)
+		}
+		defaultKey := actionKey(action)
+		for _, key := range keys {
+			if err := validateKeyName(key); err != nil {
+				return keyRemap{}, fmt.Errorf("keymap %s: %s: %w", scope, name, err)
+			}
+			if other, ok := boundTo[key]; ok && other != action {
+				return keyRemap{}, fmt.Errorf("keymap %s: %q is bound to both %s and %s", scope, key, actionName(other), name)
+			}
+			// A key taken from another action is only free once that action moves too
+			other, isDefault := DefaultKeyBindings[key]
+			if isDefault && other != action {
+				if _, moved := merged[other]; !moved {
+					return keyRemap{}, fmt.Errorf("keymap %s: %q already means %s; remap %s as well", scope, key, actionName(other), actionName(other))
+				}
+			}
+			if view, ok := viewKeyConflict(scope, key); ok && other != action {
+				return keyRemap{}, fmt.Errorf("keymap %s: %q is used by the %s view itself", scope, key, view)
+			}
+			boundTo[key] = action
+			remap.pressed[key] = defaultKey
+		}
+		remap.shown[defaultKey] = keys
+	}
+	return remap, nil
+}
+
+// viewKeyConflict returns the name of a view of the scope that handles key
+// itself. Global overrides apply to every view.
+func viewKeyConflict(scope, key string) (string, bool) {
+	for _, name := range sortedKeys(keymapScopes) {
+		if scope != config.KeymapGlobalScope && scope != name {
+			continue
+		}
+		if slices.Contains(viewKeys[keymapScopes[name]], key) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// validateKeyName accepts the key names bubbletea reports, such as "ctrl+f",
+// "f1" or "alt+x", and single characters.
+func validateKeyName(key string) error {
+	if reservedKeys[key] {
+		return fmt.Errorf("%q can't be rebound", key)
+	}
+	name := key
+	if rest, ok := strings.CutPrefix(key, "alt+"); ok && rest != "" {
+		name = rest
+	}
+	if _, ok := keyTypesByName[name]; ok || utf8.RuneCountInString(name) == 1 {
+		return nil
+	}
+	return fmt.Errorf("unknown key %q", key)
+}
+
+// actionKey returns the key the views handle an action by.
+func actionKey(action Action) string {
+	key := ActionDisplay[action].DisplayKeys[0]
+	if name, ok := displayKeyNames[key]; ok {
+		return name
+	}
+	return key
+}
+
+func actionName(action Action) string {
+	for name, a := range actionNames {
+		if a == action {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (k *Keymap) remapFor(mode viewMode) keyRemap {
+	if remap, ok := k.views[mode]; ok {
+		return remap
+	}
+	return k.global
+}
+
+// translate turns a remapped key press into the key its view handles.
+func (k *Keymap) translate(mode viewMode, msg tea.KeyMsg) tea.KeyMsg {
+	if k == nil {
+		return msg
+	}
+	key, ok := k.remapFor(mode).pressed[msg.String()]
+	if !ok {
+		return msg
+	}
+	return keyMsgFor(key)
+}
+
+// keyMsgFor builds the key press bubbletea would report for a key name.
+func keyMsgFor(key string) tea.KeyMsg {
+	var alt bool
+	if rest, ok := strings.CutPrefix(key, "alt+"); ok && rest != "" {
+		key, alt = rest, true
+	}
+	if t, ok := keyTypesByName[key]; ok {
+		return tea.KeyMsg{Type: t, Alt: alt}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key), Alt: alt}
+}
+
+// remapBindings shows the remapped keys in place of the defaults they replace.
+func (k *Keymap) remapBindings(mode viewMode, bindings []KeyBinding) []KeyBinding {
+	if k == nil {
+		return bindings
+	}
+	shown := k.remapFor(mode).shown
+	if len(shown) == 0 {
+		return bindings
+	}
+
+	out := make([]KeyBinding, len(bindings))
+	for i, b := range bindings {
+		var keys []string
+		for _, key := range b.Keys {
+			name := key
+			if n, ok := displayKeyNames[key]; ok {
+				name = n
+			}
+			if remapped, ok := shown[name]; ok {
+				keys = append(keys, remapped...)
+			} else {
+				keys = append(keys, key)
+			}
+		}
+		b.Keys = keys
+		out[i] = b
+	}
+	return out
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/config"
+)
+
+func TestNewKeymapValidation(t *testing.T) {
+	// Every configurable action must be reachable through its default key
+	for name, action := range actionNames {
+		if got := GetAction(actionKey(action)); got != action {
+			t.Errorf("%s: default key %q maps to %v", name, actionKey(action), got)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		cfg     config.KeymapConfig
+		wantErr string
+	}{
+		{"empty", nil, ""},
+		{"swap", config.KeymapConfig{"global": {"scroll_up": {"j"}, "scroll_down": {"k"}}}, ""},
+		{"unknown view", config.KeymapConfig{"nope": {"search": {"s"}}}, "unknown view"},
+		{"unknown action", config.KeymapConfig{"global": {"nope": {"s"}}}, "unknown action"},
+		{"unknown key", config.KeymapConfig{"global": {"search": {"ctrl+nope"}}}, "unknown key"},
+		{"reserved key", config.KeymapConfig{"global": {"search": {"ctrl+c"}}}, "can't be rebound"},
+		{"same key twice", config.KeymapConfig{"logs": {"search": {"ctrl+f"}, "query": {"ctrl+f"}}}, "bound to both"},
+		{"taken default", config.KeymapConfig{"global": {"search": {"j"}}}, "already means scroll_down"},
+		{"global clash in view", config.KeymapConfig{"global": {"search": {"ctrl+f"}}, "logs": {"query": {"ctrl+f"}}}, "bound to both"},
+		{"view's own key", config.KeymapConfig{"saved_views": {"sort": {"R"}}}, "used by the saved_views view"},
+		{"view's own key from global", config.KeymapConfig{"global": {"search": {"+"}}}, "used by the field_filter view"},
+		{"other view's own key", config.KeymapConfig{"detail": {"sort": {"R"}}}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeymap(tt.cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestKeymapRemapsKeysAndHelp(t *testing.T) {
+	keymap, err := NewKeymap(config.KeymapConfig{
+		"global": {"search": {"ctrl+f"}, "scroll_up": {"j"}, "scroll_down": {"k"}},
+		"logs":   {"patterns": {"alt+p"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := keymap.translate(viewLogs, tea.KeyMsg{Type: tea.KeyCtrlF}).String(); got != "/" {
+		t.Errorf("ctrl+f = %q, want /", got)
+	}
+	if got := keymap.translate(viewLogs, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")}).String(); got != "down" {
+		t.Errorf("k = %q, want down", got)
+	}
+	if got := keymap.translate(viewLogs, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p"), Alt: true}).String(); got != "P" {
+		t.Errorf("alt+p = %q, want P", got)
+	}
+	// Overrides of one view don't leak into others, and unmapped keys pass through
+	if got := keymap.translate(viewDetail, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p"), Alt: true}).String(); got != "alt+p" {
+		t.Errorf("alt+p in detail = %q, want alt+p", got)
+	}
+	if got := keymap.translate(viewLogs, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")}).String(); got != "/" {
+		t.Errorf("/ = %q, want /", got)
+	}
+
+	m, _ := newTestModel(signalLogs, viewLogs)
+	m.keymap = keymap
+	m.Components.SearchInput = textinput.New()
+	searching := m
+	if press(t, &searching, "ctrl+f"); searching.UI.Mode != viewSearch {
+		t.Errorf("ctrl+f opened mode %v, want search", searching.UI.Mode)
+	}
+
+	shown := map[string][]string{}
+	for _, b := range m.ViewKeymap() {
+		shown[b.Label] = b.Keys
+	}
+	if keys := shown[ActionDisplay[ActionSearch].Label]; len(keys) != 1 || keys[0] != "ctrl+f" {
+		t.Errorf("search shown as %v, want [ctrl+f]", keys)
+	}
+	if keys := shown[ActionDisplay[ActionPatterns].Label]; len(keys) != 1 || keys[0] != "alt+p" {
+		t.Errorf("patterns shown as %v, want [alt+p]", keys)
+	}
+}
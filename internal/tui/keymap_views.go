@@ -3,12 +3,25 @@
 
 package tui
 
-// ViewKeymap returns the full keymap for the current view/mode.
+import "github.com/elastic/elasticat/internal/es"
+
+// ViewKeymap returns the full keymap for the current view/mode, showing keys
+// remapped in the config file in place of the defaults.
 func (m Model) ViewKeymap() []KeyBinding {
-	mode := m.UI.Mode
-	if mode == viewHelp {
-		mode = m.peekViewStack()
+	mode := m.keymapMode()
+	return m.keymap.remapBindings(mode, m.viewKeymap(mode))
+}
+
+// keymapMode returns the view whose keys are shown, which is the view below
+// the help overlay or command palette while it is open.
+func (m Model) keymapMode() viewMode {
+	if m.UI.Mode == viewHelp || m.UI.Mode == viewPalette {
+		return m.peekViewStack()
 	}
+	return m.UI.Mode
+}
+
+func (m Model) viewKeymap(mode viewMode) []KeyBinding {
 	switch mode {
 	case viewLogs:
 		return m.keymapLogs()
@@ -26,6 +39,24 @@ func (m Model) ViewKeymap() []KeyBinding {
 		return m.keymapTraceNames()
 	case viewPerspectiveList:
 		return m.keymapPerspectiveList()
+	case viewLogPatterns:
+		return m.keymapLogPatterns()
+	case viewExceptions:
+		return m.keymapExceptions()
+	case viewServiceMap:
+		return m.keymapServiceMap()
+	case viewLatency:
+		return m.keymapLatency()
+	case viewMetricCompare:
+		return m.keymapMetricCompare()
+	case viewFieldFilter:
+		return m.keymapFieldFilter()
+	case viewESQL:
+		return m.keymapESQLEditor()
+	case viewSavedViews:
+		return m.keymapSavedViews()
+	case viewBookmarks:
+		return m.keymapBookmarks()
 	case viewErrorModal:
 		return m.keymapErrorModal()
 	case viewChat:
@@ -37,6 +68,13 @@ func (m Model) ViewKeymap() []KeyBinding {
 }
 
 func (m Model) keymapLogs() []KeyBinding {
+	if m.Volume.Focused {
+		return m.keymapLogVolume()
+	}
+	if m.Chips.Focused {
+		return m.keymapFilterChips()
+	}
+
 	quick := []KeyBinding{
 		ScrollBinding(KeyKindQuick),
 		ActionBindingWithLabel(ActionSelect, "details", KeyKindQuick, "View"),
@@ -52,25 +90,113 @@ func (m Model) keymapLogs() []KeyBinding {
 		ActionBinding(ActionSort, KeyKindFull, "View"),
 		ActionBinding(ActionFields, KeyKindFull, "View"),
 		ActionBinding(ActionQuery, KeyKindFull, "View"),
+		ActionBinding(ActionViews, KeyKindFull, "View"),
+		ActionBinding(ActionSplit, KeyKindFull, "View"),
 		ActionBinding(ActionRefresh, KeyKindFull, "View"),
 		ActionBinding(ActionAutoRefresh, KeyKindFull, "View"),
+		ActionBinding(ActionLiveTail, KeyKindFull, "View"),
+		ActionBinding(ActionBookmark, KeyKindFull, "View"),
+		ActionBinding(ActionBookmarks, KeyKindFull, "View"),
 		ActionBinding(ActionSendToChat, KeyKindFull, "AI"),
 		ActionBinding(ActionCreds, KeyKindFull, "System"),
 		ActionBinding(ActionOtelConfig, KeyKindFull, "System"),
 		CombinedBinding([]string{"0-4"}, "level filters", KeyKindFull, "Filter"),
 		ActionBinding(ActionQuit, KeyKindFull, "System"),
 	}
+	if m.Layout.Split {
+		full = append(full,
+			ActionBinding(ActionSplitShrink, KeyKindFull, "View"),
+			ActionBinding(ActionSplitGrow, KeyKindFull, "View"),
+		)
+	}
+	full = append(full, TabBindings()...)
 
 	if m.Filters.Signal == signalMetrics && m.Metrics.ViewMode == metricsViewDocuments {
 		full = append([]KeyBinding{CombinedBinding([]string{"d"}, "dashboard", KeyKindFull, "View")}, full...)
 	}
-	if m.Filters.Signal == signalTraces && (m.Traces.ViewLevel == traceViewTransactions || m.Traces.ViewLevel == traceViewSpans) {
+	if len(m.Filters.FieldFilters) > 0 {
+		full = append([]KeyBinding{ActionBindingWithLabel(ActionFilter, "edit field filters", KeyKindFull, "Filter")}, full...)
+	}
+	if m.Logs.Context != nil {
+		label := "all services"
+		if m.Logs.Context.AllServices {
+			label = "anchor's service only"
+		}
+		full = append([]KeyBinding{ActionBindingWithLabel(ActionContext, label, KeyKindFull, "Filter")}, full...)
+	}
+	if m.Filters.Signal == signalLogs {
+		full = append([]KeyBinding{
+			ActionBinding(ActionPatterns, KeyKindFull, "View"),
+			ActionBinding(ActionExceptions, KeyKindFull, "View"),
+			ActionBinding(ActionJumpTrace, KeyKindFull, "Navigation"),
+		}, full...)
+		full = append([]KeyBinding{ActionBinding(ActionVolume, KeyKindFull, "Filter")}, full...)
+		if m.isTimeNarrowed() {
+			full = append([]KeyBinding{ActionBindingWithLabel(ActionBack, "clear time window", KeyKindFull, "Filter")}, full...)
+		} else if m.Filters.Pattern != "" {
+			full = append([]KeyBinding{ActionBindingWithLabel(ActionBack, "clear pattern", KeyKindFull, "Navigation")}, full...)
+		} else if !m.Filters.Exception.IsZero() {
+			full = append([]KeyBinding{ActionBindingWithLabel(ActionBack, "clear error group", KeyKindFull, "Navigation")}, full...)
+		}
+	}
+	if m.Filters.Signal == signalTraces && !m.inSignalJump() && (m.Traces.ViewLevel == traceViewTransactions || m.Traces.ViewLevel == traceViewSpans) {
 		full = append([]KeyBinding{ActionBinding(ActionBack, KeyKindFull, "Navigation")}, full...)
 	}
+	if m.Filters.Signal == signalTraces && m.Traces.ViewLevel != traceViewNames {
+		full = append([]KeyBinding{ActionBinding(ActionJumpLogs, KeyKindFull, "Navigation")}, full...)
+	}
+	if m.inSignalJump() {
+		full = append([]KeyBinding{ActionBindingWithLabel(ActionBack, "return", KeyKindFull, "Navigation")}, full...)
+	}
+	if m.inTraceWaterfall() {
+		full = append([]KeyBinding{
+			ActionBindingWithLabel(ActionToggle, "toggle subtree", KeyKindFull, "View"),
+			PrevNextBinding("collapse/expand", KeyKindFull),
+		}, full...)
+	}
 
 	return append(quick, full...)
 }
 
+func (m Model) keymapLogVolume() []KeyBinding {
+	quick := []KeyBinding{
+		PrevNextBinding("bucket", KeyKindQuick),
+		ActionBindingWithLabel(ActionSelect, "narrow to bucket", KeyKindQuick, "Filter"),
+		ActionBindingWithLabel(ActionBack, "list", KeyKindQuick, "Navigation"),
+	}
+	full := []KeyBinding{
+		ActionBinding(ActionGoTop, KeyKindFull, "Navigation"),
+		ActionBinding(ActionGoBottom, KeyKindFull, "Navigation"),
+		ActionBinding(ActionCycleLookback, KeyKindFull, "Filter"),
+		ActionBinding(ActionQuit, KeyKindFull, "System"),
+	}
+	return append(quick, full...)
+}
+
+func (m Model) keymapFilterChips() []KeyBinding {
+	return []KeyBinding{
+		PrevNextBinding("filter", KeyKindQuick),
+		ActionBindingWithLabel(ActionSelect, "remove", KeyKindQuick, "Filter"),
+		ActionBindingWithLabel(ActionToggle, "include/exclude", KeyKindQuick, "Filter"),
+		ActionBindingWithLabel(ActionBack, "list", KeyKindQuick, "Navigation"),
+	}
+}
+
+func (m Model) keymapFieldFilter() []KeyBinding {
+	quick := []KeyBinding{
+		ScrollBinding(KeyKindQuick),
+		ActionBindingWithLabel(ActionSelect, "include", KeyKindQuick, "Filter"),
+		CombinedBinding([]string{"-"}, "exclude", KeyKindQuick, "Filter"),
+		CombinedBinding([]string{"e"}, "exists", KeyKindQuick, "Filter"),
+		ActionBindingWithLabel(ActionBack, "close", KeyKindQuick, "Navigation"),
+	}
+	full := []KeyBinding{
+		CombinedBinding([]string{"+"}, "include", KeyKindFull, "Filter"),
+		ActionBinding(ActionQuit, KeyKindFull, "System"),
+	}
+	return append(quick, full...)
+}
+
 func (m Model) keymapDetail() []KeyBinding {
 	quick := []KeyBinding{
 		ScrollBinding(KeyKindQuick),
@@ -82,8 +208,18 @@ func (m Model) keymapDetail() []KeyBinding {
 	}
 	full := DetailGlobalBindings()
 	if m.Filters.Signal == signalTraces {
-		full = append(full, ActionBinding(ActionSpans, KeyKindFull, "View"))
+		full = append(full,
+			ActionBinding(ActionSpans, KeyKindFull, "View"),
+			ActionBinding(ActionJumpLogs, KeyKindFull, "Navigation"))
+	}
+	if m.Filters.Signal == signalLogs {
+		full = append(full,
+			ActionBinding(ActionJumpTrace, KeyKindFull, "Navigation"),
+			ActionBinding(ActionContext, KeyKindFull, "Navigation"))
 	}
+	full = append(full,
+		ActionBindingWithLabel(ActionFilter, "filter by value", KeyKindFull, "Filter"),
+		ActionBinding(ActionBookmark, KeyKindFull, "View"))
 	return append(quick, full...)
 }
 
@@ -110,7 +246,11 @@ func (m Model) keymapMetricsDashboard() []KeyBinding {
 	}
 	// Full list only adds items not in quick
 	full := []KeyBinding{
+		ActionBindingWithLabel(ActionToggle, "mark", KeyKindFull, "View"),
+		ActionBinding(ActionCompare, KeyKindFull, "View"),
 		ActionBinding(ActionQuery, KeyKindFull, "View"),
+		ActionBinding(ActionViews, KeyKindFull, "View"),
+		ActionBinding(ActionBookmarks, KeyKindFull, "View"),
 		ActionBinding(ActionRefresh, KeyKindFull, "View"),
 		ActionBinding(ActionSendToChat, KeyKindFull, "AI"),
 		ActionBinding(ActionCreds, KeyKindFull, "System"),
@@ -118,6 +258,7 @@ func (m Model) keymapMetricsDashboard() []KeyBinding {
 		CombinedBinding([]string{"d"}, "documents", KeyKindFull, "View"),
 		ActionBinding(ActionQuit, KeyKindFull, "System"),
 	}
+	full = append(full, TabBindings()...)
 	return append(quick, full...)
 }
 
@@ -131,7 +272,10 @@ func (m Model) keymapMetricDetail() []KeyBinding {
 		ActionBinding(ActionKibana, KeyKindQuick, "View"),
 		ActionBinding(ActionBack, KeyKindQuick, "Navigation"),
 	}
-	full := append([]KeyBinding{ActionBinding(ActionRefresh, KeyKindFull, "View")}, GlobalBindings()...)
+	full := append([]KeyBinding{
+		ActionBinding(ActionGroupBy, KeyKindFull, "View"),
+		ActionBinding(ActionRefresh, KeyKindFull, "View"),
+	}, GlobalBindings()...)
 	return append(quick, full...)
 }
 
@@ -146,13 +290,18 @@ func (m Model) keymapTraceNames() []KeyBinding {
 		ActionBinding(ActionChat, KeyKindQuick, "AI"),
 	}
 	full := []KeyBinding{
+		ActionBinding(ActionLatency, KeyKindFull, "View"),
+		ActionBinding(ActionServiceMap, KeyKindFull, "View"),
 		ActionBinding(ActionQuery, KeyKindFull, "View"),
+		ActionBinding(ActionViews, KeyKindFull, "View"),
+		ActionBinding(ActionBookmarks, KeyKindFull, "View"),
 		ActionBinding(ActionRefresh, KeyKindFull, "View"),
 		ActionBinding(ActionSendToChat, KeyKindFull, "AI"),
 		ActionBinding(ActionCreds, KeyKindFull, "System"),
 		ActionBinding(ActionOtelConfig, KeyKindFull, "System"),
 		ActionBinding(ActionQuit, KeyKindFull, "System"),
 	}
+	full = append(full, TabBindings()...)
 	return append(quick, full...)
 }
 
@@ -172,10 +321,96 @@ func (m Model) keymapPerspectiveList() []KeyBinding {
 	return append(quick, full...)
 }
 
+func (m Model) keymapLogPatterns() []KeyBinding {
+	quick := []KeyBinding{
+		ScrollBinding(KeyKindQuick),
+		ActionBindingWithLabel(ActionSelect, "show logs", KeyKindQuick, "Filter"),
+		ActionBinding(ActionCycleLookback, KeyKindQuick, "Filter"),
+		ActionBinding(ActionKibana, KeyKindQuick, "View"),
+		ActionBinding(ActionBack, KeyKindQuick, "Navigation"),
+	}
+	full := []KeyBinding{
+		ActionBinding(ActionQuery, KeyKindFull, "View"),
+		ActionBinding(ActionRefresh, KeyKindFull, "View"),
+	}
+	full = append(full, GlobalBindingsWithQuit()...)
+	return append(quick, full...)
+}
+
+func (m Model) keymapExceptions() []KeyBinding {
+	groupBy := "group by top frame"
+	if m.Exceptions.By == es.ExceptionsByFrame {
+		groupBy = "group by message"
+	}
+	quick := []KeyBinding{
+		ScrollBinding(KeyKindQuick),
+		ActionBindingWithLabel(ActionSelect, "show occurrences", KeyKindQuick, "Filter"),
+		ActionBindingWithLabel(ActionToggle, groupBy, KeyKindQuick, "View"),
+		ActionBinding(ActionCycleLookback, KeyKindQuick, "Filter"),
+		ActionBinding(ActionBack, KeyKindQuick, "Navigation"),
+	}
+	full := []KeyBinding{
+		ActionBinding(ActionKibana, KeyKindFull, "View"),
+		ActionBinding(ActionQuery, KeyKindFull, "View"),
+		ActionBinding(ActionRefresh, KeyKindFull, "View"),
+	}
+	full = append(full, GlobalBindingsWithQuit()...)
+	return append(quick, full...)
+}
+
+func (m Model) keymapServiceMap() []KeyBinding {
+	quick := []KeyBinding{
+		ScrollBinding(KeyKindQuick),
+		ActionBindingWithLabel(ActionSelect, "filter service", KeyKindQuick, "Filter"),
+		ActionBinding(ActionCycleLookback, KeyKindQuick, "Filter"),
+		ActionBinding(ActionBack, KeyKindQuick, "Navigation"),
+	}
+	full := []KeyBinding{
+		ActionBinding(ActionQuery, KeyKindFull, "View"),
+		ActionBinding(ActionRefresh, KeyKindFull, "View"),
+	}
+	full = append(full, GlobalBindingsWithQuit()...)
+	return append(quick, full...)
+}
+
+func (m Model) keymapLatency() []KeyBinding {
+	quick := []KeyBinding{
+		ScrollBinding(KeyKindQuick),
+		ActionBindingWithLabel(ActionSelect, "example trace", KeyKindQuick, "View"),
+		ActionBinding(ActionCycleLookback, KeyKindQuick, "Filter"),
+		ActionBinding(ActionBack, KeyKindQuick, "Navigation"),
+	}
+	full := []KeyBinding{
+		ActionBinding(ActionQuery, KeyKindFull, "View"),
+		ActionBinding(ActionRefresh, KeyKindFull, "View"),
+	}
+	full = append(full, GlobalBindingsWithQuit()...)
+	return append(quick, full...)
+}
+
+func (m Model) keymapMetricCompare() []KeyBinding {
+	quick := []KeyBinding{
+		PrevNextBinding("move cursor", KeyKindQuick),
+		ActionBindingWithLabel(ActionToggle, "overlay/stacked", KeyKindQuick, "View"),
+		ActionBinding(ActionCycleLookback, KeyKindQuick, "Filter"),
+		ActionBinding(ActionBack, KeyKindQuick, "Navigation"),
+	}
+	full := []KeyBinding{
+		ActionBinding(ActionRefresh, KeyKindFull, "View"),
+	}
+	full = append(full, GlobalBindingsWithQuit()...)
+	return append(quick, full...)
+}
+
 func (m Model) keymapFields() []KeyBinding {
+	if m.Fields.Facets.Focused {
+		return m.keymapFieldFacets()
+	}
+
 	quick := []KeyBinding{
 		ScrollBinding(KeyKindQuick),
 		CombinedBinding([]string{"space", "enter"}, "toggle", KeyKindQuick, "View"),
+		ActionBindingWithLabel(ActionNextItem, "top values", KeyKindQuick, "Filter"),
 		ActionBinding(ActionSearch, KeyKindQuick, "Filter"),
 		ActionBinding(ActionReset, KeyKindQuick, "View"),
 		ActionBindingWithLabel(ActionBack, "close", KeyKindQuick, "Navigation"),
@@ -183,6 +418,83 @@ func (m Model) keymapFields() []KeyBinding {
 	return append(quick, GlobalBindings()...)
 }
 
+func (m Model) keymapFieldFacets() []KeyBinding {
+	return []KeyBinding{
+		ScrollBinding(KeyKindQuick),
+		ActionBindingWithLabel(ActionSelect, "include", KeyKindQuick, "Filter"),
+		CombinedBinding([]string{"-"}, "exclude", KeyKindQuick, "Filter"),
+		ActionBindingWithLabel(ActionPrevItem, "fields", KeyKindQuick, "Navigation"),
+		CombinedBinding([]string{"+"}, "include", KeyKindFull, "Filter"),
+	}
+}
+
+func (m Model) keymapESQLEditor() []KeyBinding {
+	if m.Editor.Naming {
+		return []KeyBinding{
+			CombinedBinding([]string{"enter"}, "save", KeyKindQuick, "Input"),
+			CombinedBinding([]string{"esc"}, "cancel", KeyKindQuick, "Input"),
+		}
+	}
+	if m.Editor.Editing {
+		quick := []KeyBinding{
+			CombinedBinding([]string{"ctrl+r"}, "run", KeyKindQuick, "Query"),
+			CombinedBinding([]string{"ctrl+p", "ctrl+n"}, "history", KeyKindQuick, "Query"),
+			CombinedBinding([]string{"esc"}, "results", KeyKindQuick, "Input"),
+		}
+		return append(quick, SystemBindings()...)
+	}
+
+	quick := []KeyBinding{
+		ScrollBinding(KeyKindQuick),
+		ActionBindingWithLabel(ActionSelect, "open row", KeyKindQuick, "View"),
+		CombinedBinding([]string{"i"}, "edit", KeyKindQuick, "Query"),
+		CombinedBinding([]string{"ctrl+r", "r"}, "run", KeyKindQuick, "Query"),
+		CombinedBinding([]string{"S"}, "save as view", KeyKindQuick, "Query"),
+		ActionBindingWithLabel(ActionBack, "close", KeyKindQuick, "Navigation"),
+	}
+	full := []KeyBinding{
+		PrevNextBinding("columns", KeyKindFull),
+		CombinedBinding([]string{"ctrl+p", "ctrl+n"}, "history", KeyKindFull, "Query"),
+		ActionBindingWithLabel(ActionCopy, "copy query", KeyKindFull, "Clipboard"),
+	}
+	return append(quick, full...)
+}
+
+func (m Model) keymapSavedViews() []KeyBinding {
+	if m.SavedViews.Naming {
+		return []KeyBinding{
+			CombinedBinding([]string{"enter"}, "save", KeyKindQuick, "Input"),
+			CombinedBinding([]string{"esc"}, "cancel", KeyKindQuick, "Input"),
+		}
+	}
+	quick := []KeyBinding{
+		ScrollBinding(KeyKindQuick),
+		ActionBindingWithLabel(ActionSelect, "open", KeyKindQuick, "View"),
+		CombinedBinding([]string{"S"}, "save current", KeyKindQuick, "View"),
+		CombinedBinding([]string{"R"}, "rename", KeyKindQuick, "View"),
+		ActionBindingWithLabel(ActionBack, "close", KeyKindQuick, "Navigation"),
+	}
+	return append(quick, SystemBindings()...)
+}
+
+func (m Model) keymapBookmarks() []KeyBinding {
+	if m.Bookmarks.Editing {
+		return []KeyBinding{
+			CombinedBinding([]string{"enter"}, "save note", KeyKindQuick, "Input"),
+			CombinedBinding([]string{"esc"}, "cancel", KeyKindQuick, "Input"),
+		}
+	}
+	quick := []KeyBinding{
+		ScrollBinding(KeyKindQuick),
+		ActionBindingWithLabel(ActionSelect, "jump to", KeyKindQuick, "View"),
+		CombinedBinding([]string{"n"}, "edit note", KeyKindQuick, "View"),
+		CombinedBinding([]string{"D"}, "delete", KeyKindQuick, "View"),
+		CombinedBinding([]string{"E"}, "export timeline", KeyKindQuick, "View"),
+		ActionBindingWithLabel(ActionBack, "close", KeyKindQuick, "Navigation"),
+	}
+	return append(quick, SystemBindings()...)
+}
+
 func (m Model) keymapErrorModal() []KeyBinding {
 	// Small set; help disabled; quick only.
 	return []KeyBinding{
@@ -0,0 +1,98 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elastic/elasticat/internal/config"
+)
+
+// Split layout limits
+const (
+	splitDefaultRatio    = 50  // Percentage of the split given to the list
+	splitMinRatio        = 20  // The list keeps at least this share...
+	splitMaxRatio        = 80  // ...and leaves the detail at least the rest
+	splitRatioStep       = 10  // Change per shrink/grow key press
+	splitSideBySideWidth = 160 // Narrower terminals stack the detail below the list
+	splitMinPaneHeight   = 3
+)
+
+// layoutFromPrefs restores the split layout saved by an earlier run.
+func layoutFromPrefs(prefs config.LayoutPrefs) LayoutState {
+	ratio := prefs.SplitRatio
+	if ratio == 0 {
+		ratio = splitDefaultRatio
+	}
+	return LayoutState{
+		Split: prefs.Split,
+		Ratio: min(max(ratio, splitMinRatio), splitMaxRatio),
+	}
+}
+
+// toggleSplit turns the split layout on or off.
+func (m *Model) toggleSplit() {
+	m.Layout = layoutFromPrefs(config.LayoutPrefs{Split: !m.Layout.Split, SplitRatio: m.Layout.Ratio})
+	m.saveLayout()
+}
+
+// resizeSplit gives the list more (positive delta) or less of the split.
+func (m *Model) resizeSplit(delta int) {
+	if !m.Layout.Split {
+		return
+	}
+	ratio := min(max(m.Layout.Ratio+delta, splitMinRatio), splitMaxRatio)
+	if ratio == m.Layout.Ratio {
+		return
+	}
+	m.Layout.Ratio = ratio
+	m.saveLayout()
+}
+
+// saveLayout keeps the split layout for the next run.
+func (m *Model) saveLayout() {
+	prefs, err := config.LoadPrefs()
+	if err == nil {
+		prefs.Layout = config.LayoutPrefs{Split: m.Layout.Split, SplitRatio: m.Layout.Ratio}
+		err = config.SavePrefs(prefs)
+	}
+	if err != nil {
+		m.UI.StatusMessage = "Layout not saved: " + err.Error()
+		m.UI.StatusTime = time.Now()
+	}
+}
+
+// splitSideBySide reports whether the detail pane goes beside the list
+// rather than below it.
+func (m Model) splitSideBySide() bool {
+	return m.UI.Width >= splitSideBySideWidth
+}
+
+// renderSplitLogs renders the list with the detail of the selected entry
+// beside or below it. The detail follows the list cursor.
+func (m Model) renderSplitLogs(height int) string {
+	list, detail := m, m
+	if m.splitSideBySide() {
+		// Each pane renders as if it had the terminal to itself
+		list.UI.Width = m.UI.Width * m.Layout.Ratio / 100
+		detail.UI.Width = m.UI.Width - list.UI.Width + 2
+		listPane := list.renderLogsPane(height)
+		return lipgloss.JoinHorizontal(lipgloss.Top, listPane,
+			detail.renderCompactDetail(lipgloss.Height(listPane)-2))
+	}
+
+	listHeight := max(height*m.Layout.Ratio/100, splitMinPaneHeight)
+	listPane := list.renderLogsPane(listHeight)
+	detailHeight := max(height-lipgloss.Height(listPane)-3, splitMinPaneHeight)
+	return listPane + "\n" + detail.renderCompactDetail(detailHeight)
+}
+
+// renderLogsPane renders the log list, or the span waterfall of a trace.
+func (m Model) renderLogsPane(height int) string {
+	if m.inTraceWaterfall() {
+		return m.renderTraceWaterfall(height)
+	}
+	return m.renderLogListWithHeight(height)
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestSplitLayout(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	m, _ := newTestModel(signalLogs, viewLogs)
+	m.UI.Width = 200
+	m.Logs = LogsState{
+		Entries:       []es.LogEntry{{Body: "first"}, {Body: "second", ServiceName: "checkout"}},
+		SelectedIndex: 1,
+	}
+
+	press(t, &m, ">") // Resizing does nothing until the split is on
+	if m.Layout.Split || m.Layout.Ratio != 0 {
+		t.Fatalf("layout changed before splitting: %+v", m.Layout)
+	}
+	press(t, &m, "|")
+	if !m.Layout.Split || m.Layout.Ratio != splitDefaultRatio {
+		t.Fatalf("after |: %+v, want split at the default ratio", m.Layout)
+	}
+	for range 5 {
+		press(t, &m, ">")
+	}
+	if m.Layout.Ratio != splitMaxRatio {
+		t.Errorf("ratio = %d, want it clamped to %d", m.Layout.Ratio, splitMaxRatio)
+	}
+	press(t, &m, "<")
+
+	prefs, err := config.LoadPrefs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (config.LayoutPrefs{Split: true, SplitRatio: splitMaxRatio - splitRatioStep}); prefs.Layout != want {
+		t.Errorf("saved layout = %+v, want %+v", prefs.Layout, want)
+	}
+	if got := layoutFromPrefs(prefs.Layout); got != m.Layout {
+		t.Errorf("restored layout = %+v, want %+v", got, m.Layout)
+	}
+
+	// The detail follows the cursor, beside the list or below it
+	for _, width := range []int{200, 100} {
+		m.UI.Width = width
+		view := m.View()
+		if !strings.Contains(view, "Message: second") {
+			t.Errorf("width %d: selected entry's detail not shown:\n%s", width, view)
+		}
+		if h := lipgloss.Height(view); h != m.UI.Height {
+			t.Errorf("width %d: view is %d lines high, want %d", width, h, m.UI.Height)
+		}
+	}
+
+	if got := layoutFromPrefs(config.LayoutPrefs{SplitRatio: 95}); got.Ratio != splitMaxRatio {
+		t.Errorf("ratio 95 restored as %d, want %d", got.Ratio, splitMaxRatio)
+	}
+}
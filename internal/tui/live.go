@@ -0,0 +1,169 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+// Live tail limits
+const (
+	liveTailPageSize = 500  // Documents asked for per poll
+	liveTailBuffer   = 2000 // Entries kept in the list; the oldest fall off
+)
+
+// canLiveTail reports whether the logs view can follow new entries, and if
+// not, why.
+func (m Model) canLiveTail() (bool, string) {
+	switch {
+	case m.Filters.Signal != signalLogs:
+		return false, "Live tail only follows logs"
+	case m.isTimeNarrowed():
+		return false, "Live tail needs the whole lookback (esc clears the narrowed window)"
+	case m.Logs.Context != nil:
+		return false, "Live tail doesn't follow the context of an entry"
+	}
+	return true, ""
+}
+
+// toggleLiveTail starts or stops following new logs. While live, each tick
+// asks only for what is newer than the entries shown and appends it.
+func (m *Model) toggleLiveTail() tea.Cmd {
+	if m.Logs.Live != nil {
+		m.stopLiveTail()
+		m.UI.StatusMessage = "Live tail off"
+		m.UI.StatusTime = time.Now()
+		return nil
+	}
+	if ok, reason := m.canLiveTail(); !ok {
+		m.UI.StatusMessage = reason
+		m.UI.StatusTime = time.Now()
+		return nil
+	}
+
+	// New entries go at the bottom
+	if !m.UI.SortAscending {
+		m.UI.SortAscending = true
+		m.Logs.Entries = slices.Clone(m.Logs.Entries)
+		slices.Reverse(m.Logs.Entries)
+		m.Logs.SelectedIndex = len(m.Logs.Entries) - 1 - m.Logs.SelectedIndex
+	}
+	m.Logs.Live = es.NewTailCursor(m.Logs.Entries, time.Now())
+	m.Logs.UserHasScrolled = false
+	m.Logs.Unseen = 0
+	*m = m.applyTailBehaviorAfterFetch()
+	m.UI.StatusMessage = "Live tail on"
+	m.UI.StatusTime = time.Now()
+	return m.fetchLiveTail()
+}
+
+func (m *Model) stopLiveTail() {
+	m.Logs.Live = nil
+	m.Logs.Unseen = 0
+}
+
+// restartLiveTail continues live tail after the list was fetched again, from
+// the newly fetched entries, or stops it if the new filters don't allow it.
+func (m *Model) restartLiveTail() {
+	if m.Logs.Live == nil {
+		return
+	}
+	if ok, _ := m.canLiveTail(); !ok || !m.UI.SortAscending {
+		m.stopLiveTail()
+		return
+	}
+	m.Logs.Live = es.NewTailCursor(m.Logs.Entries, time.Now())
+	m.Logs.Unseen = 0
+}
+
+// fetchLiveTail asks for the logs matching the current filters that sort
+// after the newest one shown, by timestamp and then _id.
+func (m *Model) fetchLiveTail() tea.Cmd {
+	after := m.Logs.Live.After()
+	query := m.Filters.Query
+	opts := es.TailOptions{
+		Size:           liveTailPageSize,
+		Service:        m.Filters.Service,
+		NegateService:  m.Filters.NegateService,
+		Resource:       m.Filters.Resource,
+		NegateResource: m.Filters.NegateResource,
+		Level:          m.Filters.Level,
+		SortAsc:        true,
+		After:          &after, // Replaces the lookback
+		TraceID:        m.Filters.TraceID,
+		SpanID:         m.Filters.SpanID,
+		Pattern:        m.Filters.Pattern,
+		Exception:      m.Filters.Exception,
+		FieldFilters:   m.Filters.FieldFilters,
+	}
+	searchFields := CollectSearchFields(m.Fields.Display)
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestLogs, m.tuiConfig.LogsTimeout)
+		defer done()
+
+		var result *es.SearchResult
+		var err error
+		if query != "" {
+			result, _, err = m.client.SearchESQL(ctx, query, es.SearchOptions{
+				Size:           opts.Size,
+				Service:        opts.Service,
+				NegateService:  opts.NegateService,
+				Resource:       opts.Resource,
+				NegateResource: opts.NegateResource,
+				Level:          opts.Level,
+				SortAsc:        true,
+				SearchFields:   searchFields,
+				After:          opts.After,
+				TraceID:        opts.TraceID,
+				SpanID:         opts.SpanID,
+				Pattern:        opts.Pattern,
+				Exception:      opts.Exception,
+				FieldFilters:   opts.FieldFilters,
+			})
+		} else {
+			result, _, err = m.client.TailESQL(ctx, opts)
+		}
+		if err != nil {
+			return liveTailMsg{err: err}
+		}
+		return liveTailMsg{logs: result.Logs}
+	}
+}
+
+// handleLiveTailMsg appends the entries not seen yet. The cursor stays on
+// the newest entry unless the user scrolled away, in which case the new
+// entries are counted until they scroll back to the bottom. A full page
+// means more are waiting, so the next one is asked for right away.
+func (m Model) handleLiveTailMsg(msg liveTailMsg) (Model, tea.Cmd) {
+	if m.handleAsyncError(msg.err) || m.Logs.Live == nil {
+		return m, nil
+	}
+	m.UI.LastRefresh = time.Now()
+
+	fresh := m.Logs.Live.Advance(msg.logs)
+	if len(fresh) == 0 {
+		return m, nil
+	}
+	var next tea.Cmd
+	if len(msg.logs) >= liveTailPageSize {
+		next = m.fetchLiveTail()
+	}
+	m.Logs.Entries = append(m.Logs.Entries, fresh...)
+	m.Logs.Total += int64(len(fresh))
+	if over := len(m.Logs.Entries) - liveTailBuffer; over > 0 {
+		m.Logs.Entries = slices.Delete(m.Logs.Entries, 0, over)
+		m.Logs.SelectedIndex = max(m.Logs.SelectedIndex-over, 0)
+	}
+
+	if m.Logs.UserHasScrolled {
+		m.Logs.Unseen += len(fresh)
+	} else {
+		m.Logs.SelectedIndex = len(m.Logs.Entries) - 1
+	}
+	return m, next
+}
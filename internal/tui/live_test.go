@@ -0,0 +1,79 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestLiveTail(t *testing.T) {
+	base := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	entry := func(body string, sec int) es.LogEntry {
+		return es.LogEntry{Body: body, Timestamp: base.Add(time.Duration(sec) * time.Second), RawJSON: `{"body":"` + body + `"}`}
+	}
+	m, _ := newTestModel(signalLogs, viewLogs)
+	m.Logs = LogsState{Entries: []es.LogEntry{entry("b", 1), entry("a", 0)}, Total: 2}
+	bodies := func() []string {
+		var out []string
+		for _, e := range m.Logs.Entries {
+			out = append(out, e.Body)
+		}
+		return out
+	}
+
+	press(t, &m, "w")
+	if m.Logs.Live == nil || !m.UI.SortAscending {
+		t.Fatal("expected live tail in oldest-first order")
+	}
+	if got := strings.Join(bodies(), ","); got != "a,b" || m.Logs.SelectedIndex != 1 {
+		t.Fatalf("entries %s, cursor %d; want a,b with the cursor on b", got, m.Logs.SelectedIndex)
+	}
+
+	// Only new entries are appended
+	var cmd tea.Cmd
+	m, cmd = m.handleLiveTailMsg(liveTailMsg{logs: []es.LogEntry{entry("b", 1), entry("c", 2), entry("d", 3)}})
+	if cmd != nil {
+		t.Error("expected a short page to wait for the next tick")
+	}
+	if got := strings.Join(bodies(), ","); got != "a,b,c,d" {
+		t.Fatalf("entries = %s, want a,b,c,d", got)
+	}
+	if m.Logs.SelectedIndex != 3 || m.Logs.Total != 4 {
+		t.Errorf("cursor %d, total %d; want the cursor pinned to d and 4 in total", m.Logs.SelectedIndex, m.Logs.Total)
+	}
+
+	// Scrolled away, the cursor stays put and the new entries are counted
+	press(t, &m, "k")
+	m, _ = m.handleLiveTailMsg(liveTailMsg{logs: []es.LogEntry{entry("d", 3), entry("e", 4)}})
+	if m.Logs.SelectedIndex != 2 || m.Logs.Unseen != 1 {
+		t.Errorf("cursor %d, unseen %d; want the cursor on c and 1 new", m.Logs.SelectedIndex, m.Logs.Unseen)
+	}
+	if !strings.Contains(m.renderStatusBar(), "1 new") {
+		t.Error("expected the status bar to show the new entries")
+	}
+	press(t, &m, "G")
+	if m.Logs.Unseen != 0 || m.Logs.UserHasScrolled {
+		t.Error("expected the cursor to follow again at the bottom")
+	}
+
+	// A full page means more are waiting
+	page := make([]es.LogEntry, liveTailPageSize)
+	for i := range page {
+		page[i] = entry(fmt.Sprintf("burst-%03d", i), 5)
+	}
+	if _, cmd := m.handleLiveTailMsg(liveTailMsg{logs: page}); cmd == nil {
+		t.Error("expected a full page to fetch the next one right away")
+	}
+
+	press(t, &m, "w")
+	if m.Logs.Live != nil {
+		t.Error("expected live tail to stop")
+	}
+}
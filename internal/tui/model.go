@@ -24,7 +24,11 @@ import (
 //   - Fields: field selection state
 //   - Metrics: dashboard and detail state
 //   - Traces: navigation hierarchy state
+//   - Patterns: log message categorization
+//   - Exceptions: exception groups of the errors view
 //   - Perspective: filtering by service/resource
+//   - Tabs: hidden tabs and their saved state
+//   - Palette: command palette input and selection
 //   - Chat: AI chat state
 //   - Creds: credentials modal state
 //   - Otel: OTel config modal state
@@ -41,20 +45,49 @@ type Model struct {
 	esUsername  string           // ES/Kibana username for Agent Builder auth
 	esPassword  string           // ES/Kibana password for Agent Builder auth
 	profileName string           // Active profile name (for feature gating)
+	keymap      *Keymap          // Key overrides from the config file (nil = defaults)
 
 	// === Embedded State ===
-	Filters     FilterState
-	UI          UIState
-	Query       QueryState
-	Logs        LogsState
-	Fields      FieldsState
-	Metrics     MetricsState
-	Traces      TracesState
-	Perspective PerspectiveState
-	Chat        ChatState
-	Creds       CredsState
-	Otel        OtelState
-	Components  UIComponents
+	Filters      FilterState
+	UI           UIState
+	Query        QueryState
+	Logs         LogsState
+	Volume       VolumeState
+	Chips        ChipsState
+	Layout       LayoutState
+	Tabs         TabsState
+	FilterPicker FilterPickerState
+	Fields       FieldsState
+	Metrics      MetricsState
+	Traces       TracesState
+	Patterns     PatternsState
+	Exceptions   ExceptionsState
+	ServiceMap   ServiceMapState
+	Latency      LatencyState
+	Compare      CompareState
+	Perspective  PerspectiveState
+	Editor       ESQLEditorState
+	SavedViews   SavedViewsState
+	Bookmarks    BookmarksState
+	Palette      PaletteState
+	Chat         ChatState
+	Creds        CredsState
+	Otel         OtelState
+	Components   UIComponents
+}
+
+// initialModeFor returns the view a signal starts in.
+func initialModeFor(signal SignalType) viewMode {
+	switch signal {
+	case SignalTraces:
+		return viewTraceNames
+	case SignalMetrics:
+		return viewMetricsDashboard
+	case SignalChat:
+		return viewChat
+	default:
+		return viewLogs
+	}
 }
 
 // Highlighter returns a Highlighter configured with the current search query
@@ -76,7 +109,11 @@ type NewModelOpts struct {
 	ESAPIKey    string
 	ESUsername  string
 	ESPassword  string
-	ProfileName string // Active profile name (for feature gating)
+	ProfileName string          // Active profile name (for feature gating)
+	View        *config.View    // Saved view to open with (catseye --view)
+	Keymap      *Keymap         // Key overrides from the config file
+	Prefs       config.Prefs    // Settings kept from the last run
+	Session     *config.Session // Where the last run was left, to restore
 }
 
 func NewModel(ctx context.Context, client DataSource, signal SignalType, tuiCfg config.TUIConfig, kibanaURL, kibanaSpace string) Model {
@@ -111,17 +148,7 @@ func NewModelWithOpts(ctx context.Context, client DataSource, signal SignalType,
 	chatVp := viewport.New(80, 15)  // Viewport for chat history
 
 	// Determine initial view mode based on signal type
-	var initialMode viewMode
-	switch signal {
-	case SignalTraces:
-		initialMode = viewTraceNames
-	case SignalMetrics:
-		initialMode = viewMetricsDashboard
-	case SignalChat:
-		initialMode = viewChat
-	default:
-		initialMode = viewLogs
-	}
+	initialMode := initialModeFor(signal)
 
 	if ctx == nil {
 		ctx = context.Background()
@@ -142,6 +169,7 @@ func NewModelWithOpts(ctx context.Context, client DataSource, signal SignalType,
 		esUsername:  opts.ESUsername,
 		esPassword:  opts.ESPassword,
 		profileName: opts.ProfileName,
+		keymap:      opts.Keymap,
 		requests:    newRequestManager(),
 
 		Filters: FilterState{
@@ -179,6 +207,14 @@ func NewModelWithOpts(ctx context.Context, client DataSource, signal SignalType,
 			ErrorViewport: errorVp,
 			HelpViewport:  helpVp,
 		},
+		Layout: layoutFromPrefs(opts.Prefs.Layout),
+	}
+	m.ensureTabs()
+
+	// Pick up where the last run was left; a saved view replaces it below
+	if opts.Session != nil && opts.View == nil {
+		m.setSession(*opts.Session)
+		initialMode = m.UI.Mode
 	}
 
 	// If we start in chat view, initialize chat state like enterChatView would.
@@ -196,5 +232,15 @@ func NewModelWithOpts(ctx context.Context, client DataSource, signal SignalType,
 		m.updateChatViewport()
 	}
 
+	// Open a saved view; Init runs its query
+	if opts.View != nil {
+		m.setSavedView(*opts.View)
+		m.UI.Mode = initialModeFor(m.Filters.Signal)
+		if opts.View.ESQL != "" {
+			m.openESQLEditorWith(opts.View.ESQL)
+			m.runESQLEditor()
+		}
+	}
+
 	return m
 }
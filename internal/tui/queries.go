@@ -11,7 +11,9 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/elastic/elasticat/internal/es"
 	"github.com/elastic/elasticat/internal/es/metrics"
+	"github.com/elastic/elasticat/internal/es/patterns"
 	"github.com/elastic/elasticat/internal/es/perspectives"
+	"github.com/elastic/elasticat/internal/es/traces"
 )
 
 type requestKind int
@@ -19,13 +21,26 @@ type requestKind int
 const (
 	requestLogs requestKind = iota
 	requestMetricsAgg
+	requestMetricsPage
 	requestMetricDetailDocs
+	requestMetricBreakdown
+	requestMetricDistribution
+	requestMetricCompare
 	requestTransactionNames
 	requestSpans
 	requestPerspective
 	requestFieldCaps
 	requestAutoDetect
 	requestChat
+	requestPatterns
+	requestServiceMap
+	requestLatency
+	requestLogVolume
+	requestFieldFacets
+	requestESQLEditor
+	requestTabUnread
+	requestBookmarkExport
+	requestExceptions
 )
 
 type requestState struct {
@@ -55,7 +70,20 @@ func (rm *requestManager) inFlight(kind requestKind) bool {
 	return ok
 }
 
+// cancel aborts an in-flight request of the given kind, if any.
+func (rm *requestManager) cancel(kind requestKind) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+	if st, ok := rm.cancels[kind]; ok {
+		st.cancel()
+		delete(rm.cancels, kind)
+	}
+}
+
 func (m *Model) fetchLogs() tea.Cmd {
+	if m.Logs.Context != nil {
+		return m.fetchContext()
+	}
 	return func() tea.Msg {
 		ctx, done := m.startRequest(requestLogs, m.tuiConfig.LogsTimeout)
 		defer done()
@@ -68,9 +96,21 @@ func (m *Model) fetchLogs() tea.Cmd {
 		lookbackRange := m.Filters.Lookback.ESRange()
 
 		// For traces, determine processor event filter based on view level
+		size := 100
 		processorEvent := ""
 		transactionName := ""
 		traceID := ""
+		spanID := ""
+		var from, to time.Time
+		if m.Filters.Signal == signalLogs {
+			traceID = m.Filters.TraceID
+			spanID = m.Filters.SpanID
+			if m.isTimeNarrowed() {
+				// The narrowed window replaces the lookback
+				from, to = m.Filters.From, m.Filters.To
+				lookbackRange = ""
+			}
+		}
 		if m.Filters.Signal == signalTraces {
 			switch m.Traces.ViewLevel {
 			case traceViewTransactions:
@@ -78,7 +118,9 @@ func (m *Model) fetchLogs() tea.Cmd {
 				transactionName = m.Traces.SelectedTxName
 			case traceViewSpans:
 				// When viewing spans, show all events for the trace (no processor filter)
+				// and fetch enough of them to build the full waterfall
 				traceID = m.Traces.SelectedTraceID
+				size = 1000
 			default:
 				processorEvent = "transaction"
 			}
@@ -86,7 +128,7 @@ func (m *Model) fetchLogs() tea.Cmd {
 
 		if m.Filters.Query != "" {
 			opts := es.SearchOptions{
-				Size:            100,
+				Size:            size,
 				Service:         m.Filters.Service,
 				NegateService:   m.Filters.NegateService,
 				Resource:        m.Filters.Resource,
@@ -95,14 +137,20 @@ func (m *Model) fetchLogs() tea.Cmd {
 				SortAsc:         m.UI.SortAscending,
 				SearchFields:    CollectSearchFields(m.Fields.Display),
 				Lookback:        lookbackRange,
+				From:            from,
+				To:              to,
 				ProcessorEvent:  processorEvent,
 				TransactionName: transactionName,
 				TraceID:         traceID,
+				SpanID:          spanID,
+				Pattern:         m.Filters.Pattern,
+				Exception:       m.Filters.Exception,
+				FieldFilters:    m.Filters.FieldFilters,
 			}
 			result, queryString, err = m.client.SearchESQL(ctx, m.Filters.Query, opts)
 		} else {
 			opts := es.TailOptions{
-				Size:            100,
+				Size:            size,
 				Service:         m.Filters.Service,
 				NegateService:   m.Filters.NegateService,
 				Resource:        m.Filters.Resource,
@@ -110,9 +158,15 @@ func (m *Model) fetchLogs() tea.Cmd {
 				Level:           m.Filters.Level,
 				SortAsc:         m.UI.SortAscending,
 				Lookback:        lookbackRange,
+				From:            from,
+				To:              to,
 				ProcessorEvent:  processorEvent,
 				TransactionName: transactionName,
 				TraceID:         traceID,
+				SpanID:          spanID,
+				Pattern:         m.Filters.Pattern,
+				Exception:       m.Filters.Exception,
+				FieldFilters:    m.Filters.FieldFilters,
 			}
 			result, queryString, err = m.client.TailESQL(ctx, opts)
 		}
@@ -125,35 +179,215 @@ func (m *Model) fetchLogs() tea.Cmd {
 	}
 }
 
+// logVolumeTarget is the preferred number of volume histogram buckets; the
+// rendered row is narrower on small terminals.
+const logVolumeTarget = 120
+
+// isTimeNarrowed reports whether the logs view is narrowed to a histogram bucket.
+func (m Model) isTimeNarrowed() bool {
+	return !m.Filters.From.IsZero()
+}
+
+// logsTimeWindow returns the time range of the logs view: the narrowed window
+// when set, otherwise the lookback ending at now.
+func (m Model) logsTimeWindow(now time.Time) (time.Time, time.Time) {
+	if m.isTimeNarrowed() {
+		return m.Filters.From, m.Filters.To
+	}
+	window := m.Filters.Lookback.Duration()
+	if window == 0 {
+		window = 24 * time.Hour // "all" queries the last 24h (see ESRange)
+	}
+	return now.Add(-window), now
+}
+
+// fetchLogVolume fetches the per-bucket log counts for the current filters and time window
+func (m *Model) fetchLogVolume() tea.Cmd {
+	from, to := m.logsTimeWindow(time.Now())
+	interval := es.VolumeInterval(to.Sub(from), m.logVolumeBuckets())
+	query := m.Filters.Query
+	opts := es.SearchOptions{
+		Service:        m.Filters.Service,
+		NegateService:  m.Filters.NegateService,
+		Resource:       m.Filters.Resource,
+		NegateResource: m.Filters.NegateResource,
+		Level:          m.Filters.Level,
+		SearchFields:   CollectSearchFields(m.Fields.Display),
+		From:           from,
+		To:             to,
+		TraceID:        m.Filters.TraceID,
+		SpanID:         m.Filters.SpanID,
+		Pattern:        m.Filters.Pattern,
+		Exception:      m.Filters.Exception,
+		FieldFilters:   m.Filters.FieldFilters,
+	}
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestLogVolume, m.tuiConfig.LogsTimeout)
+		defer done()
+
+		result, _, err := m.client.LogVolume(ctx, query, opts, interval)
+		return logVolumeMsg{result: result, err: err}
+	}
+}
+
 func (m Model) tickCmd() tea.Cmd {
 	return tea.Tick(m.tuiConfig.TickInterval, func(t time.Time) tea.Msg {
 		return tickMsg(t)
 	})
 }
 
+// metricsAggOptions returns the aggregation options for the current filters and lookback
+func (m Model) metricsAggOptions() metrics.AggregateMetricsOptions {
+	lookbackRange := m.Filters.Lookback.ESRange()
+	return metrics.AggregateMetricsOptions{
+		Lookback:       lookbackRange,
+		BucketSize:     es.LookbackToBucketInterval(lookbackRange),
+		Service:        m.Filters.Service,
+		NegateService:  m.Filters.NegateService,
+		Resource:       m.Filters.Resource,
+		NegateResource: m.Filters.NegateResource,
+	}
+}
+
+// fetchAggregatedMetrics aggregates the metrics matching the name filter from the
+// first one. As many metrics as are already loaded are refetched, so a refresh
+// keeps the pages the user has scrolled through.
 func (m Model) fetchAggregatedMetrics() tea.Cmd {
+	opts := m.metricsAggOptions()
+	opts.NameFilter = m.Metrics.NameFilter
+	if m.Metrics.Aggregated != nil && m.Metrics.Aggregated.NextOffset > metrics.DefaultMetricsPageSize {
+		opts.Limit = m.Metrics.Aggregated.NextOffset
+	}
 	return func() tea.Msg {
+		// A full fetch supersedes any page still loading
+		m.requests.cancel(requestMetricsPage)
 		ctx, done := m.startRequest(requestMetricsAgg, m.tuiConfig.MetricsTimeout)
 		defer done()
 
-		lookbackRange := m.Filters.Lookback.ESRange()
-		bucketInterval := es.LookbackToBucketInterval(lookbackRange)
+		result, err := m.client.AggregateMetrics(ctx, opts)
+		if err != nil {
+			return metricsAggMsg{err: err}
+		}
 
-		opts := metrics.AggregateMetricsOptions{
-			Lookback:       lookbackRange,
-			BucketSize:     bucketInterval,
-			Service:        m.Filters.Service,
-			NegateService:  m.Filters.NegateService,
-			Resource:       m.Filters.Resource,
-			NegateResource: m.Filters.NegateResource,
+		return metricsAggMsg{result: result}
+	}
+}
+
+// metricsPrefetchMargin is how close to the end of the loaded metrics the
+// cursor gets before the next page is fetched
+const metricsPrefetchMargin = 10
+
+// fetchMoreMetrics aggregates the next page of metrics once the cursor comes
+// within metricsPrefetchMargin of the end of the loaded list.
+func (m *Model) fetchMoreMetrics() tea.Cmd {
+	agg := m.Metrics.Aggregated
+	if agg == nil || !agg.HasMore() || m.Metrics.Loading || m.Metrics.LoadingMore {
+		return nil
+	}
+	if m.Metrics.Cursor < len(agg.Metrics)-metricsPrefetchMargin {
+		return nil
+	}
+
+	m.Metrics.LoadingMore = true
+	opts := m.metricsAggOptions()
+	opts.NameFilter = m.Metrics.NameFilter
+	opts.Offset = agg.NextOffset
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestMetricsPage, m.tuiConfig.MetricsTimeout)
+		defer done()
+
+		result, err := m.client.AggregateMetrics(ctx, opts)
+		if err != nil {
+			return metricsAggMsg{more: true, offset: opts.Offset, err: err}
 		}
 
+		return metricsAggMsg{more: true, offset: opts.Offset, result: result}
+	}
+}
+
+// fetchMetricDimensions discovers the attributes the given metric can be grouped by
+func (m Model) fetchMetricDimensions(metricName string) tea.Cmd {
+	opts := m.metricsAggOptions()
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestMetricBreakdown, m.tuiConfig.MetricsTimeout)
+		defer done()
+
+		dims, err := m.client.GetMetricDimensions(ctx, metricName, opts)
+		if err != nil {
+			return metricDimensionsMsg{metric: metricName, err: err}
+		}
+
+		return metricDimensionsMsg{metric: metricName, dims: dims}
+	}
+}
+
+// fetchMetricBreakdown aggregates a single metric split by the values of groupBy
+func (m Model) fetchMetricBreakdown(metricName, groupBy string) tea.Cmd {
+	opts := m.metricsAggOptions()
+	opts.MetricNames = []string{metricName}
+	opts.GroupBy = groupBy
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestMetricBreakdown, m.tuiConfig.MetricsTimeout)
+		defer done()
+
 		result, err := m.client.AggregateMetrics(ctx, opts)
 		if err != nil {
-			return metricsAggMsg{err: err}
+			return metricBreakdownMsg{metric: metricName, groupBy: groupBy, err: err}
 		}
 
-		return metricsAggMsg{result: result}
+		return metricBreakdownMsg{metric: metricName, groupBy: groupBy, result: result}
+	}
+}
+
+// fetchMetricCompare aggregates the compared metrics in one request, so their
+// buckets share the same time axis
+func (m Model) fetchMetricCompare() tea.Cmd {
+	opts := m.metricsAggOptions()
+	opts.MetricNames = m.Compare.Names
+	opts.Limit = len(m.Compare.Names)
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestMetricCompare, m.tuiConfig.MetricsTimeout)
+		defer done()
+
+		result, err := m.client.AggregateMetrics(ctx, opts)
+		if err != nil {
+			return metricCompareMsg{err: err}
+		}
+
+		return metricCompareMsg{result: result}
+	}
+}
+
+// metricHeatmapRows is the number of value ranges in the histogram metric heatmap
+const metricHeatmapRows = 8
+
+// fetchMetricDistribution loads the percentiles and heatmap of the detail
+// metric when it is a histogram, and clears those of the previous metric.
+func (m *Model) fetchMetricDistribution() tea.Cmd {
+	m.Metrics.DistributionMetric = ""
+	m.Metrics.Distribution = nil
+	m.Metrics.DistributionLoading = false
+	if m.Metrics.Aggregated == nil || m.Metrics.Cursor >= len(m.Metrics.Aggregated.Metrics) {
+		return nil
+	}
+	metric := m.Metrics.Aggregated.Metrics[m.Metrics.Cursor]
+	if metric.Type != "histogram" {
+		return nil
+	}
+
+	m.Metrics.DistributionMetric = metric.Name
+	m.Metrics.DistributionLoading = true
+	opts := m.metricsAggOptions()
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestMetricDistribution, m.tuiConfig.MetricsTimeout)
+		defer done()
+
+		dist, err := m.client.GetMetricDistribution(ctx, metric.Name, opts, metricHeatmapRows)
+		if err != nil {
+			return metricDistributionMsg{metric: metric.Name, err: err}
+		}
+
+		return metricDistributionMsg{metric: metric.Name, dist: dist}
 	}
 }
 
@@ -234,6 +468,123 @@ func (m Model) fetchSpans(traceID string) tea.Cmd {
 	}
 }
 
+// fetchLogPatterns categorizes log messages for the current filters
+func (m *Model) fetchLogPatterns() tea.Cmd {
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestPatterns, m.tuiConfig.LogsTimeout)
+		defer done()
+
+		opts := patterns.Options{
+			Lookback:       m.Filters.Lookback.ESRange(),
+			Service:        m.Filters.Service,
+			NegateService:  m.Filters.NegateService,
+			Resource:       m.Filters.Resource,
+			NegateResource: m.Filters.NegateResource,
+			Level:          m.Filters.Level,
+			FieldFilters:   m.Filters.FieldFilters,
+		}
+
+		result, err := m.client.GetLogPatterns(ctx, opts)
+		if err != nil {
+			return logPatternsMsg{err: err}
+		}
+
+		return logPatternsMsg{result: result}
+	}
+}
+
+// fetchExceptions groups the exceptions among the logs matching the current
+// filters for the errors view
+func (m *Model) fetchExceptions() tea.Cmd {
+	from, to := m.logsTimeWindow(time.Now())
+	query := m.Filters.Query
+	by := m.Exceptions.By
+	opts := es.SearchOptions{
+		Service:        m.Filters.Service,
+		NegateService:  m.Filters.NegateService,
+		Resource:       m.Filters.Resource,
+		NegateResource: m.Filters.NegateResource,
+		Level:          m.Filters.Level,
+		SearchFields:   CollectSearchFields(m.Fields.Display),
+		From:           from,
+		To:             to,
+		TraceID:        m.Filters.TraceID,
+		SpanID:         m.Filters.SpanID,
+		Pattern:        m.Filters.Pattern,
+		FieldFilters:   m.Filters.FieldFilters,
+	}
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestExceptions, m.tuiConfig.LogsTimeout)
+		defer done()
+
+		result, queryString, err := m.client.Exceptions(ctx, query, opts, by, exceptionSparklineWidth)
+		return exceptionsMsg{result: result, query: queryString, err: err}
+	}
+}
+
+// latencyOptions selects the transactions of the latency view with the current filters
+func (m *Model) latencyOptions() traces.LatencyHistogramOptions {
+	return traces.LatencyHistogramOptions{
+		Lookback:        m.Filters.Lookback.ESRange(),
+		TransactionName: m.Latency.Tx.Name,
+		Service:         m.Filters.Service,
+		NegateService:   m.Filters.NegateService,
+		Resource:        m.Filters.Resource,
+		NegateResource:  m.Filters.NegateResource,
+		MinDuration:     m.Latency.Tx.MinDuration,
+		MaxDuration:     m.Latency.Tx.MaxDuration,
+	}
+}
+
+// fetchLatencyHistogram fetches the duration distribution of the inspected transaction name
+func (m *Model) fetchLatencyHistogram() tea.Cmd {
+	opts := m.latencyOptions()
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestLatency, m.tuiConfig.TracesTimeout)
+		defer done()
+
+		result, err := m.client.GetLatencyHistogram(ctx, opts)
+		if err != nil {
+			return latencyHistogramMsg{err: err}
+		}
+
+		return latencyHistogramMsg{result: result}
+	}
+}
+
+// fetchExampleTrace finds a trace whose transaction falls into the given latency bucket
+func (m *Model) fetchExampleTrace(bucket traces.LatencyBucket) tea.Cmd {
+	opts := m.latencyOptions()
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestLatency, m.tuiConfig.TracesTimeout)
+		defer done()
+
+		traceID, err := m.client.GetExampleTrace(ctx, opts, bucket)
+		if err != nil {
+			return exampleTraceMsg{err: err}
+		}
+
+		return exampleTraceMsg{traceID: traceID}
+	}
+}
+
+// fetchServiceMap derives service dependencies from traces in the current lookback
+func (m *Model) fetchServiceMap() tea.Cmd {
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestServiceMap, m.tuiConfig.TracesTimeout)
+		defer done()
+
+		result, err := m.client.GetServiceMap(ctx, traces.ServiceMapOptions{
+			Lookback: m.Filters.Lookback.ESRange(),
+		})
+		if err != nil {
+			return serviceMapMsg{err: err}
+		}
+
+		return serviceMapMsg{result: result}
+	}
+}
+
 func (m *Model) fetchPerspectiveData() tea.Cmd {
 	return func() tea.Msg {
 		ctx, done := m.startRequest(requestPerspective, m.tuiConfig.LogsTimeout)
@@ -269,6 +620,10 @@ func (m *Model) fetchPerspectiveData() tea.Cmd {
 }
 
 func (m *Model) autoDetectLookback() tea.Cmd {
+	if m.Filters.LookbackPinned {
+		lookback := m.Filters.Lookback
+		return func() tea.Msg { return autoDetectMsg{lookback: lookback, pinned: true} }
+	}
 	return func() tea.Msg {
 		ctx, done := m.startRequest(requestAutoDetect, m.tuiConfig.AutoDetectTimeout)
 		defer done()
@@ -333,6 +688,39 @@ func (m *Model) fetchFieldCaps() tea.Cmd {
 	}
 }
 
+// fetchFieldFacets fetches the top values of a field for the current filters
+func (m *Model) fetchFieldFacets(field es.FieldInfo) tea.Cmd {
+	query := m.Filters.Query
+	opts := es.SearchOptions{
+		Service:        m.Filters.Service,
+		NegateService:  m.Filters.NegateService,
+		Resource:       m.Filters.Resource,
+		NegateResource: m.Filters.NegateResource,
+		Level:          m.Filters.Level,
+		SearchFields:   CollectSearchFields(m.Fields.Display),
+		Lookback:       m.Filters.Lookback.ESRange(),
+		Pattern:        m.Filters.Pattern,
+		Exception:      m.Filters.Exception,
+		FieldFilters:   m.Filters.FieldFilters,
+	}
+	if m.Filters.Signal == signalLogs {
+		opts.TraceID = m.Filters.TraceID
+		opts.SpanID = m.Filters.SpanID
+		if m.isTimeNarrowed() {
+			opts.From, opts.To = m.Filters.From, m.Filters.To
+			opts.Lookback = ""
+		}
+	}
+	numeric := es.IsNumericType(field.Type)
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestFieldFacets, m.tuiConfig.FieldCapsTimeout)
+		defer done()
+
+		result, err := m.client.GetFieldFacets(ctx, field.Name, numeric, query, opts)
+		return fieldFacetsMsg{field: field.Name, result: result, err: err}
+	}
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
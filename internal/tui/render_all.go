@@ -43,9 +43,6 @@ func (m Model) renderBase(mode viewMode) string {
 
 	switch mode {
 	case viewLogs, viewIndex, viewQuery:
-		compact := m.renderCompactDetail()
-		compactHeight := lipgloss.Height(compact)
-
 		index := ""
 		indexHeight := 0
 		if mode == viewIndex {
@@ -60,18 +57,36 @@ func (m Model) renderBase(mode viewMode) string {
 		}
 
 		// Layout:
+		// ([volume histogram] \n)?
 		// [log list]
 		// \n
 		// [compact detail]
 		// (\n [index/query])?
-		listHeight := remainingHeight - 1 - compactHeight - (boolToInt(mode == viewIndex || mode == viewQuery) * 1) - indexHeight - queryHeight
+		// The split layout puts a larger detail pane beside or below the list instead.
+		listHeight := remainingHeight - (boolToInt(mode == viewIndex || mode == viewQuery) * 1) - indexHeight - queryHeight
+		if m.showLogVolume() {
+			listHeight--
+		}
+		compact := ""
+		if !m.Layout.Split {
+			compact = m.renderCompactDetail(compactDetailHeight)
+			listHeight -= 1 + lipgloss.Height(compact)
+		}
 		if listHeight < 3 {
 			listHeight = 3
 		}
 
-		body.WriteString(m.renderLogListWithHeight(listHeight))
-		body.WriteString("\n")
-		body.WriteString(compact)
+		if m.showLogVolume() {
+			body.WriteString(m.renderLogVolume())
+			body.WriteString("\n")
+		}
+		if m.Layout.Split {
+			body.WriteString(m.renderSplitLogs(listHeight))
+		} else {
+			body.WriteString(m.renderLogsPane(listHeight))
+			body.WriteString("\n")
+			body.WriteString(compact)
+		}
 		if mode == viewIndex {
 			body.WriteString("\n")
 			body.WriteString(index)
@@ -100,8 +115,26 @@ func (m Model) renderBase(mode viewMode) string {
 		body.WriteString(m.renderMetricDetail())
 	case viewTraceNames:
 		body.WriteString(m.renderTransactionNames(remainingHeight))
+	case viewLogPatterns:
+		body.WriteString(m.renderLogPatterns(remainingHeight))
+	case viewExceptions:
+		body.WriteString(m.renderExceptions(remainingHeight))
+	case viewServiceMap:
+		body.WriteString(m.renderServiceMap(remainingHeight))
+	case viewLatency:
+		body.WriteString(m.renderLatencyHistogram(remainingHeight))
+	case viewMetricCompare:
+		body.WriteString(m.renderMetricCompare(remainingHeight))
+	case viewFieldFilter:
+		body.WriteString(m.renderFieldFilterPicker(remainingHeight))
+	case viewESQL:
+		body.WriteString(m.renderESQLEditor())
+	case viewSavedViews:
+		body.WriteString(m.renderSavedViews(remainingHeight))
+	case viewBookmarks:
+		body.WriteString(m.renderBookmarks(remainingHeight))
 	case viewPerspectiveList:
-		compact := m.renderCompactDetail()
+		compact := m.renderCompactDetail(compactDetailHeight)
 		compactHeight := lipgloss.Height(compact)
 		listHeight := remainingHeight - 1 - compactHeight
 		if listHeight < 3 {
@@ -133,6 +166,7 @@ func boolToInt(b bool) int {
 // - render_logs.go: renderLogList, renderLogListWithHeight, renderLogEntry, renderLogDetail
 // - render_metrics.go: renderMetricsDashboard, renderMetricsCompactDetail, renderMetricDetail
 // - render_traces.go: renderTransactionNames
+// - render_patterns.go: renderLogPatterns
 // - render_perspective.go: renderPerspectiveList
 // - render_detail.go: renderCompactDetail, renderDetailView, renderFieldSelector
 // - render_overlay.go: renderQueryOverlay, renderErrorModal
@@ -171,6 +205,24 @@ func (m Model) View() string {
 		return m.renderBase(m.UI.Mode)
 	case viewTraceNames:
 		return m.renderBase(m.UI.Mode)
+	case viewLogPatterns:
+		return m.renderBase(m.UI.Mode)
+	case viewExceptions:
+		return m.renderBase(m.UI.Mode)
+	case viewServiceMap:
+		return m.renderBase(m.UI.Mode)
+	case viewLatency:
+		return m.renderBase(m.UI.Mode)
+	case viewMetricCompare:
+		return m.renderBase(m.UI.Mode)
+	case viewFieldFilter:
+		return m.renderBase(m.UI.Mode)
+	case viewESQL:
+		return m.renderBase(m.UI.Mode)
+	case viewSavedViews:
+		return m.renderBase(m.UI.Mode)
+	case viewBookmarks:
+		return m.renderBase(m.UI.Mode)
 	case viewPerspectiveList:
 		return m.renderBase(m.UI.Mode)
 	case viewChat:
@@ -202,6 +254,15 @@ func (m Model) View() string {
 			m.renderHelpOverlay(),
 		)
 		return overlayCenter(base, modal, m.UI.Width, m.UI.Height)
+	case viewPalette:
+		// Render previous mode as background, then overlay the command palette
+		base := m.renderBase(m.peekViewStack())
+		modal := lipgloss.Place(
+			m.UI.Width, m.UI.Height,
+			lipgloss.Center, lipgloss.Center,
+			m.renderPalette(),
+		)
+		return overlayCenter(base, modal, m.UI.Width, m.UI.Height)
 	case viewCredsModal:
 		// Render previous mode as background, then overlay credentials modal
 		base := m.renderBase(m.peekViewStack())
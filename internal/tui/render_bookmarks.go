@@ -0,0 +1,113 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+// bookmarkTimeFormat shows bookmark times to the millisecond, as documents
+// of one incident are often close together.
+const bookmarkTimeFormat = "2006-01-02 15:04:05.000"
+
+// renderBookmarks lists the bookmarks in timeline order with their notes.
+func (m Model) renderBookmarks(listHeight int) string {
+	bookmarks := m.Bookmarks.Bookmarks
+	var lines []string
+
+	lines = append(lines, HeaderRowStyle.Render(PadOrTruncate(
+		fmt.Sprintf("Bookmarks (%d)", len(bookmarks)), m.UI.Width-8)))
+
+	// Leave room for the header lines and the prompt or hint below the list
+	rowsHeight := listHeight - 4
+	switch {
+	case m.Bookmarks.Err != nil:
+		lines = append(lines, ErrorStyle.Render(fmt.Sprintf("Error: %v", m.Bookmarks.Err)))
+	case len(bookmarks) == 0:
+		lines = append(lines, LoadingStyle.Render("No bookmarks yet. Press b on a log or span to bookmark it."))
+	default:
+		// TIME (23) | SIGNAL (6) | SERVICE (16) | NOTE (24) | DOCUMENT (flex)
+		timeWidth, signalWidth, serviceWidth, noteWidth := 23, 6, 16, 24
+		docWidth := m.UI.Width - timeWidth - signalWidth - serviceWidth - noteWidth - 14
+		if docWidth < 10 {
+			docWidth = 10
+		}
+		lines = append(lines, HeaderRowStyle.Render(
+			PadOrTruncate("TIME", timeWidth)+" "+
+				PadOrTruncate("SIGNAL", signalWidth)+" "+
+				PadOrTruncate("SERVICE", serviceWidth)+" "+
+				PadOrTruncate("NOTE", noteWidth)+" "+
+				PadOrTruncate("DOCUMENT", docWidth)))
+
+		startIdx, endIdx := calcVisibleRange(m.Bookmarks.Cursor, len(bookmarks), rowsHeight)
+		for i := startIdx; i < endIdx; i++ {
+			b := bookmarks[i]
+			line := PadOrTruncate(b.Timestamp.Local().Format(bookmarkTimeFormat), timeWidth) + " " +
+				PadOrTruncate(b.Signal, signalWidth) + " " +
+				PadOrTruncate(b.Service, serviceWidth) + " " +
+				PadOrTruncate(b.Note, noteWidth) + " " +
+				PadOrTruncate(b.Summary, docWidth)
+			if i == m.Bookmarks.Cursor {
+				lines = append(lines, SelectedLogStyle.Width(m.UI.Width-6).Render(line))
+			} else {
+				lines = append(lines, LogEntryStyle.Render(line))
+			}
+		}
+	}
+
+	lines = append(lines, "")
+	switch {
+	case m.Bookmarks.Editing:
+		lines = append(lines, StatusKeyStyle.Render("Note: ")+m.Bookmarks.NoteInput.View())
+	case m.Bookmarks.Exporting:
+		lines = append(lines, LoadingStyle.Render("Exporting timeline..."))
+	default:
+		lines = append(lines, DetailMutedStyle.Render("enter to jump to • n to edit the note • D to delete • E to export a Markdown timeline • esc to close"))
+	}
+
+	return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(strings.Join(lines, "\n"))
+}
+
+// renderBookmarkTimeline renders bookmarks as a Markdown incident timeline.
+// Each document's fields are laid out as in the detail view; doc returns
+// the document of a bookmark, if it still exists.
+func (m Model) renderBookmarkTimeline(bookmarks []config.Bookmark, doc func(config.Bookmark) (es.LogEntry, bool), now time.Time) string {
+	var b strings.Builder
+	b.WriteString("# Incident timeline\n\n")
+	fmt.Fprintf(&b, "Exported from elasticat on %s, %d bookmarks.\n", now.Format(time.RFC1123), len(bookmarks))
+
+	for _, bm := range bookmarks {
+		b.WriteString("\n## " + bm.Timestamp.Format(bookmarkTimeFormat+" MST"))
+		for _, part := range []string{bm.Service, bm.Signal} {
+			if part != "" {
+				b.WriteString(" · " + part)
+			}
+		}
+		b.WriteString("\n\n")
+		if bm.Note != "" {
+			b.WriteString("> " + bm.Note + "\n\n")
+		}
+
+		entry, ok := doc(bm)
+		if !ok {
+			fmt.Fprintf(&b, "%s\n\n_Document %s no longer found in %s._\n", bm.Summary, bm.ID, bm.Index)
+			continue
+		}
+		// The detail view lays out fields by signal, without search highlights
+		detail := m
+		detail.Filters = FilterState{Signal: signalLogs}
+		if s, ok := signalFromViewName(bm.Signal); ok {
+			detail.Filters.Signal = s
+		}
+		detail.Traces = TracesState{}
+		fmt.Fprintf(&b, "```\n%s\n```\n", strings.TrimSpace(ansi.Strip(detail.renderLogDetail(entry))))
+	}
+	return b.String()
+}
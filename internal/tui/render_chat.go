@@ -11,47 +11,59 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// Chat styles
+// Chat styles, built from the active theme by buildChatStyles
 var (
+	chatUserStyle                lipgloss.Style
+	chatAssistantStyle           lipgloss.Style
+	chatErrorStyle               lipgloss.Style
+	chatMessageStyle             lipgloss.Style
+	chatTimestampStyle           lipgloss.Style
+	chatLoadingStyle             lipgloss.Style
+	chatInputBorderStyle         lipgloss.Style
+	chatInputBorderInactiveStyle lipgloss.Style
+	chatTitleStyle               lipgloss.Style
+)
+
+func buildChatStyles(t Theme) {
 	chatUserStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("86")).
-			Bold(true)
+		Foreground(t.Info).
+		Bold(true)
 
 	chatAssistantStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("212")).
-				Bold(true)
+		Foreground(t.Accent).
+		Bold(true)
 
 	chatErrorStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
-			Bold(true)
+		Foreground(t.Levels.Error).
+		Bold(true)
 
 	chatMessageStyle = lipgloss.NewStyle().
-				PaddingLeft(2)
+		PaddingLeft(2)
 
 	chatTimestampStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("240")).
-				Italic(true)
+		Foreground(t.Muted).
+		Italic(true)
 
 	chatLoadingStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("214")).
-				Italic(true)
+		Foreground(t.Levels.Warn).
+		Italic(true)
 
 	chatInputBorderStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(lipgloss.Color("62")).
-				Padding(0, 1)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Accent).
+		Padding(0, 1)
 
 	chatInputBorderInactiveStyle = lipgloss.NewStyle().
-					Border(lipgloss.RoundedBorder()).
-					BorderForeground(lipgloss.Color("240")). // Gray border when inactive
-					Padding(0, 1)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Muted). // Gray border when inactive
+		Padding(0, 1)
 
 	chatTitleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("86")).
-			Background(lipgloss.Color("235")).
-			Padding(0, 1)
-)
+		Bold(true).
+		Foreground(t.Accent).
+		Background(t.StatusBar).
+		Padding(0, 1)
+}
 
 // renderChatView renders the full chat interface.
 func (m Model) renderChatView(height int) string {
@@ -103,7 +115,7 @@ func (m Model) renderChatView(height int) string {
 		} else {
 			// Inactive: gray border, hint to activate
 			inactiveHint := lipgloss.NewStyle().
-				Foreground(lipgloss.Color("240")).
+				Foreground(mutedColor).
 				Italic(true).
 				Render("Press 'i' or [Enter] to type...")
 			inputBox = chatInputBorderInactiveStyle.Width(m.UI.Width - 6).Render(inactiveHint)
@@ -0,0 +1,207 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// comparePlot is one series drawn on the comparison time axis
+type comparePlot struct {
+	values map[time.Time]float64
+	lo, hi float64 // Value range mapped onto the chart height
+	style  int     // Index into SeriesStyles
+}
+
+// renderMetricCompare draws the compared metrics on a shared time axis, either
+// overlaid (each normalized to its own range) or stacked as small multiples,
+// followed by every metric's value at the cursor.
+func (m Model) renderMetricCompare(listHeight int) string {
+	if m.Compare.Loading && len(m.Compare.Metrics) == 0 {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			LoadingStyle.Render("Loading metrics..."))
+	}
+
+	if m.UI.Err != nil {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			ErrorStyle.Render(fmt.Sprintf("Error: %v", m.UI.Err)))
+	}
+
+	if len(m.Compare.Times) == 0 {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			LoadingStyle.Render("No data for the marked metrics in the selected time range."))
+	}
+
+	yLabelWidth := 10
+	chartWidth := m.UI.Width - yLabelWidth - 10
+	if chartWidth < 10 {
+		chartWidth = 10
+	}
+
+	plots := make([]comparePlot, len(m.Compare.Metrics))
+	for i, metric := range m.Compare.Metrics {
+		p := comparePlot{values: make(map[time.Time]float64, len(metric.Buckets)), style: i}
+		for j, b := range metric.Buckets {
+			p.values[b.Timestamp] = b.Value
+			if j == 0 || b.Value < p.lo {
+				p.lo = b.Value
+			}
+			if j == 0 || b.Value > p.hi {
+				p.hi = b.Value
+			}
+		}
+		plots[i] = p
+	}
+
+	var b strings.Builder
+	layout := "overlay, each metric scaled to its own range"
+	if m.Compare.Stacked {
+		layout = "stacked"
+	}
+	summary := fmt.Sprintf("Comparing %d metrics (%s)", len(m.Compare.Metrics), layout)
+	if m.Compare.Loading {
+		summary += "  (loading...)"
+	}
+	b.WriteString(HeaderRowStyle.Render(PadOrTruncate(summary, m.UI.Width-8)))
+	b.WriteString("\n")
+
+	// Header, axis, time and cursor labels, blank line, readout header and rows
+	chartRows := listHeight - 7 - len(plots)
+	if m.Compare.Stacked {
+		// One title row per metric
+		height := chartRows/len(plots) - 1
+		if height < 3 {
+			height = 3
+		}
+		for i, p := range plots {
+			b.WriteString(seriesStyle(i).Render(PadOrTruncate(m.Compare.Metrics[i].ShortName, m.UI.Width-8)))
+			b.WriteString("\n")
+			lo, hi := p.lo, p.hi
+			m.plotCompareRows(&b, []comparePlot{p}, height, chartWidth, yLabelWidth, func(frac float64) string {
+				return formatMetricValue(lo + frac*(hi-lo))
+			})
+		}
+	} else {
+		if chartRows < 5 {
+			chartRows = 5
+		}
+		m.plotCompareRows(&b, plots, chartRows, chartWidth, yLabelWidth, func(frac float64) string {
+			return fmt.Sprintf("%.0f%%", frac*100)
+		})
+	}
+
+	// Shared X axis with the cursor position
+	times := m.Compare.Times
+	cursorCol := compareCursorColumn(m.Compare.Cursor, len(times), chartWidth)
+	b.WriteString(strings.Repeat(" ", yLabelWidth))
+	b.WriteString(" └")
+	b.WriteString(strings.Repeat("─", chartWidth))
+	b.WriteString("\n")
+	startTime := times[0].Format("15:04:05")
+	endTime := times[len(times)-1].Format("15:04:05")
+	padding := chartWidth - len(startTime) - len(endTime)
+	if padding < 0 {
+		padding = 0
+	}
+	b.WriteString(strings.Repeat(" ", yLabelWidth+2))
+	b.WriteString(DetailMutedStyle.Render(startTime))
+	b.WriteString(strings.Repeat(" ", padding))
+	b.WriteString(DetailMutedStyle.Render(endTime))
+	b.WriteString("\n")
+	cursorTime := times[m.Compare.Cursor].Format("15:04:05")
+	markerCol := cursorCol
+	if markerCol > chartWidth-len(cursorTime)-2 {
+		markerCol = chartWidth - len(cursorTime) - 2
+	}
+	if markerCol < 0 {
+		markerCol = 0
+	}
+	b.WriteString(strings.Repeat(" ", yLabelWidth+2+markerCol))
+	b.WriteString(DetailValueStyle.Render("▲ " + cursorTime))
+	b.WriteString("\n\n")
+
+	// Readout: every metric's value at the cursor
+	at := times[m.Compare.Cursor]
+	nameWidth := m.UI.Width / 2
+	for i, metric := range m.Compare.Metrics {
+		value := "-"
+		if v, ok := plots[i].values[at]; ok {
+			value = formatMetricValue(v) + rateUnit(metric)
+		}
+		b.WriteString(seriesStyle(i).Render("██ "))
+		b.WriteString(DetailValueStyle.Render(PadOrTruncate(metric.ShortName, nameWidth)))
+		b.WriteString(DetailValueStyle.Render(PadLeft(value, 12)))
+		b.WriteString(DetailMutedStyle.Render(fmt.Sprintf("  range %s to %s",
+			formatMetricValue(plots[i].lo), formatMetricValue(plots[i].hi))))
+		if i < len(m.Compare.Metrics)-1 {
+			b.WriteString("\n")
+		}
+	}
+
+	return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(b.String())
+}
+
+// plotCompareRows draws height chart rows on the comparison time axis. Each
+// series is scaled between its own lo and hi, the first series listed wins
+// where points overlap, and the cursor column is marked.
+func (m Model) plotCompareRows(b *strings.Builder, plots []comparePlot, height, chartWidth, yLabelWidth int, label func(frac float64) string) {
+	times := m.Compare.Times
+	n := len(times)
+	cursorCol := compareCursorColumn(m.Compare.Cursor, n, chartWidth)
+
+	// Row each series occupies in each column (-1 = no data)
+	rows := make([][]int, len(plots))
+	for i, p := range plots {
+		rows[i] = make([]int, chartWidth)
+		for col := 0; col < chartWidth; col++ {
+			v, ok := p.values[times[col*n/chartWidth]]
+			switch {
+			case !ok:
+				rows[i][col] = -1
+			case p.hi == p.lo:
+				rows[i][col] = (height - 1) / 2 // Constant series sit mid-height
+			default:
+				rows[i][col] = int((v - p.lo) / (p.hi - p.lo) * float64(height-1))
+			}
+		}
+	}
+
+	for row := height - 1; row >= 0; row-- {
+		frac := 0.0
+		if height > 1 {
+			frac = float64(row) / float64(height-1)
+		}
+		b.WriteString(DetailMutedStyle.Render(PadLeft(label(frac), yLabelWidth)))
+		b.WriteString(" │")
+		for col := 0; col < chartWidth; col++ {
+			cell := " "
+			if col == cursorCol {
+				cell = DetailMutedStyle.Render("┊")
+			}
+			for i := range plots {
+				if rows[i][col] == row {
+					cell = seriesStyle(plots[i].style).Render("•")
+					break
+				}
+			}
+			b.WriteString(cell)
+		}
+		b.WriteString("\n")
+	}
+}
+
+// compareCursorColumn returns the first chart column showing the cursor's
+// timestamp when n timestamps are spread over chartWidth columns
+func compareCursorColumn(cursor, n, chartWidth int) int {
+	if n == 0 {
+		return 0
+	}
+	col := (cursor*chartWidth + n - 1) / n
+	if col >= chartWidth {
+		col = chartWidth - 1
+	}
+	return col
+}
@@ -13,10 +13,14 @@ import (
 	"github.com/elastic/elasticat/internal/es"
 )
 
-// renderCompactDetail renders a compact detail view of the selected log at the bottom
-func (m Model) renderCompactDetail() string {
+// renderCompactDetail renders a compact detail view of the selected log, at
+// the bottom of the list or, given more height, in the split layout's pane
+func (m Model) renderCompactDetail(height int) string {
 	if len(m.Logs.Entries) == 0 || m.Logs.SelectedIndex >= len(m.Logs.Entries) {
-		return CompactDetailStyle.Width(m.UI.Width - 4).Height(4).Render(
+		if height <= compactDetailHeight {
+			height = 4
+		}
+		return CompactDetailStyle.Width(m.UI.Width - 4).Height(height).Render(
 			DetailMutedStyle.Render("No entry selected"),
 		)
 	}
@@ -24,15 +28,34 @@ func (m Model) renderCompactDetail() string {
 	log := m.Logs.Entries[m.Logs.SelectedIndex]
 	switch m.Filters.Signal {
 	case signalTraces:
-		return m.renderCompactDetailTraces(log)
+		return m.renderCompactDetailTraces(log, height)
 	case signalMetrics:
-		return m.renderCompactDetailMetrics(log)
+		return m.renderCompactDetailMetrics(log, height)
 	default:
-		return m.renderCompactDetailLogs(log)
+		return m.renderCompactDetailLogs(log, height)
+	}
+}
+
+// compactDetailBox frames compact detail content. Panes taller than the
+// default clip what doesn't fit rather than growing.
+func (m Model) compactDetailBox(content string, height int) string {
+	style := CompactDetailStyle.Width(m.UI.Width - 4).Height(height)
+	if height > compactDetailHeight {
+		style = style.MaxHeight(height + 2)
+	}
+	return style.Render(content)
+}
+
+// previewItems scales the number of key/value pairs previewed with the
+// height of the detail pane.
+func previewItems(base, height int) int {
+	if height <= compactDetailHeight {
+		return base
 	}
+	return base * height / compactDetailHeight
 }
 
-func (m Model) renderCompactDetailLogs(log es.LogEntry) string {
+func (m Model) renderCompactDetailLogs(log es.LogEntry, height int) string {
 	hl := m.Highlighter()
 	var b strings.Builder
 
@@ -56,14 +79,14 @@ func (m Model) renderCompactDetailLogs(log es.LogEntry) string {
 
 	if len(log.Attributes) > 0 {
 		b.WriteString(DetailKeyStyle.Render("Attrs: "))
-		attrs := formatKVPreview(log.Attributes, 5, 0)
+		attrs := formatKVPreview(log.Attributes, previewItems(5, height), 0)
 		b.WriteString(hl.ApplyToField(attrs, DetailMutedStyle))
 	}
 
-	return CompactDetailStyle.Width(m.UI.Width - 4).Height(compactDetailHeight).Render(b.String())
+	return m.compactDetailBox(b.String(), height)
 }
 
-func (m Model) renderCompactDetailTraces(log es.LogEntry) string {
+func (m Model) renderCompactDetailTraces(log es.LogEntry, height int) string {
 	hl := m.Highlighter()
 	var b strings.Builder
 
@@ -118,7 +141,7 @@ func (m Model) renderCompactDetailTraces(log es.LogEntry) string {
 		b.WriteString(DetailKeyStyle.Render(fmt.Sprintf("Spans (%d): ", len(m.Traces.Spans))))
 		spanNames := []string{}
 		for i, span := range m.Traces.Spans {
-			if i >= 5 {
+			if i >= previewItems(5, height) {
 				spanNames = append(spanNames, "…")
 				break
 			}
@@ -138,10 +161,10 @@ func (m Model) renderCompactDetailTraces(log es.LogEntry) string {
 		b.WriteString(DetailMutedStyle.Render("No child spans"))
 	}
 
-	return CompactDetailStyle.Width(m.UI.Width - 4).Height(compactDetailHeight).Render(b.String())
+	return m.compactDetailBox(b.String(), height)
 }
 
-func (m Model) renderCompactDetailMetrics(log es.LogEntry) string {
+func (m Model) renderCompactDetailMetrics(log es.LogEntry, height int) string {
 	hl := m.Highlighter()
 	var b strings.Builder
 
@@ -156,7 +179,7 @@ func (m Model) renderCompactDetailMetrics(log es.LogEntry) string {
 	b.WriteString("\n")
 	b.WriteString(DetailKeyStyle.Render("Metrics: "))
 	if len(log.Metrics) > 0 {
-		metricsStr := formatKVPreview(log.Metrics, 8, 0)
+		metricsStr := formatKVPreview(log.Metrics, previewItems(8, height), 0)
 		b.WriteString(hl.ApplyToField(metricsStr, DetailValueStyle))
 	} else {
 		b.WriteString(DetailMutedStyle.Render("No metrics data"))
@@ -165,11 +188,11 @@ func (m Model) renderCompactDetailMetrics(log es.LogEntry) string {
 
 	if len(log.Attributes) > 0 {
 		b.WriteString(DetailKeyStyle.Render("Attrs: "))
-		attrs := formatKVPreview(log.Attributes, 5, 0)
+		attrs := formatKVPreview(log.Attributes, previewItems(5, height), 0)
 		b.WriteString(hl.ApplyToField(attrs, DetailMutedStyle))
 	}
 
-	return CompactDetailStyle.Width(m.UI.Width - 4).Height(compactDetailHeight).Render(b.String())
+	return m.compactDetailBox(b.String(), height)
 }
 
 func (m Model) writeBaseHeader(b *strings.Builder, log es.LogEntry, hl *Highlighter, appendExtras func()) {
@@ -250,7 +273,7 @@ func (m Model) renderDetailView() string {
 
 	// Show status message if recent (within 2 seconds)
 	if m.UI.StatusMessage != "" && time.Since(m.UI.StatusTime) < 2*time.Second {
-		parts = append(parts, lipgloss.NewStyle().Foreground(lipgloss.Color("#04B575")).Bold(true).Render(m.UI.StatusMessage))
+		parts = append(parts, lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(m.UI.StatusMessage))
 	}
 
 	header := strings.Join(parts, "  ")
@@ -277,7 +300,11 @@ func (m Model) renderFieldSelector() string {
 	}
 	b.WriteString(QueryHeaderStyle.Render(header))
 	b.WriteString("\n")
-	b.WriteString(DetailMutedStyle.Render("Space/Enter to toggle • / to search • r to reset • ESC to close"))
+	if m.Fields.Facets.Focused {
+		b.WriteString(DetailMutedStyle.Render("↑/↓ to select a value • Enter/+ to include • - to exclude • ← to go back"))
+	} else {
+		b.WriteString(DetailMutedStyle.Render("Space/Enter to toggle • → for top values • / to search • r to reset • ESC to close"))
+	}
 	b.WriteString("\n\n")
 
 	contentHeight := m.getFullScreenHeight()
@@ -307,6 +334,9 @@ func (m Model) renderFieldSelector() string {
 
 	// Calculate visible range (account for header and instructions)
 	visibleHeight := contentHeight - 4
+	if m.showFieldFacets() {
+		visibleHeight -= facetPanelHeight
+	}
 	if visibleHeight < 5 {
 		visibleHeight = 5
 	}
@@ -368,5 +398,10 @@ func (m Model) renderFieldSelector() string {
 		b.WriteString(DetailMutedStyle.Render(fmt.Sprintf("\n%d/%d fields", m.Fields.Cursor+1, len(sortedFields))))
 	}
 
+	if m.showFieldFacets() {
+		b.WriteString("\n")
+		b.WriteString(m.renderFieldFacets(m.UI.Width - 8))
+	}
+
 	return DetailStyle.Width(m.UI.Width - 4).Height(contentHeight).Render(b.String())
 }
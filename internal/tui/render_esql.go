@@ -0,0 +1,217 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/elasticat/internal/es"
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+// esqlMaxColumnWidth caps the width of a result table column.
+const esqlMaxColumnWidth = 40
+
+// renderESQLEditor renders the query editor above a table of whatever
+// columns the last run returned.
+func (m Model) renderESQLEditor() string {
+	contentHeight := m.getFullScreenHeight()
+	width := m.UI.Width - 8
+	var lines []string
+
+	header := QueryHeaderStyle.Render("ES|QL")
+	switch {
+	case m.Editor.Running:
+		header += "  " + LoadingStyle.Render("running...")
+	case m.Editor.Err != nil:
+		header += "  " + ErrorStyle.Render("query failed")
+	case m.Editor.Result != nil:
+		header += "  " + DetailMutedStyle.Render(fmt.Sprintf("%d rows, %d columns in %dms",
+			len(m.Editor.Result.Values), len(m.Editor.Result.Columns), m.Editor.Result.Took))
+	}
+	lines = append(lines, header, "")
+
+	if m.Editor.Editing {
+		lines = append(lines, strings.Split(m.Editor.Input.View(), "\n")...)
+	} else {
+		lines = append(lines, m.renderESQLQueryText()...)
+	}
+
+	if msg := m.esqlEditorErrorText(); msg != "" {
+		lines = append(lines, ErrorStyle.Render(TruncateWithEllipsis(msg, width)))
+	}
+
+	switch {
+	case m.Editor.Naming:
+		lines = append(lines, "", StatusKeyStyle.Render("Save as view: ")+m.Editor.NameInput.View())
+	case m.Editor.Editing:
+		lines = append(lines, DetailMutedStyle.Render("ctrl+r to run • ctrl+p/ctrl+n for history • esc for results"))
+	default:
+		lines = append(lines, DetailMutedStyle.Render("i to edit • ctrl+r to run • enter to open a row • ←/→ columns • S to save as view • esc to close"))
+	}
+	lines = append(lines, "")
+
+	tableHeight := contentHeight - 4 - len(lines)
+	if tableHeight < 3 {
+		tableHeight = 3
+	}
+	lines = append(lines, m.renderESQLResultTable(width, tableHeight)...)
+
+	return DetailStyle.Width(m.UI.Width - 4).Height(contentHeight).Render(strings.Join(lines, "\n"))
+}
+
+// renderESQLQueryText shows the query read-only, marking the position of the
+// reported error when it belongs to the query as written.
+func (m Model) renderESQLQueryText() []string {
+	text := m.Editor.Input.Value()
+	if strings.TrimSpace(text) == "" {
+		return []string{DetailMutedStyle.Render("(empty query, press i to edit)")}
+	}
+
+	errLine, errCol := 0, 0
+	if qerr, ok := shared.IsESQLQueryError(m.Editor.Err); ok && m.Editor.Ran == strings.TrimSpace(text) {
+		errLine, errCol = qerr.Line, qerr.Column
+	}
+
+	var out []string
+	for i, line := range strings.Split(text, "\n") {
+		if i+1 != errLine {
+			out = append(out, QueryBodyStyle.Render(line))
+			continue
+		}
+		runes := []rune(line)
+		col := min(max(errCol-1, 0), len(runes))
+		mark := " "
+		rest := ""
+		if col < len(runes) {
+			mark = string(runes[col])
+			rest = string(runes[col+1:])
+		}
+		out = append(out, QueryBodyStyle.Render(string(runes[:col]))+
+			ErrorStyle.Reverse(true).Render(mark)+QueryBodyStyle.Render(rest))
+	}
+	return out
+}
+
+// esqlEditorErrorText describes the last failure, led by its position when known.
+func (m Model) esqlEditorErrorText() string {
+	if m.Editor.Err == nil {
+		return ""
+	}
+	if qerr, ok := shared.IsESQLQueryError(m.Editor.Err); ok && qerr.Message != "" {
+		if qerr.Line > 0 {
+			return fmt.Sprintf("line %d:%d: %s", qerr.Line, qerr.Column, qerr.Message)
+		}
+		return qerr.Message
+	}
+	return singleLine(m.Editor.Err.Error())
+}
+
+// renderESQLResultTable lays the result out with one column per returned
+// column, starting from the horizontally scrolled-to column.
+func (m Model) renderESQLResultTable(width, height int) []string {
+	res := m.Editor.Result
+	if res == nil {
+		return []string{DetailMutedStyle.Render("Run the query to see results.")}
+	}
+	if len(res.Columns) == 0 {
+		return []string{DetailMutedStyle.Render("The query returned no columns.")}
+	}
+
+	widths := esqlColumnWidths(res)
+	var cols []int
+	used := 0
+	for i := m.Editor.ColOffset; i < len(res.Columns); i++ {
+		if len(cols) > 0 && used+widths[i] > width {
+			break
+		}
+		cols = append(cols, i)
+		used += widths[i] + 1
+	}
+
+	row := func(cell func(col int) string) string {
+		parts := make([]string, 0, len(cols))
+		for _, c := range cols {
+			parts = append(parts, PadOrTruncate(cell(c), widths[c]))
+		}
+		return strings.Join(parts, " ")
+	}
+
+	lines := []string{HeaderRowStyle.Render(row(func(c int) string { return res.Columns[c].Name }))}
+	if len(res.Values) == 0 {
+		return append(lines, DetailMutedStyle.Render("No rows."))
+	}
+
+	startIdx, endIdx := calcVisibleRange(m.Editor.Row, len(res.Values), height)
+	for i := startIdx; i < endIdx; i++ {
+		values := res.Values[i]
+		line := row(func(c int) string {
+			if c >= len(values) {
+				return ""
+			}
+			return formatESQLValue(values[c])
+		})
+		if i == m.Editor.Row && !m.Editor.Editing {
+			lines = append(lines, SelectedLogStyle.Render(line))
+		} else {
+			lines = append(lines, LogEntryStyle.Render(line))
+		}
+	}
+
+	if hidden := len(res.Columns) - len(cols); hidden > 0 || m.Editor.ColOffset > 0 {
+		lines = append(lines, DetailMutedStyle.Render(fmt.Sprintf("columns %d-%d of %d",
+			m.Editor.ColOffset+1, m.Editor.ColOffset+len(cols), len(res.Columns))))
+	}
+	return lines
+}
+
+// esqlColumnWidths sizes each column to its name and widest value, capped.
+func esqlColumnWidths(res *es.ESQLResult) []int {
+	widths := make([]int, len(res.Columns))
+	for i, col := range res.Columns {
+		widths[i] = len(col.Name)
+	}
+	for _, row := range res.Values {
+		for i := range res.Columns {
+			if i < len(row) {
+				widths[i] = max(widths[i], len([]rune(formatESQLValue(row[i]))))
+			}
+		}
+	}
+	for i := range widths {
+		widths[i] = min(max(widths[i], 4), esqlMaxColumnWidth)
+	}
+	return widths
+}
+
+// formatESQLValue renders a result cell on a single line.
+func formatESQLValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "-"
+	case string:
+		return singleLine(val)
+	case float64:
+		if val == float64(int64(val)) {
+			return strconv.FormatInt(int64(val), 10)
+		}
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	case []interface{}:
+		parts := make([]string, 0, len(val))
+		for _, item := range val {
+			parts = append(parts, formatESQLValue(item))
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	default:
+		if b, err := json.Marshal(val); err == nil {
+			return string(b)
+		}
+		return fmt.Sprint(val)
+	}
+}
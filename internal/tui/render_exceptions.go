@@ -0,0 +1,97 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elastic/elasticat/internal/es"
+)
+
+// exceptionSparklineWidth is the width of the TREND column of the errors
+// view, one column per time bucket.
+const exceptionSparklineWidth = 20
+
+func (m Model) renderExceptions(listHeight int) string {
+	if m.Exceptions.Loading {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			LoadingStyle.Render("Grouping exceptions..."))
+	}
+
+	if m.UI.Err != nil {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			ErrorStyle.Render(fmt.Sprintf("Error: %v", m.UI.Err)))
+	}
+
+	groups := m.exceptionGroups()
+	if len(groups) == 0 {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			LoadingStyle.Render("No exceptions (exception.type) found in the selected time range."))
+	}
+
+	// Calculate column widths
+	// EXCEPTION (flex) | COUNT (8) | TREND (20) | SERVICES (20) | FIRST SEEN (10) | LAST SEEN (10)
+	countWidth := 8
+	servicesWidth := 20
+	seenWidth := 10
+	fixedWidth := countWidth + exceptionSparklineWidth + servicesWidth + seenWidth*2 + 5 // separators
+	exceptionWidth := m.UI.Width - fixedWidth - 10
+	if exceptionWidth < 20 {
+		exceptionWidth = 20
+	}
+
+	title := "EXCEPTION BY MESSAGE"
+	if m.Exceptions.By == es.ExceptionsByFrame {
+		title = "EXCEPTION BY TOP FRAME"
+	}
+	header := HeaderRowStyle.Render(
+		PadOrTruncate(title, exceptionWidth) + " " +
+			PadOrTruncate("COUNT", countWidth) + " " +
+			PadOrTruncate("TREND", exceptionSparklineWidth) + " " +
+			PadOrTruncate("SERVICES", servicesWidth) + " " +
+			PadOrTruncate("FIRST SEEN", seenWidth) + " " +
+			PadOrTruncate("LAST SEEN", seenWidth))
+
+	startIdx, endIdx := calcVisibleRange(m.Exceptions.Cursor, len(groups), listHeight)
+
+	var lines []string
+	lines = append(lines, header)
+
+	for i := startIdx; i < endIdx; i++ {
+		g := groups[i]
+		selected := i == m.Exceptions.Cursor
+
+		// Mark the group currently applied as a log filter
+		name := singleLine(g.String())
+		if g.ExceptionFilter == m.Filters.Exception {
+			name = "✓ " + name
+		}
+
+		firstSeen := "-"
+		if !g.FirstSeen.IsZero() {
+			firstSeen = formatRelativeTime(g.FirstSeen)
+		}
+		lastSeen := "-"
+		if !g.LastSeen.IsZero() {
+			lastSeen = formatRelativeTime(g.LastSeen)
+		}
+
+		line := PadOrTruncate(name, exceptionWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%d", g.Count), countWidth) + " " +
+			countSparkline(g.Buckets, exceptionSparklineWidth) + " " +
+			PadOrTruncate(strings.Join(g.Services, ", "), servicesWidth) + " " +
+			PadOrTruncate(firstSeen, seenWidth) + " " +
+			PadOrTruncate(lastSeen, seenWidth)
+
+		if selected {
+			lines = append(lines, SelectedLogStyle.Width(m.UI.Width-6).Render(line))
+		} else {
+			lines = append(lines, LogEntryStyle.Render(line))
+		}
+	}
+
+	content := strings.Join(lines, "\n")
+	return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(content)
+}
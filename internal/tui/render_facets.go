@@ -0,0 +1,90 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// facetPanelHeight is the number of lines the top-values panel takes below
+// the field list: a blank line, the title, the numeric summary and the values.
+const facetPanelHeight = 13
+
+// facetBarWidth is the width of the percentage bar next to each value.
+const facetBarWidth = 20
+
+// showFieldFacets reports whether the top-values panel is drawn in the field selector.
+func (m Model) showFieldFacets() bool {
+	return m.Fields.Facets.Field != ""
+}
+
+// renderFieldFacets draws the top values of the highlighted field with their
+// counts and share of the matching documents.
+func (m Model) renderFieldFacets(width int) string {
+	facets := m.Fields.Facets
+	var b strings.Builder
+
+	b.WriteString("\n")
+	b.WriteString(DetailKeyStyle.Render("Top values: " + facets.Field))
+
+	switch {
+	case facets.Loading:
+		b.WriteString("\n" + LoadingStyle.Render("Loading values..."))
+		return b.String()
+	case facets.Result == nil:
+		b.WriteString("\n" + DetailMutedStyle.Render("No values for this field"))
+		return b.String()
+	}
+
+	result := facets.Result
+	b.WriteString(DetailMutedStyle.Render(fmt.Sprintf("  (%d of %d docs have it)", result.Present, result.Total)))
+	if n := result.Numeric; n != nil {
+		b.WriteString("\n" + DetailValueStyle.Render(fmt.Sprintf("min %s  max %s  avg %s",
+			formatMetricValue(n.Min), formatMetricValue(n.Max), formatMetricValue(n.Avg))))
+	}
+	if len(result.Values) == 0 {
+		b.WriteString("\n" + DetailMutedStyle.Render("No values match the current filters"))
+		return b.String()
+	}
+
+	valueWidth := width - facetBarWidth - 20
+	if valueWidth > 40 {
+		valueWidth = 40
+	}
+	if valueWidth < 10 {
+		valueWidth = 10
+	}
+	for i, v := range result.Values {
+		pct := result.Percent(v)
+		line := fmt.Sprintf("%s %8d %5.1f%% ", PadOrTruncate(singleLine(v.Value), valueWidth), v.Count, pct)
+		b.WriteString("\n")
+		if facets.Focused && i == facets.Cursor {
+			b.WriteString(SelectedLogStyle.Render(line) + facetBar(pct))
+		} else {
+			b.WriteString(DetailValueStyle.Render(line) + facetBar(pct))
+		}
+	}
+	return b.String()
+}
+
+// facetBar draws a value's share of the documents as a horizontal bar.
+func facetBar(pct float64) string {
+	n := int(pct*facetBarWidth/100 + 0.5)
+	if n < 1 && pct > 0 {
+		n = 1
+	}
+	if n > facetBarWidth {
+		n = facetBarWidth
+	}
+	return SparklineStyle.Render(strings.Repeat("█", n))
+}
+
+// facetValueCount returns how many values the top-values panel lists.
+func (m Model) facetValueCount() int {
+	if m.Fields.Facets.Result == nil {
+		return 0
+	}
+	return len(m.Fields.Facets.Result.Values)
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	includeChipStyle lipgloss.Style
+	excludeChipStyle lipgloss.Style
+)
+
+func buildChipStyles(t Theme) {
+	includeChipStyle = lipgloss.NewStyle().Foreground(t.Success).Bold(true)
+	excludeChipStyle = lipgloss.NewStyle().Foreground(t.Levels.Error).Bold(true)
+}
+
+// renderFilterChips renders the active field filters as removable chips,
+// highlighting the selected one while the chips have focus.
+func (m Model) renderFilterChips() string {
+	chips := make([]string, 0, len(m.Filters.FieldFilters))
+	for i, f := range m.Filters.FieldFilters {
+		style := includeChipStyle
+		if f.Negate {
+			style = excludeChipStyle
+		}
+		if m.Chips.Focused && i == m.Chips.Cursor {
+			style = style.Reverse(true)
+		}
+		chips = append(chips, style.Render("["+TruncateWithEllipsis(f.String(), 40)+" ✕]"))
+	}
+	return strings.Join(chips, " ")
+}
+
+// renderFieldFilterPicker lists the fields of the inspected document so one
+// can be turned into an include, exclude or exists filter.
+func (m Model) renderFieldFilterPicker(listHeight int) string {
+	fields := m.FilterPicker.Fields
+	if len(fields) == 0 {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			LoadingStyle.Render("No fields to filter on."))
+	}
+
+	// FIELD (40%) | VALUE (flex)
+	nameWidth := (m.UI.Width - 10) * 2 / 5
+	if nameWidth < 20 {
+		nameWidth = 20
+	}
+	valueWidth := m.UI.Width - nameWidth - 10
+	if valueWidth < 10 {
+		valueWidth = 10
+	}
+
+	var lines []string
+	lines = append(lines, HeaderRowStyle.Render(PadOrTruncate(
+		fmt.Sprintf("Filter by value (%d fields, %d active filters)", len(fields), len(m.Filters.FieldFilters)), m.UI.Width-8)))
+	lines = append(lines, HeaderRowStyle.Render(PadOrTruncate("FIELD", nameWidth)+" "+PadOrTruncate("VALUE", valueWidth)))
+
+	startIdx, endIdx := calcVisibleRange(m.FilterPicker.Cursor, len(fields), listHeight-1)
+	for i := startIdx; i < endIdx; i++ {
+		f := fields[i]
+		line := PadOrTruncate(f.Name, nameWidth) + " " + PadOrTruncate(strings.ReplaceAll(f.Value, "\n", " "), valueWidth)
+		if i == m.FilterPicker.Cursor {
+			lines = append(lines, SelectedLogStyle.Width(m.UI.Width-6).Render(line))
+		} else {
+			lines = append(lines, LogEntryStyle.Render(line))
+		}
+	}
+
+	return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(strings.Join(lines, "\n"))
+}
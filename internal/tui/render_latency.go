@@ -0,0 +1,84 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// renderLatencyHistogram draws the duration distribution of a transaction name
+// as one horizontal bar per bucket, fastest at the top.
+func (m Model) renderLatencyHistogram(listHeight int) string {
+	if m.Latency.Loading && m.Latency.Histogram == nil {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			LoadingStyle.Render("Loading latency distribution..."))
+	}
+
+	if m.UI.Err != nil {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			ErrorStyle.Render(fmt.Sprintf("Error: %v", m.UI.Err)))
+	}
+
+	if m.latencyBucketCount() == 0 {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			LoadingStyle.Render("No transactions found in the selected time range."))
+	}
+
+	tx := m.Latency.Tx
+	buckets := m.Latency.Histogram.Buckets
+
+	var maxCount int64
+	for _, b := range buckets {
+		if b.Count > maxCount {
+			maxCount = b.Count
+		}
+	}
+
+	// RANGE (21) | bar (flex) | COUNT (8)
+	rangeWidth := 21
+	countWidth := 8
+	barWidth := m.UI.Width - rangeWidth - countWidth - 12
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	summary := fmt.Sprintf("%s  p50 %.1fms  p90 %.1fms  p95 %.1fms  p99 %.1fms  max %.1fms",
+		tx.Name, tx.P50Duration, tx.P90Duration, tx.P95Duration, tx.P99Duration, tx.MaxDuration)
+	if m.Latency.Loading {
+		summary += "  (loading...)"
+	}
+
+	var lines []string
+	lines = append(lines, HeaderRowStyle.Render(PadOrTruncate(summary, m.UI.Width-8)))
+	lines = append(lines, HeaderRowStyle.Render(
+		PadOrTruncate("DURATION (ms)", rangeWidth)+" "+
+			PadOrTruncate("", barWidth)+" "+
+			PadOrTruncate("COUNT", countWidth)))
+
+	startIdx, endIdx := calcVisibleRange(m.Latency.Cursor, len(buckets), listHeight-1)
+	for i := startIdx; i < endIdx; i++ {
+		b := buckets[i]
+		length := 0
+		if maxCount > 0 {
+			length = int(float64(b.Count) / float64(maxCount) * float64(barWidth))
+			if length == 0 && b.Count > 0 {
+				length = 1 // Keep sparse tail buckets visible
+			}
+		}
+
+		line := PadOrTruncate(fmt.Sprintf("%.1f - %.1f", b.Min, b.Max), rangeWidth) + " " +
+			WaterfallBarStyle.Render(strings.Repeat("█", length)) + strings.Repeat(" ", barWidth-length) + " " +
+			PadOrTruncate(fmt.Sprintf("%d", b.Count), countWidth)
+
+		if i == m.Latency.Cursor {
+			lines = append(lines, SelectedLogStyle.Width(m.UI.Width-6).Render(line))
+		} else {
+			lines = append(lines, LogEntryStyle.Render(line))
+		}
+	}
+
+	content := strings.Join(lines, "\n")
+	return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(content)
+}
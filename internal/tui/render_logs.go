@@ -227,6 +227,12 @@ func (m Model) renderLogEntry(log es.LogEntry, selected bool) string {
 
 	line := strings.Join(parts, " ")
 
+	if m.isContextAnchor(log) {
+		if selected {
+			return SelectedCellStyle.Width(m.UI.Width - 6).Render(contextAnchorMark + line)
+		}
+		return AnchorMarkStyle.Render(contextAnchorMark) + line
+	}
 	if selected {
 		return SelectedLogStyle.Width(m.UI.Width - 6).Render(line)
 	}
@@ -307,15 +313,7 @@ func renderSpanWaterfall(spans []es.LogEntry, availableWidth int) string {
 		barWidth := int(float64(durationNs) / float64(totalDuration) * float64(barAreaWidth))
 
 		// Format duration string
-		ms := float64(durationNs) / 1_000_000.0
-		var durationStr string
-		if ms < 1 {
-			durationStr = fmt.Sprintf("%.2fms", ms)
-		} else if ms < 100 {
-			durationStr = fmt.Sprintf("%.1fms", ms)
-		} else {
-			durationStr = fmt.Sprintf("%.0fms", ms)
-		}
+		durationStr := es.FormatSpanDuration(durationNs)
 
 		// Minimum bar width to fit: ├(duration)┤
 		minBarWidth := len(durationStr) + 2 // +2 for ├ and ┤
@@ -478,6 +476,12 @@ func (m Model) renderLogDetail(log es.LogEntry) string {
 			b.WriteString("\n\n")
 		}
 
+		if log.TraceID != "" {
+			b.WriteString(DetailKeyStyle.Render("Trace ID: "))
+			b.WriteString(DetailValueStyle.Render(log.TraceID))
+			b.WriteString("\n\n")
+		}
+
 		b.WriteString(DetailKeyStyle.Render("Message:"))
 		b.WriteString("\n")
 		b.WriteString(hl.ApplyToField(log.GetMessage(), DetailValueStyle))
@@ -550,9 +554,6 @@ func (m Model) renderLogDetail(log es.LogEntry) string {
 	return b.String()
 }
 
-// LogOriginalStyle is a mellow blue for highlighting log.record.original
-var LogOriginalStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("111")) // Mellow blue
-
 // isEffectivelyNil checks if a value is nil or a map containing only nil values (recursively).
 // This is used to hide empty nested structures in the detail view.
 func isEffectivelyNil(v interface{}) bool {
@@ -21,18 +21,14 @@ func (m Model) renderMetricsDashboard(listHeight int) string {
 			ErrorStyle.Render(fmt.Sprintf("Error: %v", m.UI.Err)))
 	}
 
-	if m.Metrics.Aggregated == nil || len(m.Metrics.Aggregated.Metrics) == 0 {
+	filteredMetrics := m.getFilteredMetrics()
+	if len(filteredMetrics) == 0 && m.Metrics.NameFilter != "" {
 		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
-			LoadingStyle.Render("No metrics found. " + keysHint("documents view", "d")))
+			LoadingStyle.Render(fmt.Sprintf("No metrics matching '%s'. Press / to search, Esc to clear.", m.Metrics.NameFilter)))
 	}
-
-	// Filter metrics by name if filter is set
-	allMetrics := m.Metrics.Aggregated.Metrics
-	filteredMetrics := m.filterMetricsByName(allMetrics)
-
 	if len(filteredMetrics) == 0 {
 		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
-			LoadingStyle.Render(fmt.Sprintf("No metrics matching '%s'. Press / to search, Esc to clear.", m.Metrics.NameFilter)))
+			LoadingStyle.Render("No metrics found. " + keysHint("documents view", "d")))
 	}
 
 	// Calculate column widths
@@ -51,6 +47,16 @@ func (m Model) renderMetricsDashboard(listHeight int) string {
 	if m.Metrics.NameFilter != "" {
 		headerText = fmt.Sprintf("METRIC (filter: %s)", m.Metrics.NameFilter)
 	}
+	if n := len(m.Metrics.Marked); n > 0 {
+		headerText += fmt.Sprintf(" [%d marked]", n)
+	}
+	// Metrics are loaded page by page as the cursor moves down
+	if agg := m.Metrics.Aggregated; agg.HasMore() {
+		headerText += fmt.Sprintf(" [%d of %d]", len(filteredMetrics), agg.Total)
+		if m.Metrics.LoadingMore {
+			headerText += " loading..."
+		}
+	}
 	header := HeaderRowStyle.Render(
 		PadOrTruncate(headerText, metricWidth) + " " +
 			PadOrTruncate("TREND", sparklineWidth) + " " +
@@ -74,10 +80,11 @@ func (m Model) renderMetricsDashboard(listHeight int) string {
 		sparkline := generateSparkline(metric.Buckets, sparklineWidth)
 
 		// Format numbers
-		minStr := formatMetricValue(metric.Min)
-		maxStr := formatMetricValue(metric.Max)
-		avgStr := formatMetricValue(metric.Avg)
-		latestStr := formatMetricValue(metric.Latest)
+		unit, bound := rateUnit(metric), rateBound(metric)
+		minStr := bound + formatMetricValue(metric.Min) + unit
+		maxStr := bound + formatMetricValue(metric.Max) + unit
+		avgStr := bound + formatMetricValue(metric.Avg) + unit
+		latestStr := bound + formatMetricValue(metric.Latest) + unit
 
 		// Format last seen
 		lastSeenStr := "-"
@@ -85,8 +92,14 @@ func (m Model) renderMetricsDashboard(listHeight int) string {
 			lastSeenStr = formatRelativeTime(metric.LastSeen)
 		}
 
+		// Marked metrics are listed in the comparison view
+		name := metric.ShortName
+		if m.isMetricMarked(metric.Name) {
+			name = "● " + name
+		}
+
 		// Build line
-		line := PadOrTruncate(metric.ShortName, metricWidth) + " " +
+		line := PadOrTruncate(name, metricWidth) + " " +
 			sparkline + " " +
 			PadOrTruncate(minStr, numWidth) + " " +
 			PadOrTruncate(maxStr, numWidth) + " " +
@@ -129,20 +142,25 @@ func (m Model) renderMetricsCompactDetail() string {
 		b.WriteString(DetailKeyStyle.Render("Type: "))
 		b.WriteString(DetailValueStyle.Render(metric.Type))
 	}
+	if metric.Partial {
+		b.WriteString("  ")
+		b.WriteString(DetailMutedStyle.Render(partialRateNote()))
+	}
 	b.WriteString("\n")
 
 	// Second line: Stats
+	unit := rateUnit(metric)
 	b.WriteString(DetailKeyStyle.Render("Min: "))
-	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.4f", metric.Min)))
+	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.4f%s", metric.Min, unit)))
 	b.WriteString("  ")
 	b.WriteString(DetailKeyStyle.Render("Max: "))
-	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.4f", metric.Max)))
+	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.4f%s", metric.Max, unit)))
 	b.WriteString("  ")
 	b.WriteString(DetailKeyStyle.Render("Avg: "))
-	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.4f", metric.Avg)))
+	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.4f%s", metric.Avg, unit)))
 	b.WriteString("  ")
 	b.WriteString(DetailKeyStyle.Render("Latest: "))
-	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.4f", metric.Latest)))
+	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.4f%s", metric.Latest, unit)))
 	b.WriteString("\n")
 
 	// Third line: Bucket info
@@ -191,20 +209,28 @@ func (m Model) renderMetricDetailContent() string {
 		b.WriteString("  ")
 		b.WriteString(DetailMutedStyle.Render(fmt.Sprintf("(%s)", metric.Type)))
 	}
+	if metric.Rate {
+		b.WriteString("  ")
+		b.WriteString(DetailMutedStyle.Render(fmt.Sprintf("rate per second, %s counter", metric.Temporality)))
+	}
+	if metric.Partial {
+		b.WriteString(DetailMutedStyle.Render(", " + partialRateNote()))
+	}
 	b.WriteString("\n")
 
 	// Stats line
+	unit := rateUnit(metric)
 	b.WriteString(DetailKeyStyle.Render("Min: "))
-	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.6f", metric.Min)))
+	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.6f%s", metric.Min, unit)))
 	b.WriteString("  ")
 	b.WriteString(DetailKeyStyle.Render("Max: "))
-	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.6f", metric.Max)))
+	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.6f%s", metric.Max, unit)))
 	b.WriteString("  ")
 	b.WriteString(DetailKeyStyle.Render("Avg: "))
-	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.6f", metric.Avg)))
+	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.6f%s", metric.Avg, unit)))
 	b.WriteString("  ")
 	b.WriteString(DetailKeyStyle.Render("Latest: "))
-	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.6f", metric.Latest)))
+	b.WriteString(DetailValueStyle.Render(fmt.Sprintf("%.6f%s", metric.Latest, unit)))
 	b.WriteString("\n")
 
 	// Time range info
@@ -219,9 +245,16 @@ func (m Model) renderMetricDetailContent() string {
 	}
 	b.WriteString("\n\n")
 
-	// Chart
-	chart := m.renderLargeChart(metric.Buckets, metric.Min, metric.Max, chartWidth, chartHeight)
-	b.WriteString(chart)
+	// Chart: one line per dimension value when grouped; percentiles and a
+	// heatmap for histograms
+	if m.Metrics.GroupBy != "" && m.Metrics.BreakdownMetric == metric.Name {
+		b.WriteString(m.renderMetricBreakdown(chartWidth, chartHeight))
+	} else if m.Metrics.DistributionMetric == metric.Name {
+		b.WriteString(m.renderMetricDistribution(metric, chartWidth, chartHeight))
+	} else {
+		chart := m.renderLargeChart(metric.Buckets, metric.Min, metric.Max, chartWidth, chartHeight)
+		b.WriteString(chart)
+	}
 	b.WriteString("\n\n")
 
 	// Document details (no height limit - viewport handles scrolling)
@@ -230,29 +263,60 @@ func (m Model) renderMetricDetailContent() string {
 	return b.String()
 }
 
-// filterMetricsByName returns metrics that match the name filter (case-insensitive substring)
-func (m Model) filterMetricsByName(allMetrics []metrics.AggregatedMetric) []metrics.AggregatedMetric {
-	if m.Metrics.NameFilter == "" {
-		return allMetrics
+// renderMetricDistribution renders the percentiles and heatmap of a histogram metric.
+// Until they are loaded, the average chart is shown.
+func (m Model) renderMetricDistribution(metric metrics.AggregatedMetric, width, height int) string {
+	dist := m.Metrics.Distribution
+	if dist == nil || len(dist.Heatmap.Times) == 0 {
+		var b strings.Builder
+		if m.Metrics.DistributionLoading {
+			b.WriteString(LoadingStyle.Render("Loading distribution..."))
+			b.WriteString("\n\n")
+		}
+		b.WriteString(m.renderLargeChart(metric.Buckets, metric.Min, metric.Max, width, height))
+		return b.String()
 	}
 
-	filter := strings.ToLower(m.Metrics.NameFilter)
-	var filtered []metrics.AggregatedMetric
-	for _, metric := range allMetrics {
-		if strings.Contains(strings.ToLower(metric.Name), filter) ||
-			strings.Contains(strings.ToLower(metric.ShortName), filter) {
-			filtered = append(filtered, metric)
-		}
+	var b strings.Builder
+	b.WriteString(DetailKeyStyle.Render("Percentiles"))
+	b.WriteString("\n\n")
+	b.WriteString(m.renderMultiSeriesChart(dist.Percentiles, width, height))
+	b.WriteString("\n\n")
+	b.WriteString(DetailKeyStyle.Render("Distribution"))
+	b.WriteString(DetailMutedStyle.Render(" (values per time bucket, darker = more)"))
+	b.WriteString("\n\n")
+	b.WriteString(m.renderHeatmap(dist.Heatmap, width))
+	return b.String()
+}
+
+// renderMetricBreakdown renders the detail metric split by the active group-by dimension
+func (m Model) renderMetricBreakdown(width, height int) string {
+	var b strings.Builder
+
+	b.WriteString(DetailKeyStyle.Render("Group by: "))
+	b.WriteString(DetailValueStyle.Render(strings.TrimPrefix(m.Metrics.GroupBy, "attributes.")))
+	b.WriteString(DetailMutedStyle.Render(fmt.Sprintf(" (%d/%d, top %d values)",
+		m.metricGroupByIndex()+1, len(m.Metrics.Dimensions), metrics.DefaultGroupByLimit)))
+	b.WriteString("\n\n")
+
+	switch {
+	case m.Metrics.BreakdownLoading:
+		b.WriteString(LoadingStyle.Render("Loading breakdown..."))
+	case m.Metrics.Breakdown == nil || len(m.Metrics.Breakdown.Series) == 0:
+		b.WriteString(DetailMutedStyle.Render("No values for this dimension"))
+	default:
+		b.WriteString(m.renderMultiSeriesChart(m.Metrics.Breakdown.Series, width, height))
 	}
-	return filtered
+	return b.String()
 }
 
-// getFilteredMetrics returns the filtered metrics list for navigation
+// getFilteredMetrics returns the loaded metrics matching the name filter.
+// The filter is applied server-side, so this is every loaded metric.
 func (m Model) getFilteredMetrics() []metrics.AggregatedMetric {
 	if m.Metrics.Aggregated == nil {
 		return nil
 	}
-	return m.filterMetricsByName(m.Metrics.Aggregated.Metrics)
+	return m.Metrics.Aggregated.Metrics
 }
 
 // renderMetricDetailDocs renders the document browser section in the metric detail view
@@ -34,7 +34,7 @@ func (m Model) renderQueryOverlay() string {
 	justCopied := m.UI.StatusMessage == "Copied to clipboard!" && time.Since(m.UI.StatusTime) < 2*time.Second
 	if justCopied {
 		b.WriteString("  ")
-		b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("#04B575")).Bold(true).Render(m.UI.StatusMessage))
+		b.WriteString(lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(m.UI.StatusMessage))
 	}
 	b.WriteString("\n\n")
 
@@ -62,8 +62,8 @@ func (m Model) renderQueryOverlay() string {
 	b.WriteString("\n\n")
 
 	// Key hints
-	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
-	highlightStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(mutedColor)
+	highlightStyle := lipgloss.NewStyle().Foreground(successColor).Bold(true)
 
 	var copyHint string
 	if justCopied {
@@ -80,6 +80,8 @@ func (m Model) renderQueryOverlay() string {
 		"  ",
 		dimStyle.Render(keysHint("curl", "c")),
 		"  ",
+		dimStyle.Render(keysHint("edit ES|QL", "e")),
+		"  ",
 		dimStyle.Render(keysHint("close", "esc", "q")),
 	)
 	b.WriteString(hints)
@@ -101,14 +103,14 @@ func (m Model) renderErrorModal() string {
 	modalStyle := lipgloss.NewStyle().
 		Width(modalWidth).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("196")). // Red border
+		BorderForeground(errorColor). // Red border
 		Padding(1, 2).
 		Align(lipgloss.Left)
 
 	// Error title
 	title := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("196")).
+		Foreground(errorColor).
 		Render("⚠ Error")
 
 	// Check if we just copied (statusMessage set within last 2 seconds)
@@ -119,7 +121,7 @@ func (m Model) renderErrorModal() string {
 	scrollInfo := ""
 	if m.Components.ErrorViewport.TotalLineCount() > m.Components.ErrorViewport.Height {
 		scrollInfo = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("240")).
+			Foreground(mutedColor).
 			Render(fmt.Sprintf(" (scroll: %d%%) ", int(m.Components.ErrorViewport.ScrollPercent()*100)))
 	}
 
@@ -127,12 +129,12 @@ func (m Model) renderErrorModal() string {
 	var copyButton string
 	if justCopied {
 		copyButton = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("42")).
+			Foreground(successColor).
 			Bold(true).
 			Render(keysHint("Copy ✓ copied", "y"))
 	} else {
 		copyButton = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("42")).
+			Foreground(successColor).
 			Bold(true).
 			Render(actionHint(ActionCopy))
 	}
@@ -142,15 +144,15 @@ func (m Model) renderErrorModal() string {
 		copyButton,
 		"  ",
 		lipgloss.NewStyle().
-			Foreground(lipgloss.Color("240")).
+			Foreground(mutedColor).
 			Render(keysHint("Scroll", "↑", "↓")),
 		"  ",
 		lipgloss.NewStyle().
-			Foreground(lipgloss.Color("240")).
+			Foreground(mutedColor).
 			Render(actionHint(ActionBack)),
 		"  ",
 		lipgloss.NewStyle().
-			Foreground(lipgloss.Color("240")).
+			Foreground(mutedColor).
 			Render(actionHint(ActionQuit)),
 		scrollInfo,
 	)
@@ -182,7 +184,7 @@ func (m Model) renderQuitConfirmModal() string {
 	modalStyle := lipgloss.NewStyle().
 		Width(modalWidth).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("240")).
+		BorderForeground(mutedColor).
 		Padding(1, 2).
 		Align(lipgloss.Left)
 
@@ -191,14 +193,14 @@ func (m Model) renderQuitConfirmModal() string {
 		Render("Quit?")
 
 	body := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("245")).
+		Foreground(mutedColor).
 		Render("Are you sure you want to quit?")
 
 	actions := lipgloss.JoinHorizontal(
 		lipgloss.Left,
-		lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("Yes", quitConfirmYesKey)),
+		lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("Yes", quitConfirmYesKey)),
 		"  ",
-		lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(keyHint([]string{quitConfirmNoKey, "esc"}, "No")),
+		lipgloss.NewStyle().Foreground(mutedColor).Render(keyHint([]string{quitConfirmNoKey, "esc"}, "No")),
 	)
 
 	content := lipgloss.JoinVertical(
@@ -216,26 +218,26 @@ func (m Model) renderOtelConfigExplainModal() string {
 	// Modal dimensions
 	modalWidth := min(m.UI.Width-8, 65)
 
-	// Modal box style - use cyan border
+	// Modal box style - use the info colour for the border
 	modalStyle := lipgloss.NewStyle().
 		Width(modalWidth).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("45")). // Cyan border
+		BorderForeground(infoColor).
 		Padding(1, 2).
 		Align(lipgloss.Left)
 
 	// Title
 	title := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("45")).
+		Foreground(infoColor).
 		Render("⚙ Open OTel Collector Config")
 
 	var b strings.Builder
 
 	// Explanation text
-	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
-	highlightStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("255")).Bold(true)
-	yellowStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("226"))
+	dimStyle := lipgloss.NewStyle().Foreground(mutedColor)
+	highlightStyle := lipgloss.NewStyle().Foreground(fgColor).Bold(true)
+	yellowStyle := lipgloss.NewStyle().Foreground(warningColor)
 
 	b.WriteString(dimStyle.Render("This will open the OpenTelemetry Collector configuration"))
 	b.WriteString("\n")
@@ -261,9 +263,9 @@ func (m Model) renderOtelConfigExplainModal() string {
 	// Actions
 	actions := lipgloss.JoinHorizontal(
 		lipgloss.Left,
-		lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("open editor", "enter", "o")),
+		lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("open editor", "enter", "o")),
 		"  ",
-		lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(keysHint("cancel", "esc")),
+		lipgloss.NewStyle().Foreground(mutedColor).Render(keysHint("cancel", "esc")),
 	)
 
 	content := lipgloss.JoinVertical(
@@ -286,21 +288,21 @@ func (m Model) renderOtelConfigUnavailableModal() string {
 	modalStyle := lipgloss.NewStyle().
 		Width(modalWidth).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("214")). // Orange border
+		BorderForeground(warningColor).
 		Padding(1, 2).
 		Align(lipgloss.Left)
 
 	// Title
 	title := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("214")).
+		Foreground(warningColor).
 		Render("⚠ OTel Config Not Available")
 
 	var b strings.Builder
 
 	// Explanation text
-	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
-	highlightStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("255")).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(mutedColor)
+	highlightStyle := lipgloss.NewStyle().Foreground(fgColor).Bold(true)
 
 	b.WriteString(dimStyle.Render("The OTel Collector config editor is only available"))
 	b.WriteString("\n")
@@ -309,7 +311,7 @@ func (m Model) renderOtelConfigUnavailableModal() string {
 
 	b.WriteString(highlightStyle.Render("Current profile: "))
 	if m.profileName != "" {
-		b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("226")).Render(m.profileName))
+		b.WriteString(lipgloss.NewStyle().Foreground(warningColor).Render(m.profileName))
 	} else {
 		b.WriteString(dimStyle.Render("(none)"))
 	}
@@ -322,7 +324,7 @@ func (m Model) renderOtelConfigUnavailableModal() string {
 	b.WriteString(dimStyle.Render("  • Run 'elasticat config use elastic-start-local'"))
 
 	// Actions
-	actions := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(keysHint("dismiss", "esc", "enter"))
+	actions := lipgloss.NewStyle().Foreground(mutedColor).Render(keysHint("dismiss", "esc", "enter"))
 
 	content := lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -340,28 +342,28 @@ func (m Model) renderOtelConfigModal() string {
 	// Modal dimensions
 	modalWidth := min(m.UI.Width-8, 70)
 
-	// Modal box style - use a nice cyan border for config
+	// Modal box style - use the info colour for the config border
 	modalStyle := lipgloss.NewStyle().
 		Width(modalWidth).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("45")). // Cyan border
+		BorderForeground(infoColor).
 		Padding(1, 2).
 		Align(lipgloss.Left)
 
 	// Title
 	title := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("45")).
+		Foreground(infoColor).
 		Render("⚙ OTel Collector Config")
 
 	var b strings.Builder
 
 	// Label/value styles
-	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
-	valueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("255")).Bold(true)
-	successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true) // Green
-	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true)  // Red
-	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	labelStyle := lipgloss.NewStyle().Foreground(mutedColor)
+	valueStyle := lipgloss.NewStyle().Foreground(fgColor).Bold(true)
+	successStyle := lipgloss.NewStyle().Foreground(successColor).Bold(true) // Green
+	errorStyle := lipgloss.NewStyle().Foreground(errorColor).Bold(true)     // Red
+	dimStyle := lipgloss.NewStyle().Foreground(mutedColor)
 
 	// Show config path (never truncate - user needs to copy it)
 	b.WriteString(labelStyle.Render("Config file:"))
@@ -429,7 +431,7 @@ func (m Model) renderOtelConfigModal() string {
 	b.WriteString("\n\n")
 
 	// Instructions
-	instructionStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("226")) // Yellow
+	instructionStyle := lipgloss.NewStyle().Foreground(warningColor) // Yellow
 	if m.Otel.WatchingConfig {
 		b.WriteString(instructionStyle.Render("Save to validate & hot-reload. Invalid configs won't reload."))
 	} else {
@@ -447,23 +449,23 @@ func (m Model) renderOtelConfigModal() string {
 
 	// Copy path hint
 	if justCopiedPath {
-		actionParts = append(actionParts, lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("path ✓", "y")))
+		actionParts = append(actionParts, lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("path ✓", "y")))
 	} else {
-		actionParts = append(actionParts, lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("copy path", "y")))
+		actionParts = append(actionParts, lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("copy path", "y")))
 	}
 
 	// Copy error hint (only if there's an error)
 	hasError := (!m.Otel.ValidationValid && m.Otel.ValidationStatus != "" && m.Otel.ValidationStatus != "Validating config...") || m.Otel.ReloadError != nil
 	if hasError {
 		if justCopiedError {
-			actionParts = append(actionParts, lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("error ✓", "Y")))
+			actionParts = append(actionParts, lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("error ✓", "Y")))
 		} else {
-			actionParts = append(actionParts, lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("copy error", "Y")))
+			actionParts = append(actionParts, lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("copy error", "Y")))
 		}
 	}
 
 	// Dismiss hint
-	actionParts = append(actionParts, lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(keysHint("dismiss", "esc")))
+	actionParts = append(actionParts, lipgloss.NewStyle().Foreground(mutedColor).Render(keysHint("dismiss", "esc")))
 
 	actions := lipgloss.JoinHorizontal(lipgloss.Left, strings.Join(actionParts, "  "))
 
@@ -483,27 +485,27 @@ func (m Model) renderCredsModal() string {
 	// Modal dimensions
 	modalWidth := min(m.UI.Width-8, 70)
 
-	// Modal box style - use a nice blue/cyan border for credentials
+	// Modal box style - use the accent colour for the credentials border
 	modalStyle := lipgloss.NewStyle().
 		Width(modalWidth).
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("39")). // Blue border
+		BorderForeground(primaryColor).
 		Padding(1, 2).
 		Align(lipgloss.Left)
 
 	// Title
 	title := lipgloss.NewStyle().
 		Bold(true).
-		Foreground(lipgloss.Color("39")).
+		Foreground(primaryColor).
 		Render("Open Kibana")
 
 	var b strings.Builder
 
 	// Label style
-	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
-	valueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("255")).Bold(true)
-	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
-	instructionStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("226")).Bold(true) // Yellow
+	labelStyle := lipgloss.NewStyle().Foreground(mutedColor)
+	valueStyle := lipgloss.NewStyle().Foreground(fgColor).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(mutedColor)
+	instructionStyle := lipgloss.NewStyle().Foreground(warningColor).Bold(true) // Yellow
 
 	// Instruction message at top
 	if m.Creds.LastKibanaURL != "" {
@@ -559,42 +561,42 @@ func (m Model) renderCredsModal() string {
 	if m.Creds.LastKibanaURL != "" {
 		var copyURLHint string
 		if justCopiedURL {
-			copyURLHint = lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("url ✓", "y"))
+			copyURLHint = lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("url ✓", "y"))
 		} else {
-			copyURLHint = lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("copy url", "y"))
+			copyURLHint = lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("copy url", "y"))
 		}
 		var copyPassHint string
 		if justCopiedPass {
-			copyPassHint = lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("pass ✓", "p"))
+			copyPassHint = lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("pass ✓", "p"))
 		} else {
-			copyPassHint = lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("copy pass", "p"))
+			copyPassHint = lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("copy pass", "p"))
 		}
 		actions = lipgloss.JoinHorizontal(
 			lipgloss.Left,
-			lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("open browser", "enter")),
+			lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("open browser", "enter")),
 			"  ",
 			copyURLHint,
 			"  ",
 			copyPassHint,
 			"  ",
-			lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(keysHint("dismiss", "esc")),
+			lipgloss.NewStyle().Foreground(mutedColor).Render(keysHint("dismiss", "esc")),
 			"  ",
-			lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(keysHint("never show", "n")),
+			lipgloss.NewStyle().Foreground(mutedColor).Render(keysHint("never show", "n")),
 		)
 	} else {
 		var copyPassHint string
 		if justCopiedPass {
-			copyPassHint = lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("pass ✓", "p"))
+			copyPassHint = lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("pass ✓", "p"))
 		} else {
-			copyPassHint = lipgloss.NewStyle().Foreground(lipgloss.Color("42")).Bold(true).Render(keysHint("copy pass", "p"))
+			copyPassHint = lipgloss.NewStyle().Foreground(successColor).Bold(true).Render(keysHint("copy pass", "p"))
 		}
 		actions = lipgloss.JoinHorizontal(
 			lipgloss.Left,
 			copyPassHint,
 			"  ",
-			lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(keysHint("dismiss", "esc")),
+			lipgloss.NewStyle().Foreground(mutedColor).Render(keysHint("dismiss", "esc")),
 			"  ",
-			lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(keysHint("never show again", "n")),
+			lipgloss.NewStyle().Foreground(mutedColor).Render(keysHint("never show again", "n")),
 		)
 	}
 
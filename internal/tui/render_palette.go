@@ -0,0 +1,55 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// paletteRows is the number of entries the palette shows at once.
+const paletteRows = 12
+
+// renderPalette renders the command palette: the input, the entries matching
+// it and the error of the last command run.
+func (m Model) renderPalette() string {
+	width := min(m.UI.Width-10, 80)
+	keysWidth, detailWidth := 14, 28
+	labelWidth := max(width-keysWidth-detailWidth-2, 10)
+
+	var lines []string
+	lines = append(lines, m.Palette.Input.View(), "")
+
+	items := m.paletteItems()
+	switch {
+	case len(items) == 0 && m.Fields.Loading:
+		lines = append(lines, LoadingStyle.Render("Loading fields..."))
+	case len(items) == 0:
+		lines = append(lines, LoadingStyle.Render("No matching actions or commands"))
+	default:
+		startIdx, endIdx := calcVisibleRange(m.Palette.Cursor, len(items), paletteRows)
+		for i := startIdx; i < endIdx; i++ {
+			item := items[i]
+			line := PadOrTruncate(item.Label, labelWidth) + " " +
+				PadOrTruncate(item.Detail, detailWidth) + " " +
+				PadOrTruncate(strings.Join(item.Keys, "/"), keysWidth)
+			if i == m.Palette.Cursor {
+				lines = append(lines, SelectedLogStyle.Width(width).Render(line))
+			} else {
+				lines = append(lines, LogEntryStyle.Render(line))
+			}
+		}
+		if len(items) > paletteRows {
+			lines = append(lines, DetailMutedStyle.Render(fmt.Sprintf("%d of %d", endIdx-startIdx, len(items))))
+		}
+	}
+
+	lines = append(lines, "")
+	if m.Palette.Err != nil {
+		lines = append(lines, ErrorStyle.Render(m.Palette.Err.Error()))
+	}
+	lines = append(lines, DetailMutedStyle.Render("enter to run • tab to complete • esc to close"))
+
+	return QueryOverlayStyle.Width(width + 6).Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (m Model) renderLogPatterns(listHeight int) string {
+	if m.Patterns.Loading {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			LoadingStyle.Render("Categorizing log messages..."))
+	}
+
+	if m.UI.Err != nil {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			ErrorStyle.Render(fmt.Sprintf("Error: %v", m.UI.Err)))
+	}
+
+	if len(m.Patterns.Items) == 0 {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			LoadingStyle.Render("No log patterns found in the selected time range."))
+	}
+
+	// Calculate column widths
+	// PATTERN (flex) | COUNT (8) | ERR (6) | WARN (6) | INFO (6) | DEBUG (6) | FIRST SEEN (10) | LAST SEEN (10)
+	countWidth := 8
+	levelWidth := 6
+	seenWidth := 10
+	fixedWidth := countWidth + levelWidth*4 + seenWidth*2 + 7 // separators
+	patternWidth := m.UI.Width - fixedWidth - 10
+	if patternWidth < 20 {
+		patternWidth = 20
+	}
+
+	header := HeaderRowStyle.Render(
+		PadOrTruncate("PATTERN", patternWidth) + " " +
+			PadOrTruncate("COUNT", countWidth) + " " +
+			PadOrTruncate("ERR", levelWidth) + " " +
+			PadOrTruncate("WARN", levelWidth) + " " +
+			PadOrTruncate("INFO", levelWidth) + " " +
+			PadOrTruncate("DEBUG", levelWidth) + " " +
+			PadOrTruncate("FIRST SEEN", seenWidth) + " " +
+			PadOrTruncate("LAST SEEN", seenWidth))
+
+	startIdx, endIdx := calcVisibleRange(m.Patterns.Cursor, len(m.Patterns.Items), listHeight)
+
+	var lines []string
+	lines = append(lines, header)
+
+	for i := startIdx; i < endIdx; i++ {
+		p := m.Patterns.Items[i]
+		selected := i == m.Patterns.Cursor
+
+		// Mark the pattern currently applied as a log filter
+		name := singleLine(p.Template())
+		if p.Pattern == m.Filters.Pattern {
+			name = "✓ " + name
+		}
+
+		firstSeen := "-"
+		if !p.FirstSeen.IsZero() {
+			firstSeen = formatRelativeTime(p.FirstSeen)
+		}
+		lastSeen := "-"
+		if !p.LastSeen.IsZero() {
+			lastSeen = formatRelativeTime(p.LastSeen)
+		}
+
+		line := PadOrTruncate(name, patternWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%d", p.Count), countWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%d", p.Levels.Error), levelWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%d", p.Levels.Warn), levelWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%d", p.Levels.Info), levelWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%d", p.Levels.Debug), levelWidth) + " " +
+			PadOrTruncate(firstSeen, seenWidth) + " " +
+			PadOrTruncate(lastSeen, seenWidth)
+
+		if selected {
+			lines = append(lines, SelectedLogStyle.Width(m.UI.Width-6).Render(line))
+		} else {
+			lines = append(lines, LogEntryStyle.Render(line))
+		}
+	}
+
+	content := strings.Join(lines, "\n")
+	return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(content)
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elastic/elasticat/internal/es/traces"
+)
+
+func (m Model) renderServiceMap(listHeight int) string {
+	if m.ServiceMap.Loading {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			LoadingStyle.Render("Building service map from traces..."))
+	}
+
+	if m.UI.Err != nil {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			ErrorStyle.Render(fmt.Sprintf("Error: %v", m.UI.Err)))
+	}
+
+	if m.serviceMapEdgeCount() == 0 {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			LoadingStyle.Render("No service dependencies found in the selected time range."))
+	}
+
+	result := m.ServiceMap.Result
+
+	// Calculate column widths
+	// SOURCE (flex) | -> (4) | TARGET (flex) | CALLS (8) | ERR% (7) | AVG(ms) (9) | MAX(ms) (9)
+	connectorWidth := 4
+	callsWidth := 8
+	errWidth := 7
+	latencyWidth := 9
+	fixedWidth := connectorWidth + callsWidth + errWidth + latencyWidth*2 + 6 // separators
+	nameWidth := (m.UI.Width - fixedWidth - 10) / 2
+	if nameWidth < 15 {
+		nameWidth = 15
+	}
+
+	header := HeaderRowStyle.Render(
+		PadOrTruncate("SOURCE", nameWidth) + " " +
+			PadOrTruncate("", connectorWidth) + " " +
+			PadOrTruncate("TARGET", nameWidth) + " " +
+			PadOrTruncate("CALLS", callsWidth) + " " +
+			PadOrTruncate("ERR%", errWidth) + " " +
+			PadOrTruncate("AVG(ms)", latencyWidth) + " " +
+			PadOrTruncate("MAX(ms)", latencyWidth))
+
+	// Leave room for the truncation note
+	rowsHeight := listHeight
+	if result.Truncated {
+		rowsHeight--
+	}
+	startIdx, endIdx := calcVisibleRange(m.ServiceMap.Cursor, len(result.Edges), rowsHeight)
+
+	var lines []string
+	lines = append(lines, header)
+
+	for i := startIdx; i < endIdx; i++ {
+		e := result.Edges[i]
+		selected := i == m.ServiceMap.Cursor
+
+		// Group edges by caller: only name the source on its first row
+		source := e.Source
+		if i > startIdx && result.Edges[i-1].Source == e.Source {
+			source = ""
+		}
+		target := e.Target
+		if e.External {
+			target += " (ext)"
+		}
+
+		line := PadOrTruncate(source, nameWidth) + " " +
+			PadOrTruncate("-->", connectorWidth) + " " +
+			PadOrTruncate(target, nameWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%d", e.Calls), callsWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%.1f", e.ErrorRate()), errWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%.1f", e.AvgLatency), latencyWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%.1f", e.MaxLatency), latencyWidth)
+
+		switch {
+		case selected:
+			lines = append(lines, SelectedLogStyle.Width(m.UI.Width-6).Render(line))
+		case e.Errors > 0:
+			lines = append(lines, ErrorStyle.Render(line))
+		default:
+			lines = append(lines, LogEntryStyle.Render(line))
+		}
+	}
+
+	if result.Truncated {
+		lines = append(lines, LoadingStyle.Render(fmt.Sprintf(
+			"Sampled the first %d spans; shorten the lookback for a complete map.", traces.DefaultServiceMapSpanLimit)))
+	}
+
+	content := strings.Join(lines, "\n")
+	return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(content)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/elastic/elasticat/internal/es"
 	"github.com/elastic/elasticat/internal/es/traces"
 )
 
@@ -35,16 +36,17 @@ func (m Model) renderTransactionNames(listHeight int) string {
 	}
 
 	// Calculate column widths
-	// TRANSACTION NAME (flex) | COUNT (8) | MIN(ms) (9) | AVG(ms) (9) | MAX(ms) (9) | TRACES (7) | SPANS (6) | ERR% (6) | LAST SEEN (10)
+	// TRANSACTION NAME (flex) | COUNT (8) | MIN(ms) (9) | AVG(ms) (9) | P50..P99 (9 each) | MAX(ms) (9) | TRACES (7) | SPANS (6) | ERR% (6) | LAST SEEN (10)
 	countWidth := 8
 	minWidth := 9
 	avgWidth := 9
+	pctWidth := 9
 	maxWidth := 9
 	tracesWidth := 7
 	spansWidth := 6
 	errWidth := 6
 	lastSeenWidth := 10
-	fixedWidth := countWidth + minWidth + avgWidth + maxWidth + tracesWidth + spansWidth + errWidth + lastSeenWidth + 8 // separators
+	fixedWidth := countWidth + minWidth + avgWidth + pctWidth*4 + maxWidth + tracesWidth + spansWidth + errWidth + lastSeenWidth + 12 // separators
 	nameWidth := m.UI.Width - fixedWidth - 10
 	if nameWidth < 20 {
 		nameWidth = 20
@@ -60,6 +62,10 @@ func (m Model) renderTransactionNames(listHeight int) string {
 			PadOrTruncate("COUNT", countWidth) + " " +
 			PadOrTruncate("MIN(ms)", minWidth) + " " +
 			PadOrTruncate("AVG(ms)", avgWidth) + " " +
+			PadOrTruncate("P50", pctWidth) + " " +
+			PadOrTruncate("P90", pctWidth) + " " +
+			PadOrTruncate("P95", pctWidth) + " " +
+			PadOrTruncate("P99", pctWidth) + " " +
 			PadOrTruncate("MAX(ms)", maxWidth) + " " +
 			PadOrTruncate("TRACES", tracesWidth) + " " +
 			PadOrTruncate("SPANS", spansWidth) + " " +
@@ -95,6 +101,10 @@ func (m Model) renderTransactionNames(listHeight int) string {
 			PadOrTruncate(countStr, countWidth) + " " +
 			PadOrTruncate(minStr, minWidth) + " " +
 			PadOrTruncate(avgStr, avgWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%.2f", tx.P50Duration), pctWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%.2f", tx.P90Duration), pctWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%.2f", tx.P95Duration), pctWidth) + " " +
+			PadOrTruncate(fmt.Sprintf("%.2f", tx.P99Duration), pctWidth) + " " +
 			PadOrTruncate(maxStr, maxWidth) + " " +
 			PadOrTruncate(tracesStr, tracesWidth) + " " +
 			PadOrTruncate(spansStr, spansWidth) + " " +
@@ -132,3 +142,100 @@ func (m Model) filterTransactionNames(allNames []traces.TransactionNameAgg) []tr
 func (m Model) getFilteredTransactionNames() []traces.TransactionNameAgg {
 	return m.filterTransactionNames(m.Traces.TransactionNames)
 }
+
+// renderTraceWaterfall renders the selected trace as an indented span tree with
+// duration bars scaled to the root span.
+func (m Model) renderTraceWaterfall(listHeight int) string {
+	if m.UI.Err != nil {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			ErrorStyle.Render(fmt.Sprintf("Error: %v", m.UI.Err)))
+	}
+
+	if len(m.Traces.Waterfall) == 0 {
+		return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(
+			LoadingStyle.Render("No spans found for this trace."))
+	}
+
+	// Columns: ! | SPAN (indented tree) | SERVICE (16) | DURATION (9) | TIMELINE (rest)
+	serviceWidth := 16
+	durationWidth := 9
+	available := m.UI.Width - 10
+	nameWidth := available * 2 / 5
+	if nameWidth < 24 {
+		nameWidth = 24
+	}
+	barWidth := available - 2 - nameWidth - serviceWidth - durationWidth - 3
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	header := HeaderRowStyle.Render(
+		"  " +
+			PadOrTruncate("SPAN", nameWidth) + " " +
+			PadOrTruncate("SERVICE", serviceWidth) + " " +
+			PadLeft("DURATION", durationWidth) + " " +
+			PadOrTruncate("TIMELINE", barWidth))
+
+	timeline := es.NewTimeline(m.Traces.SpanTree)
+	startIdx, endIdx := calcVisibleRange(m.Logs.SelectedIndex, len(m.Traces.Waterfall), listHeight)
+
+	var lines []string
+	lines = append(lines, header)
+	for i := startIdx; i < endIdx; i++ {
+		row := m.Traces.Waterfall[i]
+		line := waterfallRowText(row, nameWidth, serviceWidth, durationWidth)
+
+		offset, length := timeline.Bar(row.Span, barWidth)
+		barStyle := WaterfallBarStyle
+		if row.Span.IsError() {
+			barStyle = WaterfallErrorBarStyle
+		}
+		bar := strings.Repeat(" ", offset) + barStyle.Render(strings.Repeat("█", length))
+
+		mark := "  "
+		if row.Span.IsError() {
+			mark = ErrorStyle.Render("!") + " "
+		}
+
+		if i == m.Logs.SelectedIndex {
+			lines = append(lines, SelectedLogStyle.Width(m.UI.Width-6).Render(mark+line+" "+bar))
+		} else {
+			lines = append(lines, LogEntryStyle.Render(mark+line+" "+bar))
+		}
+	}
+
+	content := strings.Join(lines, "\n")
+	return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(content)
+}
+
+// waterfallRowText formats the span name (indented by depth, with a +/- subtree
+// marker), service and duration columns of a waterfall row.
+func waterfallRowText(row es.WaterfallRow, nameWidth, serviceWidth, durationWidth int) string {
+	marker := "  "
+	if row.HasChildren {
+		marker = "- "
+		if row.Collapsed {
+			marker = "+ "
+		}
+	}
+
+	name := row.Span.Name
+	if name == "" {
+		name = row.Span.GetMessage()
+	}
+	if name == "" {
+		name = "unnamed"
+	}
+	if row.Collapsed {
+		name = fmt.Sprintf("%s (+%d)", name, row.Hidden)
+	}
+
+	service := row.Span.ServiceName
+	if service == "" {
+		service = "-"
+	}
+
+	return PadOrTruncate(strings.Repeat("  ", row.Depth)+marker+name, nameWidth) + " " +
+		PadOrTruncate(service, serviceWidth) + " " +
+		PadLeft(es.FormatSpanDuration(row.Span.Duration), durationWidth)
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elastic/elasticat/internal/config"
+)
+
+// renderSavedViews lists the saved views with what each one filters on.
+func (m Model) renderSavedViews(listHeight int) string {
+	views := m.SavedViews.Views
+	var lines []string
+
+	lines = append(lines, HeaderRowStyle.Render(PadOrTruncate(
+		fmt.Sprintf("Saved views (%d)", len(views)), m.UI.Width-8)))
+
+	// Leave room for the header lines and the prompt or hint below the list
+	rowsHeight := listHeight - 4
+	switch {
+	case m.SavedViews.Err != nil:
+		lines = append(lines, ErrorStyle.Render(fmt.Sprintf("Error: %v", m.SavedViews.Err)))
+	case len(views) == 0:
+		lines = append(lines, LoadingStyle.Render("No saved views yet. Press S to save the current filters and columns as a view."))
+	default:
+		// NAME (24) | SIGNAL (8) | SOURCE (8) | DEFINITION (flex)
+		nameWidth, signalWidth, sourceWidth := 24, 8, 8
+		defWidth := m.UI.Width - nameWidth - signalWidth - sourceWidth - 13
+		if defWidth < 10 {
+			defWidth = 10
+		}
+		lines = append(lines, HeaderRowStyle.Render(
+			PadOrTruncate("NAME", nameWidth)+" "+
+				PadOrTruncate("SIGNAL", signalWidth)+" "+
+				PadOrTruncate("SOURCE", sourceWidth)+" "+
+				PadOrTruncate("DEFINITION", defWidth)))
+
+		userPath, _ := config.GetViewsPath()
+		startIdx, endIdx := calcVisibleRange(m.SavedViews.Cursor, len(views), rowsHeight)
+		for i := startIdx; i < endIdx; i++ {
+			v := views[i]
+			source := "user"
+			if v.Source != userPath {
+				source = "project"
+			}
+			signal := v.Signal
+			if signal == "" {
+				signal = "-"
+			}
+			line := PadOrTruncate(v.Name, nameWidth) + " " +
+				PadOrTruncate(signal, signalWidth) + " " +
+				PadOrTruncate(source, sourceWidth) + " " +
+				PadOrTruncate(savedViewSummary(v), defWidth)
+			if i == m.SavedViews.Cursor {
+				lines = append(lines, SelectedLogStyle.Width(m.UI.Width-6).Render(line))
+			} else {
+				lines = append(lines, LogEntryStyle.Render(line))
+			}
+		}
+	}
+
+	lines = append(lines, "")
+	switch {
+	case m.SavedViews.Naming && m.SavedViews.Renaming != "":
+		lines = append(lines, StatusKeyStyle.Render("Rename "+m.SavedViews.Renaming+" to: ")+m.SavedViews.NameInput.View())
+	case m.SavedViews.Naming:
+		lines = append(lines, StatusKeyStyle.Render("Save current view as: ")+m.SavedViews.NameInput.View())
+	default:
+		lines = append(lines, DetailMutedStyle.Render("enter to open • S to save the current view • R to rename • esc to close"))
+	}
+
+	return LogListStyle.Width(m.UI.Width - 4).Height(listHeight).Render(strings.Join(lines, "\n"))
+}
+
+// savedViewSummary describes a view's query and filters on one line.
+func savedViewSummary(v config.View) string {
+	if v.ESQL != "" {
+		return "ES|QL: " + singleLine(v.ESQL)
+	}
+
+	var parts []string
+	if v.Query != "" {
+		parts = append(parts, fmt.Sprintf("%q", v.Query))
+	}
+	if v.Service != "" {
+		parts = append(parts, negatedPrefix(v.NegateService)+"service: "+v.Service)
+	}
+	if v.Resource != "" {
+		parts = append(parts, negatedPrefix(v.NegateResource)+"env: "+v.Resource)
+	}
+	if v.Level != "" {
+		parts = append(parts, "level: "+v.Level)
+	}
+	for _, f := range v.Filters {
+		parts = append(parts, f.String())
+	}
+	if v.Lookback != "" {
+		parts = append(parts, "last "+v.Lookback)
+	}
+	if len(v.Fields) > 0 {
+		parts = append(parts, fmt.Sprintf("%d columns", len(v.Fields)))
+	}
+	if len(parts) == 0 {
+		return "(no filters)"
+	}
+	return strings.Join(parts, " • ")
+}
+
+func negatedPrefix(negate bool) string {
+	if negate {
+		return "NOT "
+	}
+	return ""
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+// volumeShades are the bar heights of the volume histogram, empty first.
+var volumeShades = []rune(" ▁▂▃▄▅▆▇█")
+
+// volumeReadoutWidth is the space reserved to the right of the bars.
+const volumeReadoutWidth = 44
+
+// logVolumeBuckets returns how many histogram columns fit the terminal.
+func (m Model) logVolumeBuckets() int {
+	n := m.UI.Width - volumeReadoutWidth - 6
+	if n < 10 {
+		n = 10
+	}
+	if n > logVolumeTarget {
+		n = logVolumeTarget
+	}
+	return n
+}
+
+// renderLogVolume draws the log volume histogram as a single row of block
+// characters, one per bucket, followed by a readout of the selected bucket.
+func (m Model) renderLogVolume() string {
+	width := m.UI.Width - 4
+	if m.logVolumeBucketCount() == 0 {
+		label := "volume: no data"
+		switch {
+		case m.Volume.Loading:
+			label = "volume: loading..."
+		case m.Volume.Err != nil:
+			return " " + ErrorStyle.Render(PadOrTruncate("volume: "+m.Volume.Err.Error(), width))
+		}
+		return " " + LoadingStyle.Render(PadOrTruncate(label, width))
+	}
+
+	hist := m.Volume.Histogram
+	barWidth := width - volumeReadoutWidth
+	if barWidth < 10 {
+		barWidth = 10
+	}
+	start, end := volumeVisibleRange(m.Volume.Cursor, len(hist.Buckets), barWidth)
+
+	maxCount := volumeMax(hist.Buckets)
+	var bars strings.Builder
+	for i := start; i < end; i++ {
+		b := hist.Buckets[i]
+		style := volumeStyle(b)
+		if m.Volume.Focused && i == m.Volume.Cursor {
+			style = style.Reverse(true)
+		}
+		bars.WriteString(style.Render(string(volumeShade(b.Total(), maxCount))))
+	}
+	bars.WriteString(strings.Repeat(" ", barWidth-(end-start)))
+
+	return " " + bars.String() + "  " + m.volumeReadout()
+}
+
+// volumeReadout describes the selected bucket when focused, else the histogram.
+func (m Model) volumeReadout() string {
+	hist := m.Volume.Histogram
+	if !m.Volume.Focused {
+		var total int64
+		for _, b := range hist.Buckets {
+			total += b.Total()
+		}
+		return LoadingStyle.Render(PadOrTruncate(
+			fmt.Sprintf("%d logs, %s buckets", total, hist.Interval), volumeReadoutWidth-2))
+	}
+
+	b := hist.Buckets[m.Volume.Cursor]
+	window := formatTimeWindow(b.Start, b.Start.Add(hist.Interval))
+	counts := fmt.Sprintf(" %d", b.Total())
+	if b.Error > 0 {
+		counts += VolumeErrorStyle.Render(fmt.Sprintf(" %de", b.Error))
+	}
+	if b.Warn > 0 {
+		counts += VolumeWarnStyle.Render(fmt.Sprintf(" %dw", b.Warn))
+	}
+	return StatusValueStyle.Render(window) + counts
+}
+
+// volumeVisibleRange keeps the newest buckets in view unless the cursor has
+// moved further back.
+func volumeVisibleRange(cursor, count, width int) (int, int) {
+	start := count - width
+	if start < 0 {
+		start = 0
+	}
+	if cursor < start {
+		start = cursor
+	}
+	end := start + width
+	if end > count {
+		end = count
+	}
+	return start, end
+}
+
+// volumeStyle colours a bucket by the most severe level it contains.
+func volumeStyle(b es.VolumeBucket) lipgloss.Style {
+	switch {
+	case b.Error > 0:
+		return VolumeErrorStyle
+	case b.Warn > 0:
+		return VolumeWarnStyle
+	default:
+		return VolumeOtherStyle
+	}
+}
+
+// volumeShade maps a bucket count to a bar height; non-empty buckets always
+// get at least the lowest bar.
+func volumeShade(count, maxCount int64) rune {
+	if count <= 0 || maxCount <= 0 {
+		return volumeShades[0]
+	}
+	top := int64(len(volumeShades) - 1)
+	idx := (count*top + maxCount - 1) / maxCount
+	if idx < 1 {
+		idx = 1
+	}
+	if idx > top {
+		idx = top
+	}
+	return volumeShades[idx]
+}
+
+func volumeMax(buckets []es.VolumeBucket) int64 {
+	var maxCount int64
+	for _, b := range buckets {
+		if b.Total() > maxCount {
+			maxCount = b.Total()
+		}
+	}
+	return maxCount
+}
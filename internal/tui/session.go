@@ -0,0 +1,121 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+// timeDisplayNames are the names time display modes are saved by.
+var timeDisplayNames = map[TimeDisplayMode]string{
+	timeDisplayClock:    "clock",
+	timeDisplayRelative: "relative",
+	timeDisplayFull:     "full",
+}
+
+// traceLevelNames are the names trace navigation levels are saved by.
+var traceLevelNames = map[TraceViewLevel]string{
+	traceViewNames:        "names",
+	traceViewTransactions: "transactions",
+	traceViewSpans:        "spans",
+}
+
+// Session captures where the visible tab was left, for the next start to
+// restore. During a log <-> trace jump it is the view jumped from, since the
+// jump's way back isn't kept.
+func (m Model) Session() config.Session {
+	filters, display, traces := m.Filters, m.Fields.Display, m.Traces
+	for _, ctx := range m.UI.ViewStack {
+		if ctx.Signal != nil {
+			filters, display, traces = ctx.Signal.Filters, ctx.Signal.Display, ctx.Signal.Traces
+			break
+		}
+	}
+
+	s := config.Session{
+		Signal:         strings.ToLower(filters.Signal.String()),
+		Query:          filters.Query,
+		Lookback:       filters.Lookback.String(),
+		Service:        filters.Service,
+		NegateService:  filters.NegateService,
+		Resource:       filters.Resource,
+		NegateResource: filters.NegateResource,
+		Level:          filters.Level,
+		Pattern:        filters.Pattern,
+		From:           filters.From,
+		To:             filters.To,
+		Filters:        append([]es.FieldFilter(nil), filters.FieldFilters...),
+		Fields:         config.ViewFieldsFrom(display),
+		TimeDisplay:    timeDisplayNames[m.UI.TimeDisplayMode],
+		SortAscending:  m.UI.SortAscending,
+	}
+	if !filters.Exception.IsZero() {
+		s.ExceptionType = filters.Exception.Type
+		s.ExceptionKey = filters.Exception.Key
+		s.ExceptionBy = filters.Exception.By.String()
+	}
+	if filters.Signal == signalTraces {
+		s.TraceLevel = traceLevelNames[traces.ViewLevel]
+		s.TraceName = traces.SelectedTxName
+		s.TraceID = traces.SelectedTraceID
+	}
+	return s
+}
+
+// setSession restores a session saved by an earlier run without fetching
+// anything. Its lookback is kept rather than auto-detected.
+func (m *Model) setSession(s config.Session) {
+	m.setSavedView(config.View{
+		Signal:         s.Signal,
+		Query:          s.Query,
+		Lookback:       s.Lookback,
+		Service:        s.Service,
+		NegateService:  s.NegateService,
+		Resource:       s.Resource,
+		NegateResource: s.NegateResource,
+		Level:          s.Level,
+		Filters:        s.Filters,
+		Fields:         s.Fields,
+	})
+	m.Filters.Pattern = s.Pattern
+	m.Filters.From, m.Filters.To = s.From, s.To
+	if s.ExceptionType != "" {
+		m.Filters.Exception = es.ExceptionFilter{Type: s.ExceptionType, Key: s.ExceptionKey}
+		if s.ExceptionBy == es.ExceptionsByFrame.String() {
+			m.Filters.Exception.By = es.ExceptionsByFrame
+		}
+	}
+
+	for mode, name := range timeDisplayNames {
+		if name == s.TimeDisplay {
+			m.UI.TimeDisplayMode = mode
+		}
+	}
+	m.UI.SortAscending = s.SortAscending
+
+	m.UI.Mode = initialModeFor(m.Filters.Signal)
+	if m.Filters.Signal != signalTraces {
+		return
+	}
+	for level, name := range traceLevelNames {
+		if name == s.TraceLevel {
+			m.Traces.ViewLevel = level
+		}
+	}
+	switch {
+	case m.Traces.ViewLevel == traceViewSpans && s.TraceID != "":
+		m.Traces.SelectedTxName = s.TraceName
+		m.Traces.SelectedTraceID = s.TraceID
+		m.UI.Mode = viewLogs
+	case m.Traces.ViewLevel != traceViewNames && s.TraceName != "":
+		m.Traces.ViewLevel = traceViewTransactions
+		m.Traces.SelectedTxName = s.TraceName
+		m.UI.Mode = viewLogs
+	default:
+		m.Traces.ViewLevel = traceViewNames
+	}
+}
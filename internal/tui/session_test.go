@@ -0,0 +1,71 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es"
+	"github.com/elastic/elasticat/internal/es/shared"
+)
+
+func TestSession(t *testing.T) {
+	newModel := func() Model {
+		m, _ := newTestModel(signalLogs, viewLogs)
+		m.Filters.Lookback = lookback24h
+		return m
+	}
+
+	// Traces left at the transactions level, narrowed down and resorted
+	m := newModel()
+	m.Filters = FilterState{
+		Signal:       signalTraces,
+		Lookback:     lookback1h,
+		Service:      "checkout",
+		FieldFilters: []es.FieldFilter{{Field: "http.status", Op: shared.FieldEquals, Value: "500"}},
+	}
+	m.Fields.Display = DefaultFields(signalTraces)
+	m.Traces = TracesState{ViewLevel: traceViewTransactions, SelectedTxName: "GET /cart"}
+	m.UI.TimeDisplayMode = timeDisplayFull
+	m.UI.SortAscending = true
+
+	s := m.Session()
+	if s.Signal != "traces" || s.Lookback != "1h" || s.TraceLevel != "transactions" || s.TraceName != "GET /cart" || s.TimeDisplay != "full" {
+		t.Fatalf("unexpected session: %+v", s)
+	}
+
+	restored := newModel()
+	restored.setSession(s)
+	if restored.Filters.Signal != signalTraces || restored.Filters.Lookback != lookback1h || !restored.Filters.LookbackPinned {
+		t.Errorf("expected a pinned 1h traces lookback, got %+v", restored.Filters)
+	}
+	if restored.Filters.Service != "checkout" || !slices.Equal(restored.Filters.FieldFilters, m.Filters.FieldFilters) {
+		t.Errorf("filters not restored: %+v", restored.Filters)
+	}
+	if restored.Traces.ViewLevel != traceViewTransactions || restored.Traces.SelectedTxName != "GET /cart" || restored.UI.Mode != viewLogs {
+		t.Errorf("expected the transactions level, got level %v name %q mode %v", restored.Traces.ViewLevel, restored.Traces.SelectedTxName, restored.UI.Mode)
+	}
+	if restored.UI.TimeDisplayMode != timeDisplayFull || !restored.UI.SortAscending {
+		t.Errorf("display settings not restored: time %v ascending %v", restored.UI.TimeDisplayMode, restored.UI.SortAscending)
+	}
+
+	// A spans level without its trace falls back to the names
+	s.TraceLevel, s.TraceID = "spans", ""
+	s.TraceName = ""
+	restored = newModel()
+	restored.setSession(s)
+	if restored.Traces.ViewLevel != traceViewNames || restored.UI.Mode != viewTraceNames {
+		t.Errorf("expected the names level, got level %v mode %v", restored.Traces.ViewLevel, restored.UI.Mode)
+	}
+
+	// During a signal jump the view jumped from is saved
+	m = newModel()
+	m.Filters.Query = "timeout"
+	m.UI.ViewStack = []ViewContext{{Mode: viewLogs, Signal: &signalContext{Filters: m.Filters, Display: m.Fields.Display}}}
+	m.Filters = FilterState{Signal: signalTraces, Lookback: lookback24h, TraceID: "abc"}
+	if s := m.Session(); s.Signal != "logs" || s.Query != "timeout" || s.TraceLevel != "" {
+		t.Errorf("expected the jump origin, got %+v", s)
+	}
+}
@@ -6,23 +6,34 @@ package tui
 import (
 	"time"
 
+	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
+	"github.com/elastic/elasticat/internal/config"
 	"github.com/elastic/elasticat/internal/es"
 	"github.com/elastic/elasticat/internal/es/metrics"
+	"github.com/elastic/elasticat/internal/es/patterns"
 	"github.com/elastic/elasticat/internal/es/traces"
 )
 
 // FilterState holds all active filters.
 type FilterState struct {
-	Level          string // Level/severity filter
-	Query          string // Free-text search query
-	Service        string // Active service filter
-	NegateService  bool   // If true, exclude Service
-	Resource       string // Active resource filter
-	NegateResource bool   // If true, exclude Resource
+	Level          string             // Level/severity filter
+	Query          string             // Free-text search query
+	Service        string             // Active service filter
+	NegateService  bool               // If true, exclude Service
+	Resource       string             // Active resource filter
+	NegateResource bool               // If true, exclude Resource
+	Pattern        string             // Message pattern filter (CATEGORIZE key)
+	Exception      es.ExceptionFilter // Exception group filter (drill-down from the errors view)
+	TraceID        string             // Trace filter for logs (set when jumping from a span)
+	SpanID         string             // Span filter for logs (set when jumping from a span)
+	From           time.Time          // Narrowed window start (set from the volume histogram; zero = Lookback)
+	To             time.Time          // Narrowed window end
+	FieldFilters   []es.FieldFilter   // Field predicates, in the order they were added
 	Signal         SignalType
 	Lookback       LookbackDuration
+	LookbackPinned bool // Lookback set by a saved view; skips auto-detection
 }
 
 // UIState holds general UI state shared across views.
@@ -50,10 +61,69 @@ type QueryState struct {
 
 // LogsState holds log list state.
 type LogsState struct {
-	Entries         []es.LogEntry // Current log entries
-	SelectedIndex   int           // Selected log index
-	UserHasScrolled bool          // User manually scrolled
-	Total           int64         // Total matching documents
+	Entries         []es.LogEntry  // Current log entries
+	SelectedIndex   int            // Selected log index
+	UserHasScrolled bool           // User manually scrolled
+	Total           int64          // Total matching documents
+	Live            *es.TailCursor // Where live tail polls from; nil when not live-tailing
+	Unseen          int            // Entries live tail appended below a scrolled-away cursor
+	SelectID        string         // Document to select once the list loads (jump to a bookmark)
+	Context         *ContextState  // Set while the list shows the entries around one entry
+}
+
+// ContextState describes the entries shown around an anchor entry, like grep -C.
+type ContextState struct {
+	Anchor      es.LogEntry
+	AllServices bool // Include other services and containers than the anchor's
+}
+
+// VolumeState holds the log volume histogram shown above the log list.
+type VolumeState struct {
+	Histogram *es.VolumeHistogram // Document counts per time bucket and level
+	Cursor    int                 // Selected bucket
+	Focused   bool                // Cursor keys move the bucket cursor instead of the list
+	Loading   bool                // Fetching the histogram
+	Key       string              // Filters and time window the histogram was fetched for
+	Err       error               // Last fetch failure, shown in place of the histogram
+}
+
+// FilterPickerState holds the filter-by-value picker opened from the detail view.
+type FilterPickerState struct {
+	Fields []docField // Field/value pairs of the inspected document
+	Cursor int        // Selected pair
+}
+
+// ChipsState holds the focus of the field filter chips in the status bar.
+type ChipsState struct {
+	Focused bool // Cursor keys select a chip instead of moving the list
+	Cursor  int  // Selected chip
+}
+
+// LayoutState holds the split layout of the log list, which shows the
+// selected entry's detail beside or below the list.
+type LayoutState struct {
+	Split bool // Show the detail pane
+	Ratio int  // Percentage of the split given to the list
+}
+
+// TabsState holds the tabs. The visible tab's state lives in the Model
+// itself; hidden tabs keep theirs until they are shown again.
+type TabsState struct {
+	Tabs      []Tab           // Open tabs, in display order
+	Active    int             // Index of the visible tab
+	NextID    int             // ID given to the next tab
+	Renaming  bool            // Prompting for a name for the visible tab
+	NameInput textinput.Model // Name prompt
+}
+
+// Tab is a workspace with its own signal, filters, lookback, cursor and
+// view stack.
+type Tab struct {
+	ID     int
+	Name   string    // Set by renaming; empty = named after the signal
+	Unread int64     // New documents counted while the tab is hidden
+	SeenAt time.Time // When the tab was last visible
+	saved  tabContext
 }
 
 // FieldsState holds field selection state.
@@ -64,6 +134,16 @@ type FieldsState struct {
 	Loading    bool           // Loading field caps
 	SearchMode bool           // In search mode within fields
 	Search     string         // Search filter for fields
+	Facets     FacetState     // Top values of the highlighted field
+}
+
+// FacetState holds the top-values panel of the field selector.
+type FacetState struct {
+	Field   string          // Field the facets were requested for
+	Result  *es.FieldFacets // Top values; nil until loaded
+	Cursor  int             // Selected value
+	Focused bool            // Keys go to the panel instead of the field list
+	Loading bool            // Facets request in flight
 }
 
 // MetricsState holds metrics dashboard state.
@@ -75,8 +155,19 @@ type MetricsState struct {
 	DetailDocs         []es.LogEntry             // Detail view documents
 	DetailDocCursor    int                       // Current detail doc index
 	DetailDocsLoading  bool                      // Loading detail docs
-	NameFilter         string                    // Filter metrics by name (local filter)
+	LoadingMore        bool                      // Loading the next page of metrics
+	NameFilter         string                    // Filter metrics by name (applied before paging)
+	Marked             []string                  // Metrics marked for comparison, in marking order
 	SelectedMetricName string                    // Name of selected metric (for detail view)
+	BreakdownMetric    string                    // Metric the dimensions and breakdown belong to
+	Dimensions         []metrics.Dimension       // Attributes the detail metric can be grouped by
+	GroupBy            string                    // Active group-by dimension ("" = single series)
+	Breakdown          *metrics.AggregatedMetric // Detail metric split by GroupBy
+	BreakdownLoading   bool                      // Loading dimensions or breakdown
+
+	DistributionMetric  string                         // Histogram metric the distribution belongs to
+	Distribution        *metrics.HistogramDistribution // Percentiles and heatmap of a histogram detail metric
+	DistributionLoading bool                           // Loading the distribution
 }
 
 // TracesState holds traces navigation state.
@@ -91,6 +182,49 @@ type TracesState struct {
 	SpansLoading       bool                        // Loading spans
 	LastFetchedTraceID string                      // De-dupe span fetches
 	NameFilter         string                      // Filter transaction names (local filter)
+	SpanTree           []*es.SpanNode              // Span tree for the waterfall (traceViewSpans)
+	Waterfall          []es.WaterfallRow           // Visible waterfall rows, parallel to Logs.Entries
+	Collapsed          map[string]bool             // Span IDs with collapsed subtrees
+}
+
+// PatternsState holds log pattern categorization state.
+type PatternsState struct {
+	Items   []patterns.PatternAgg // Patterns ordered by count
+	Cursor  int                   // Selected pattern
+	Loading bool                  // Loading patterns
+}
+
+// ExceptionsState holds the exception groups of the errors view.
+type ExceptionsState struct {
+	Result  *es.ExceptionGroups // Groups ordered by count, with their sparkline buckets
+	By      es.ExceptionGroupBy // Masked message or top stack frame
+	Cursor  int                 // Selected group
+	Loading bool                // Loading groups
+}
+
+// CompareState holds the multi-metric comparison view state.
+type CompareState struct {
+	Names   []string                   // Compared metrics, in the order they were marked
+	Metrics []metrics.AggregatedMetric // Aggregated metrics, in Names order
+	Times   []time.Time                // Union of the metrics' bucket timestamps
+	Cursor  int                        // Selected timestamp in Times
+	Stacked bool                       // Small multiples instead of a normalized overlay
+	Loading bool                       // Loading the metrics
+}
+
+// LatencyState holds the latency distribution view state.
+type LatencyState struct {
+	Tx        traces.TransactionNameAgg // Transaction name being inspected
+	Histogram *traces.LatencyHistogram  // Bucketed duration distribution
+	Cursor    int                       // Selected bucket
+	Loading   bool                      // Loading histogram or example trace
+}
+
+// ServiceMapState holds the service dependency view state.
+type ServiceMapState struct {
+	Result  *traces.ServiceMapResult // Service dependency graph
+	Cursor  int                      // Selected edge
+	Loading bool                     // Loading service map
 }
 
 // PerspectiveState holds perspective filtering state.
@@ -113,6 +247,51 @@ type ChatState struct {
 	RequestStart    time.Time       // When the current chat request started
 }
 
+// ESQLEditorState holds the ES|QL query editor.
+type ESQLEditorState struct {
+	Input      textarea.Model  // Query being edited
+	Editing    bool            // Keys go to the query instead of the results
+	Running    bool            // Query in flight
+	Ran        string          // Query that produced Result or Err
+	Result     *es.ESQLResult  // Result of the last successful run
+	Err        error           // Failure of the last run
+	Row        int             // Selected result row
+	ColOffset  int             // First visible result column
+	History    []string        // Queries run this session, oldest first
+	HistoryPos int             // Recalled history entry; len(History) when editing a new query
+	Draft      string          // New query kept while recalling history
+	Naming     bool            // Prompting for a name to save the query as a view
+	NameInput  textinput.Model // View name prompt
+	SavedLogs  *LogsState      // Log list to restore on close while result rows stand in for it
+}
+
+// SavedViewsState holds the saved views list.
+type SavedViewsState struct {
+	Views     []config.View   // User views followed by project views
+	Cursor    int             // Selected view
+	Err       error           // Failure loading the views
+	Naming    bool            // Prompting for a name
+	Renaming  string          // View being renamed; empty when saving the current view
+	NameInput textinput.Model // Name prompt
+}
+
+// BookmarksState holds the bookmarks list.
+type BookmarksState struct {
+	Bookmarks []config.Bookmark // In timeline order
+	Cursor    int               // Selected bookmark
+	Err       error             // Failure loading the bookmarks
+	Editing   bool              // Prompting for the note of the selected bookmark
+	NoteInput textinput.Model   // Note prompt
+	Exporting bool              // Fetching the documents for the timeline
+}
+
+// PaletteState holds the command palette.
+type PaletteState struct {
+	Input  textinput.Model // Action filter, or a command and its argument
+	Cursor int             // Selected entry
+	Err    error           // Failure of the last command run
+}
+
 // CredsState holds credentials modal state.
 type CredsState struct {
 	HideModal     bool   // Don't show creds modal after Kibana open
@@ -7,7 +7,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/charmbracelet/lipgloss"
+	"github.com/elastic/elasticat/internal/es/patterns"
 )
 
 // renderStatusBar renders the status bar showing current state and filters
@@ -33,19 +33,50 @@ func (m Model) renderStatusBar() string {
 	}
 	if m.Filters.Service != "" {
 		if m.Filters.NegateService {
-			row1Parts = append(row1Parts, lipgloss.NewStyle().Foreground(lipgloss.Color("#FF6B6B")).Bold(true).Render("⛔ NOT Service: ")+StatusValueStyle.Render(m.Filters.Service))
+			row1Parts = append(row1Parts, excludeChipStyle.Render("⛔ NOT Service: ")+StatusValueStyle.Render(m.Filters.Service))
 		} else {
-			row1Parts = append(row1Parts, lipgloss.NewStyle().Foreground(lipgloss.Color("#04B575")).Bold(true).Render("⚡ Service: ")+StatusValueStyle.Render(m.Filters.Service))
+			row1Parts = append(row1Parts, includeChipStyle.Render("⚡ Service: ")+StatusValueStyle.Render(m.Filters.Service))
 		}
 	}
 	if m.Filters.Resource != "" {
 		if m.Filters.NegateResource {
-			row1Parts = append(row1Parts, lipgloss.NewStyle().Foreground(lipgloss.Color("#FF6B6B")).Bold(true).Render("⛔ NOT Resource: ")+StatusValueStyle.Render(m.Filters.Resource))
+			row1Parts = append(row1Parts, excludeChipStyle.Render("⛔ NOT Resource: ")+StatusValueStyle.Render(m.Filters.Resource))
 		} else {
-			row1Parts = append(row1Parts, lipgloss.NewStyle().Foreground(lipgloss.Color("#04B575")).Bold(true).Render("⚡ Resource: ")+StatusValueStyle.Render(m.Filters.Resource))
+			row1Parts = append(row1Parts, includeChipStyle.Render("⚡ Resource: ")+StatusValueStyle.Render(m.Filters.Resource))
 		}
 	}
 
+	if m.Filters.Signal == signalLogs && m.isTimeNarrowed() {
+		row1Parts = append(row1Parts, StatusKeyStyle.Render("Window: ")+StatusValueStyle.Render(formatTimeWindow(m.Filters.From, m.Filters.To)))
+	}
+
+	if c := m.Logs.Context; c != nil {
+		row1Parts = append(row1Parts, StatusKeyStyle.Render("Context: ")+StatusValueStyle.Render(contextScope(*c)))
+	}
+
+	if m.Filters.Pattern != "" {
+		row1Parts = append(row1Parts, StatusKeyStyle.Render("Pattern: ")+StatusValueStyle.Render(TruncateWithEllipsis(patterns.Template(m.Filters.Pattern), 30)))
+	}
+
+	if !m.Filters.Exception.IsZero() {
+		row1Parts = append(row1Parts, StatusKeyStyle.Render("Error: ")+StatusValueStyle.Render(TruncateWithEllipsis(singleLine(m.Filters.Exception.String()), 40)))
+	}
+
+	if len(m.Filters.FieldFilters) > 0 {
+		row1Parts = append(row1Parts, m.renderFilterChips())
+	}
+
+	if m.Filters.SpanID != "" {
+		row1Parts = append(row1Parts, StatusKeyStyle.Render("Span: ")+StatusValueStyle.Render(TruncateWithEllipsis(m.Filters.SpanID, 16)))
+	} else if m.Filters.TraceID != "" {
+		row1Parts = append(row1Parts, StatusKeyStyle.Render("Trace: ")+StatusValueStyle.Render(TruncateWithEllipsis(m.Filters.TraceID, 16)))
+	}
+
+	// New entries live tail added below the cursor
+	if m.Logs.Unseen > 0 {
+		row1Parts = append(row1Parts, LoadingStyle.Render(fmt.Sprintf("%d new ↓", m.Logs.Unseen)))
+	}
+
 	// Loading indicator
 	if m.UI.Loading {
 		row1Parts = append(row1Parts, LoadingStyle.Render("loading..."))
@@ -53,7 +84,7 @@ func (m Model) renderStatusBar() string {
 
 	// Background chat indicator (show when chat is thinking but user is in another view)
 	if m.UI.Mode != viewChat && m.requests != nil && m.requests.inFlight(requestChat) {
-		row1Parts = append(row1Parts, lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Italic(true).Render("💬 Chat thinking..."))
+		row1Parts = append(row1Parts, chatLoadingStyle.Render("💬 Chat thinking..."))
 	}
 
 	row1 := strings.Join(row1Parts, "  │  ")
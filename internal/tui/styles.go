@@ -9,201 +9,312 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// Colors
+// Colors of the active theme, set by buildStyles
 var (
-	primaryColor   = lipgloss.Color("#7D56F4")
-	secondaryColor = lipgloss.Color("#5A5A5A")
-	successColor   = lipgloss.Color("#04B575")
-	warningColor   = lipgloss.Color("#FFCC00")
-	errorColor     = lipgloss.Color("#FF5F56")
-	infoColor      = lipgloss.Color("#61AFEF")
-	debugColor     = lipgloss.Color("#6C757D")
-	traceColor     = lipgloss.Color("#888888")
-	fgColor        = lipgloss.Color("#E0E0E0")
-	mutedColor     = lipgloss.Color("#6C757D")
+	primaryColor   lipgloss.TerminalColor
+	secondaryColor lipgloss.TerminalColor
+	successColor   lipgloss.TerminalColor
+	warningColor   lipgloss.TerminalColor
+	errorColor     lipgloss.TerminalColor
+	infoColor      lipgloss.TerminalColor
+	fgColor        lipgloss.TerminalColor
+	mutedColor     lipgloss.TerminalColor
 )
 
-// Styles
+// Styles, built from the active theme by buildStyles
 var (
+	AppStyle lipgloss.Style
+
+	HeaderStyle      lipgloss.Style
+	TitleHeaderStyle lipgloss.Style
+
+	StatusBarStyle   lipgloss.Style
+	StatusKeyStyle   lipgloss.Style
+	StatusValueStyle lipgloss.Style
+
+	LogListStyle      lipgloss.Style
+	LogEntryStyle     lipgloss.Style
+	SelectedLogStyle  lipgloss.Style
+	SelectedCellStyle lipgloss.Style
+	AnchorMarkStyle   lipgloss.Style
+	HeaderRowStyle    lipgloss.Style
+	TimestampStyle    lipgloss.Style
+	ServiceStyle      lipgloss.Style
+	ResourceStyle     lipgloss.Style
+	MessageStyle      lipgloss.Style
+
+	SearchStyle       lipgloss.Style
+	SearchPromptStyle lipgloss.Style
+
+	DetailStyle        lipgloss.Style
+	DetailKeyStyle     lipgloss.Style
+	DetailValueStyle   lipgloss.Style
+	DetailMutedStyle   lipgloss.Style
+	CompactDetailStyle lipgloss.Style
+
+	// LogOriginalStyle highlights log.record.original in the detail view
+	LogOriginalStyle lipgloss.Style
+
+	QueryOverlayStyle lipgloss.Style
+	QueryHeaderStyle  lipgloss.Style
+	QueryMethodStyle  lipgloss.Style
+	QueryPathStyle    lipgloss.Style
+	QueryBodyStyle    lipgloss.Style
+
+	HelpStyle        lipgloss.Style
+	HelpKeyStyle     lipgloss.Style
+	HelpDescStyle    lipgloss.Style
+	HelpOverlayStyle lipgloss.Style
+
+	ErrorStyle             lipgloss.Style
+	WaterfallBarStyle      lipgloss.Style
+	WaterfallErrorBarStyle lipgloss.Style
+	LoadingStyle           lipgloss.Style
+
+	// HighlightStyle is used for search match highlighting
+	HighlightStyle lipgloss.Style
+
+	// SparklineStyle is used for metric chart rendering
+	SparklineStyle lipgloss.Style
+
+	// VolumeStyles colour the log volume histogram by the most severe level in a bucket
+	VolumeErrorStyle lipgloss.Style
+	VolumeWarnStyle  lipgloss.Style
+	VolumeOtherStyle lipgloss.Style
+
+	// SeriesStyles colour the lines of multi-series charts, in legend order
+	SeriesStyles []lipgloss.Style
+)
+
+func init() {
+	buildStyles(currentTheme)
+}
+
+// buildStyles sets the colors and styles from a theme.
+func buildStyles(t Theme) {
+	primaryColor = t.Accent
+	secondaryColor = t.Border
+	successColor = t.Success
+	warningColor = t.Levels.Warn
+	errorColor = t.Levels.Error
+	infoColor = t.Info
+	fgColor = t.Text
+	mutedColor = t.Muted
+
 	// App frame
 	AppStyle = lipgloss.NewStyle().
-			Padding(0, 1)
+		Padding(0, 1)
 
 	// Header
 	HeaderStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(primaryColor).
-			Padding(0, 1).
-			MarginBottom(1)
+		Bold(true).
+		Foreground(primaryColor).
+		Padding(0, 1).
+		MarginBottom(1)
 
 	// Title header with cat ASCII art
 	TitleHeaderStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(primaryColor).
-				Padding(0, 1)
+		Bold(true).
+		Foreground(primaryColor).
+		Padding(0, 1)
 
 	// Status bar
 	StatusBarStyle = lipgloss.NewStyle().
-			Foreground(fgColor).
-			Background(lipgloss.Color("#333333")).
-			Padding(0, 1)
+		Foreground(fgColor).
+		Background(t.StatusBar).
+		Padding(0, 1)
 
 	StatusKeyStyle = lipgloss.NewStyle().
-			Foreground(primaryColor).
-			Bold(true)
+		Foreground(primaryColor).
+		Bold(true)
 
 	StatusValueStyle = lipgloss.NewStyle().
-				Foreground(fgColor)
+		Foreground(fgColor)
 
 	// Log list
 	LogListStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(secondaryColor).
-			Padding(0, 1)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(secondaryColor).
+		Padding(0, 1)
 
 	// Log entry styles
 	LogEntryStyle = lipgloss.NewStyle().
-			PaddingLeft(1)
-
-	SelectedLogStyle = lipgloss.NewStyle().
-				Background(lipgloss.Color("#4A4A7A")).
-				Foreground(lipgloss.Color("#FFFFFF")).
-				Bold(true).
-				PaddingLeft(1)
+		PaddingLeft(1)
 
 	// Cell style for selected rows: no padding so column alignment stays fixed
 	SelectedCellStyle = lipgloss.NewStyle().
-				Background(lipgloss.Color("#4A4A7A")).
-				Foreground(lipgloss.Color("#FFFFFF")).
-				Bold(true)
+		Background(t.Selection.Bg).
+		Foreground(t.Selection.Fg).
+		Reverse(t.Reverse).
+		Bold(true)
+
+	SelectedLogStyle = SelectedCellStyle.
+		PaddingLeft(1)
+
+	// Marks the entry a context list is centred on, in place of the row padding
+	AnchorMarkStyle = lipgloss.NewStyle().
+		Foreground(primaryColor).
+		Bold(true)
 
 	// Column header row
 	HeaderRowStyle = lipgloss.NewStyle().
-			Foreground(mutedColor).
-			Bold(true).
-			BorderStyle(lipgloss.NormalBorder()).
-			BorderBottom(true).
-			BorderForeground(secondaryColor).
-			PaddingLeft(1).
-			MarginBottom(0)
+		Foreground(mutedColor).
+		Bold(true).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderBottom(true).
+		BorderForeground(secondaryColor).
+		PaddingLeft(1).
+		MarginBottom(0)
 
 	// Timestamp (width controlled by column layout, not style)
 	TimestampStyle = lipgloss.NewStyle().
-			Foreground(mutedColor)
+		Foreground(mutedColor)
 
 	// Service name (width controlled by column layout, not style)
 	ServiceStyle = lipgloss.NewStyle().
-			Foreground(infoColor).
-			Bold(true)
+		Foreground(infoColor).
+		Bold(true)
 
 	// Resource (OTel resource attributes, width controlled by column layout)
 	ResourceStyle = lipgloss.NewStyle().
-			Foreground(mutedColor)
+		Foreground(mutedColor)
 
 	// Log message
 	MessageStyle = lipgloss.NewStyle().
-			Foreground(fgColor)
+		Foreground(fgColor)
 
 	// Search input
 	SearchStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(primaryColor).
-			Padding(0, 1).
-			MarginTop(1)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(0, 1).
+		MarginTop(1)
 
 	SearchPromptStyle = lipgloss.NewStyle().
-				Foreground(primaryColor).
-				Bold(true)
+		Foreground(primaryColor).
+		Bold(true)
 
 	// Detail panel
 	DetailStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(secondaryColor).
-			Padding(1).
-			MarginTop(1)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(secondaryColor).
+		Padding(1).
+		MarginTop(1)
 
 	DetailKeyStyle = lipgloss.NewStyle().
-			Foreground(primaryColor).
-			Bold(true)
+		Foreground(primaryColor).
+		Bold(true)
 
 	DetailValueStyle = lipgloss.NewStyle().
-				Foreground(fgColor)
+		Foreground(fgColor)
 
 	DetailMutedStyle = lipgloss.NewStyle().
-				Foreground(mutedColor)
+		Foreground(mutedColor)
 
 	// Compact detail panel (bottom of screen)
 	CompactDetailStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(secondaryColor).
-				Padding(0, 1)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(secondaryColor).
+		Padding(0, 1)
 
 	// Query overlay (floating window)
 	QueryOverlayStyle = lipgloss.NewStyle().
-				Border(lipgloss.DoubleBorder()).
-				BorderForeground(primaryColor).
-				Padding(1, 2).
-				Background(lipgloss.Color("#1a1a2e"))
+		Border(lipgloss.DoubleBorder()).
+		BorderForeground(primaryColor).
+		Padding(1, 2).
+		Background(t.Overlay)
 
 	QueryHeaderStyle = lipgloss.NewStyle().
-				Foreground(primaryColor).
-				Bold(true)
+		Foreground(primaryColor).
+		Bold(true)
 
 	QueryMethodStyle = lipgloss.NewStyle().
-				Foreground(successColor).
-				Bold(true)
+		Foreground(successColor).
+		Bold(true)
 
 	QueryPathStyle = lipgloss.NewStyle().
-			Foreground(infoColor)
+		Foreground(infoColor)
 
 	QueryBodyStyle = lipgloss.NewStyle().
-			Foreground(fgColor)
+		Foreground(fgColor)
 
 	// Help bar
 	HelpStyle = lipgloss.NewStyle().
-			Foreground(mutedColor).
-			Padding(0, 1)
+		Foreground(mutedColor).
+		Padding(0, 1)
 
 	HelpKeyStyle = lipgloss.NewStyle().
-			Foreground(primaryColor)
+		Foreground(primaryColor)
 
 	HelpDescStyle = lipgloss.NewStyle().
-			Foreground(mutedColor)
+		Foreground(mutedColor)
 
 	HelpOverlayStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(secondaryColor).
-				Padding(1, 2)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(secondaryColor).
+		Padding(1, 2)
 
 	// Error style
 	ErrorStyle = lipgloss.NewStyle().
-			Foreground(errorColor).
-			Bold(true)
+		Foreground(errorColor).
+		Bold(true)
+
+	// Trace waterfall bars
+	WaterfallBarStyle = lipgloss.NewStyle().
+		Foreground(infoColor)
+
+	WaterfallErrorBarStyle = lipgloss.NewStyle().
+		Foreground(errorColor)
 
 	// Loading style
 	LoadingStyle = lipgloss.NewStyle().
-			Foreground(mutedColor).
-			Italic(true)
-)
+		Foreground(mutedColor).
+		Italic(true)
+
+	HighlightStyle = lipgloss.NewStyle().
+		Background(t.Highlight.Bg).
+		Foreground(t.Highlight.Fg).
+		Underline(t.NoColor).
+		Bold(true)
+
+	SparklineStyle = lipgloss.NewStyle().
+		Foreground(t.Charts[0])
+
+	LogOriginalStyle = lipgloss.NewStyle().Foreground(infoColor)
+
+	VolumeErrorStyle = lipgloss.NewStyle().Foreground(errorColor)
+	VolumeWarnStyle = lipgloss.NewStyle().Foreground(warningColor)
+	VolumeOtherStyle = SparklineStyle
+
+	SeriesStyles = make([]lipgloss.Style, 0, len(t.Charts))
+	for _, c := range t.Charts {
+		SeriesStyles = append(SeriesStyles, lipgloss.NewStyle().Foreground(c))
+	}
+
+	buildChatStyles(t)
+	buildChipStyles(t)
+	buildTabStyles(t)
+}
 
 // LevelStyle returns the appropriate style for a log level
 // Width is controlled by column layout, not by this style
 func LevelStyle(level string) lipgloss.Style {
 	base := lipgloss.NewStyle()
+	bg, fg := currentTheme.Levels, currentTheme.LevelText
 
 	switch level {
 	case "ERROR", "FATAL", "error", "fatal":
-		return base.Foreground(lipgloss.Color("#FFFFFF")).Background(errorColor).Bold(true)
+		return base.Foreground(fg.Error).Background(bg.Error).Bold(true)
 	case "WARN", "WARNING", "warn", "warning":
-		return base.Foreground(lipgloss.Color("#000000")).Background(warningColor)
+		return base.Foreground(fg.Warn).Background(bg.Warn)
 	case "INFO", "info":
-		return base.Foreground(lipgloss.Color("#FFFFFF")).Background(successColor)
+		return base.Foreground(fg.Info).Background(bg.Info)
 	case "DEBUG", "debug":
-		return base.Foreground(fgColor).Background(debugColor)
+		return base.Foreground(fg.Debug).Background(bg.Debug)
 	case "TRACE", "trace":
-		return base.Foreground(fgColor).Background(traceColor)
+		return base.Foreground(fg.Trace).Background(bg.Trace)
 	default:
-		return base.Foreground(fgColor).Background(secondaryColor)
+		return base.Foreground(fg.Other).Background(bg.Other)
 	}
 }
 
@@ -213,16 +324,6 @@ func LevelStyle(level string) lipgloss.Style {
 // - PadOrTruncate()
 // - PadLeft()
 
-// HighlightStyle is used for search match highlighting
-var HighlightStyle = lipgloss.NewStyle().
-	Background(lipgloss.Color("#FFD700")).
-	Foreground(lipgloss.Color("#000000")).
-	Bold(true)
-
-// SparklineStyle is used for metric chart rendering
-var SparklineStyle = lipgloss.NewStyle().
-	Foreground(lipgloss.Color("#61AFEF"))
-
 // ExtractWithHighlight extracts a substring containing the search match and returns
 // the extracted text along with the start/end positions of the match within it.
 // If no match is found, returns the original text truncated normally.
@@ -0,0 +1,439 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elastic/elasticat/internal/es"
+)
+
+var (
+	tabStyle       lipgloss.Style
+	activeTabStyle lipgloss.Style
+)
+
+func buildTabStyles(t Theme) {
+	tabStyle = lipgloss.NewStyle().Foreground(t.Muted).Padding(0, 1)
+	activeTabStyle = lipgloss.NewStyle().
+		Foreground(t.Selection.Fg).
+		Background(t.Selection.Bg).
+		Reverse(t.Reverse).
+		Bold(true).
+		Padding(0, 1)
+}
+
+// tabContext is the state a hidden tab keeps until it is shown again.
+type tabContext struct {
+	Filters       FilterState
+	Index         string
+	Mode          viewMode
+	ViewStack     []ViewContext
+	SortAscending bool
+	LastRefresh   time.Time
+	Query         QueryState
+	Logs          LogsState
+	Volume        VolumeState
+	Chips         ChipsState
+	FilterPicker  FilterPickerState
+	Fields        FieldsState
+	Metrics       MetricsState
+	Traces        TracesState
+	Patterns      PatternsState
+	Exceptions    ExceptionsState
+	ServiceMap    ServiceMapState
+	Latency       LatencyState
+	Compare       CompareState
+	Perspective   PerspectiveState
+	Editor        ESQLEditorState
+	SavedViews    SavedViewsState
+	Bookmarks     BookmarksState
+}
+
+// tabRequests are the request kinds whose responses belong to the visible
+// tab. They are canceled when it is hidden, and the chat keeps its own.
+var tabRequests = []requestKind{
+	requestLogs, requestMetricsAgg, requestMetricsPage, requestMetricDetailDocs,
+	requestMetricBreakdown, requestMetricDistribution, requestMetricCompare,
+	requestTransactionNames, requestSpans, requestPerspective, requestFieldCaps,
+	requestAutoDetect, requestPatterns, requestServiceMap, requestLatency,
+	requestLogVolume, requestFieldFacets, requestESQLEditor, requestExceptions,
+}
+
+// ensureTabs makes the visible state the first tab when no tab was opened yet.
+func (m *Model) ensureTabs() {
+	if len(m.Tabs.Tabs) == 0 {
+		m.Tabs.Tabs = []Tab{{ID: m.Tabs.NextID}}
+		m.Tabs.NextID++
+		m.Tabs.Active = 0
+	}
+}
+
+// saveTab captures the visible tab's state.
+func (m Model) saveTab() tabContext {
+	return tabContext{
+		Filters:       m.Filters,
+		Index:         m.client.GetIndex(),
+		Mode:          m.UI.Mode,
+		ViewStack:     m.UI.ViewStack,
+		SortAscending: m.UI.SortAscending,
+		LastRefresh:   m.UI.LastRefresh,
+		Query:         m.Query,
+		Logs:          m.Logs,
+		Volume:        m.Volume,
+		Chips:         m.Chips,
+		FilterPicker:  m.FilterPicker,
+		Fields:        m.Fields,
+		Metrics:       m.Metrics,
+		Traces:        m.Traces,
+		Patterns:      m.Patterns,
+		Exceptions:    m.Exceptions,
+		ServiceMap:    m.ServiceMap,
+		Latency:       m.Latency,
+		Compare:       m.Compare,
+		Perspective:   m.Perspective,
+		Editor:        m.Editor,
+		SavedViews:    m.SavedViews,
+		Bookmarks:     m.Bookmarks,
+	}
+}
+
+// restoreTab makes a tab's saved state the visible state.
+func (m *Model) restoreTab(ctx tabContext) {
+	m.Filters = ctx.Filters
+	m.client.SetIndex(ctx.Index)
+	m.UI.Mode = ctx.Mode
+	m.UI.ViewStack = ctx.ViewStack
+	m.UI.SortAscending = ctx.SortAscending
+	m.UI.LastRefresh = ctx.LastRefresh
+	m.Query = ctx.Query
+	m.Logs = ctx.Logs
+	m.Volume = ctx.Volume
+	m.Chips = ctx.Chips
+	m.FilterPicker = ctx.FilterPicker
+	m.Fields = ctx.Fields
+	m.Metrics = ctx.Metrics
+	m.Traces = ctx.Traces
+	m.Patterns = ctx.Patterns
+	m.Exceptions = ctx.Exceptions
+	m.ServiceMap = ctx.ServiceMap
+	m.Latency = ctx.Latency
+	m.Compare = ctx.Compare
+	m.Perspective = ctx.Perspective
+	m.Editor = ctx.Editor
+	m.SavedViews = ctx.SavedViews
+	m.Bookmarks = ctx.Bookmarks
+}
+
+// clone copies the state a tab changes in place, so a copied tab doesn't
+// share it with the original.
+func (ctx tabContext) clone() tabContext {
+	ctx.ViewStack = slices.Clone(ctx.ViewStack)
+	ctx.Logs.Entries = slices.Clone(ctx.Logs.Entries) // Live tail appends to them
+	if ctx.Logs.Live != nil {
+		ctx.Logs.Live = ctx.Logs.Live.Clone()
+	}
+	ctx.Filters.FieldFilters = slices.Clone(ctx.Filters.FieldFilters)
+	ctx.Fields.Display = slices.Clone(ctx.Fields.Display)
+	ctx.Metrics.Marked = slices.Clone(ctx.Metrics.Marked)
+	ctx.Traces.Collapsed = maps.Clone(ctx.Traces.Collapsed)
+	ctx.Editor.History = slices.Clone(ctx.Editor.History)
+	return ctx
+}
+
+// clearLoading drops the loading state of requests canceled when the tab
+// was hidden.
+func (ctx *tabContext) clearLoading() {
+	if ctx.Volume.Loading {
+		ctx.Volume.Loading = false
+		ctx.Volume.Key = "" // Refetch when the tab is shown again
+	}
+	ctx.Fields.Loading = false
+	ctx.Fields.Facets.Loading = false
+	ctx.Metrics.Loading = false
+	ctx.Metrics.DetailDocsLoading = false
+	ctx.Metrics.LoadingMore = false
+	ctx.Metrics.BreakdownLoading = false
+	ctx.Metrics.DistributionLoading = false
+	ctx.Traces.Loading = false
+	ctx.Traces.SpansLoading = false
+	ctx.Patterns.Loading = false
+	ctx.Exceptions.Loading = false
+	ctx.ServiceMap.Loading = false
+	ctx.Latency.Loading = false
+	ctx.Compare.Loading = false
+	ctx.Perspective.Loading = false
+	ctx.Editor.Running = false
+	ctx.Bookmarks.Exporting = false
+}
+
+// newTab opens a copy of the visible tab next to it and shows it.
+func (m *Model) newTab() tea.Cmd {
+	m.ensureTabs()
+	tab := Tab{ID: m.Tabs.NextID, saved: m.saveTab().clone()}
+	m.Tabs.NextID++
+	m.Tabs.Tabs = slices.Insert(m.Tabs.Tabs, m.Tabs.Active+1, tab)
+	return m.switchTab(m.Tabs.Active + 1)
+}
+
+// closeTab closes the visible tab and shows its neighbour. The last tab
+// can't be closed.
+func (m *Model) closeTab() tea.Cmd {
+	if len(m.Tabs.Tabs) <= 1 {
+		m.UI.StatusMessage = "Can't close the last tab"
+		m.UI.StatusTime = time.Now()
+		return nil
+	}
+	m.cancelTabRequests()
+	closed := m.Tabs.Active
+	m.Tabs.Tabs = slices.Delete(m.Tabs.Tabs, closed, closed+1)
+	next := min(closed, len(m.Tabs.Tabs)-1)
+	return m.showTab(next)
+}
+
+// cycleTab shows the next (delta 1) or previous (delta -1) tab.
+func (m *Model) cycleTab(delta int) tea.Cmd {
+	n := len(m.Tabs.Tabs)
+	if n <= 1 {
+		return nil
+	}
+	return m.switchTab((m.Tabs.Active + delta + n) % n)
+}
+
+// switchTab hides the visible tab and shows another one.
+func (m *Model) switchTab(i int) tea.Cmd {
+	if i == m.Tabs.Active {
+		return nil
+	}
+	m.cancelTabRequests()
+	m.Tabs.Tabs[m.Tabs.Active].saved = m.saveTab()
+	m.Tabs.Tabs[m.Tabs.Active].SeenAt = time.Now()
+	m.Tabs.Tabs[m.Tabs.Active].Unread = 0
+	return m.showTab(i)
+}
+
+// showTab restores a tab's state and refreshes the data of its view.
+func (m *Model) showTab(i int) tea.Cmd {
+	m.Tabs.Active = i
+	tab := &m.Tabs.Tabs[i]
+	tab.Unread = 0
+	tab.saved.clearLoading()
+	m.restoreTab(tab.saved)
+	tab.saved = tabContext{}
+	m.UI.Loading = false
+	m.UI.Err = nil
+
+	switch m.UI.Mode {
+	case viewLogs:
+		m.UI.Loading = true
+		return m.fetchLogs()
+	case viewMetricsDashboard, viewTraceNames:
+		return m.fetchCurrentViewData()
+	case viewDetail, viewDetailJSON:
+		m.updateDetailContent()
+	}
+	return nil
+}
+
+// cancelTabRequests drops the visible tab's requests in flight, so their
+// responses don't land in the tab shown next.
+func (m *Model) cancelTabRequests() {
+	for _, kind := range tabRequests {
+		m.requests.cancel(kind)
+	}
+}
+
+// startRenameTab prompts for a name for the visible tab.
+func (m *Model) startRenameTab() tea.Cmd {
+	m.ensureTabs()
+	input := textinput.New()
+	input.Placeholder = "tab name"
+	input.CharLimit = 32
+	input.Width = 20
+	input.SetValue(m.Tabs.Tabs[m.Tabs.Active].Name)
+	input.CursorEnd()
+	m.Tabs.NameInput = input
+	m.Tabs.Renaming = true
+	return m.Tabs.NameInput.Focus()
+}
+
+// handleTabNameKey handles the rename prompt. An empty name goes back to
+// naming the tab after its signal.
+func (m Model) handleTabNameKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.Tabs.Tabs[m.Tabs.Active].Name = strings.TrimSpace(m.Tabs.NameInput.Value())
+		fallthrough
+	case "esc":
+		m.Tabs.Renaming = false
+		m.Tabs.NameInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.Tabs.NameInput, cmd = m.Tabs.NameInput.Update(msg)
+	return m, cmd
+}
+
+// handleTabAction handles the tab keys, which work in every view that isn't
+// taking text or showing a modal. Returns (model, cmd, handled).
+func (m Model) handleTabAction(action Action) (Model, tea.Cmd, bool) {
+	if m.isTextInputActive() || m.isModalView() || m.UI.Mode == viewESQL {
+		return m, nil, false
+	}
+	switch action {
+	case ActionNewTab:
+		return m, m.newTab(), true
+	case ActionCloseTab:
+		return m, m.closeTab(), true
+	case ActionNextTab:
+		return m, m.cycleTab(1), true
+	case ActionPrevTab:
+		return m, m.cycleTab(-1), true
+	case ActionRenameTab:
+		return m, m.startRenameTab(), true
+	}
+	return m, nil, false
+}
+
+// tabLabel names a tab after its signal unless it was renamed.
+func (m Model) tabLabel(i int) string {
+	tab := m.Tabs.Tabs[i]
+	if tab.Name != "" {
+		return tab.Name
+	}
+	signal := tab.saved.Filters.Signal
+	if i == m.Tabs.Active {
+		signal = m.Filters.Signal
+	}
+	return strings.ToLower(signal.String())
+}
+
+// renderTabs renders the tab strip shown in the title header, or nothing
+// while there is a single tab. Labels shrink to the tab numbers when the
+// strip is wider than maxWidth.
+func (m Model) renderTabs(maxWidth int) string {
+	if len(m.Tabs.Tabs) <= 1 && !m.Tabs.Renaming {
+		return ""
+	}
+
+	render := func(short bool) string {
+		parts := make([]string, len(m.Tabs.Tabs))
+		for i, tab := range m.Tabs.Tabs {
+			label := fmt.Sprintf("%d", i+1)
+			switch {
+			case i == m.Tabs.Active && m.Tabs.Renaming:
+				label += " " + m.Tabs.NameInput.View()
+			case !short:
+				label += " " + m.tabLabel(i)
+			}
+			if tab.Unread > 0 {
+				label += fmt.Sprintf(" (%s)", formatUnread(tab.Unread))
+			}
+			if i == m.Tabs.Active {
+				parts[i] = activeTabStyle.Render(label)
+			} else {
+				parts[i] = tabStyle.Render(label)
+			}
+		}
+		return strings.Join(parts, " ")
+	}
+
+	strip := render(false)
+	if lipgloss.Width(strip) > maxWidth {
+		strip = render(true)
+	}
+	if lipgloss.Width(strip) > maxWidth {
+		return ""
+	}
+	return strip
+}
+
+// formatUnread shortens large unread counts.
+func formatUnread(n int64) string {
+	if n > 999 {
+		return "999+"
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+// fetchTabUnread counts the documents that arrived in the hidden tabs since
+// they were last visible. Only log and trace lists are counted, and not while
+// narrowed to a time window, which new documents never fall into.
+func (m *Model) fetchTabUnread() tea.Cmd {
+	type countReq struct {
+		id    int
+		index string
+		query string
+		opts  es.SearchOptions
+	}
+	var reqs []countReq
+	for i, tab := range m.Tabs.Tabs {
+		ctx := tab.saved
+		if i == m.Tabs.Active || !ctx.Filters.From.IsZero() {
+			continue
+		}
+		opts := es.SearchOptions{
+			Service:        ctx.Filters.Service,
+			NegateService:  ctx.Filters.NegateService,
+			Resource:       ctx.Filters.Resource,
+			NegateResource: ctx.Filters.NegateResource,
+			Level:          ctx.Filters.Level,
+			SearchFields:   CollectSearchFields(ctx.Fields.Display),
+			From:           tab.SeenAt,
+			Pattern:        ctx.Filters.Pattern,
+			Exception:      ctx.Filters.Exception,
+			FieldFilters:   ctx.Filters.FieldFilters,
+		}
+		switch ctx.Filters.Signal {
+		case signalLogs:
+			opts.TraceID = ctx.Filters.TraceID
+			opts.SpanID = ctx.Filters.SpanID
+		case signalTraces:
+			opts.ProcessorEvent = "transaction"
+		default:
+			continue
+		}
+		reqs = append(reqs, countReq{id: tab.ID, index: ctx.Index, query: ctx.Filters.Query, opts: opts})
+	}
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	return func() tea.Msg {
+		ctx, done := m.startRequest(requestTabUnread, m.tuiConfig.LogsTimeout)
+		defer done()
+
+		counts := make(map[int]int64, len(reqs))
+		for _, r := range reqs {
+			count, _, err := m.client.CountSearchESQL(ctx, r.index, r.query, r.opts)
+			if err != nil {
+				return tabUnreadMsg{err: err}
+			}
+			counts[r.id] = count
+		}
+		return tabUnreadMsg{counts: counts}
+	}
+}
+
+// handleTabUnreadMsg updates the unread counters. Counting is best effort,
+// so failures are not reported.
+func (m Model) handleTabUnreadMsg(msg tabUnreadMsg) (Model, tea.Cmd) {
+	if msg.err != nil {
+		return m, nil
+	}
+	for i := range m.Tabs.Tabs {
+		if count, ok := msg.counts[m.Tabs.Tabs[i].ID]; ok && i != m.Tabs.Active {
+			m.Tabs.Tabs[i].Unread = count
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/elastic/elasticat/internal/es"
+)
+
+func TestTabsKeepTheirOwnState(t *testing.T) {
+	m, src := newTestModel(signalLogs, viewLogs)
+	src.count = 7
+	m.UI.Width, m.UI.AutoRefresh = 200, true
+	m.Filters = FilterState{Signal: signalLogs, Service: "gateway", Level: "ERROR", Lookback: lookback1h}
+	m.Logs = LogsState{
+		Entries:       []es.LogEntry{{Body: "first"}, {Body: "boom"}},
+		SelectedIndex: 1,
+	}
+
+	press(t, &m, "ctrl+t")
+	if len(m.Tabs.Tabs) != 2 || m.Tabs.Active != 1 {
+		t.Fatalf("after ctrl+t: %d tabs, active %d; want 2 tabs, active 1", len(m.Tabs.Tabs), m.Tabs.Active)
+	}
+	if m.Filters.Service != "gateway" {
+		t.Errorf("new tab filters = %+v, want a copy of the first tab's", m.Filters)
+	}
+
+	// Switching the signal in the new tab leaves the first one alone
+	press(t, &m, "m")
+	if m.Filters.Signal != signalTraces || src.index != signalTraces.IndexPattern() {
+		t.Fatalf("signal = %v on %s, want traces", m.Filters.Signal, src.index)
+	}
+	press(t, &m, "shift+tab")
+	if m.Tabs.Active != 0 || m.Filters.Signal != signalLogs || m.Filters.Service != "gateway" || m.Filters.Lookback != lookback1h {
+		t.Errorf("first tab not restored: active %d, filters %+v", m.Tabs.Active, m.Filters)
+	}
+	if src.index != signalLogs.IndexPattern() || m.UI.Mode != viewLogs || m.Logs.SelectedIndex != 1 {
+		t.Errorf("first tab not restored: index %s, mode %v, cursor %d", src.index, m.UI.Mode, m.Logs.SelectedIndex)
+	}
+
+	// Hidden tabs count new documents instead of refreshing
+	cmd := m.fetchTabUnread()
+	if cmd == nil {
+		t.Fatal("expected an unread count for the hidden traces tab")
+	}
+	next, _ := m.Update(cmd())
+	m = next.(Model)
+	if len(src.counted) != 1 || src.counted[0] != signalTraces.IndexPattern() {
+		t.Fatalf("counted %v, want the traces index", src.counted)
+	}
+	if opts := src.countOpts[0]; opts.ProcessorEvent != "transaction" || !opts.From.Equal(m.Tabs.Tabs[1].SeenAt) {
+		t.Errorf("count options = %+v, want transactions since the tab was hidden", opts)
+	}
+	if m.Tabs.Tabs[1].Unread != 7 {
+		t.Errorf("unread = %d, want 7", m.Tabs.Tabs[1].Unread)
+	}
+
+	press(t, &m, "f2")
+	for _, r := range "errors" {
+		typeText(t, &m, string(r))
+	}
+	press(t, &m, "enter")
+	if m.Tabs.Renaming || m.Tabs.Tabs[0].Name != "errors" {
+		t.Errorf("rename: renaming=%v name=%q, want errors", m.Tabs.Renaming, m.Tabs.Tabs[0].Name)
+	}
+	if header := m.renderTitleHeader(); !strings.Contains(header, "1 errors") || !strings.Contains(header, "2 traces (7)") {
+		t.Errorf("tab strip missing from header:\n%s", header)
+	}
+
+	press(t, &m, "tab")
+	if m.Tabs.Active != 1 || m.Tabs.Tabs[1].Unread != 0 || m.Filters.Signal != signalTraces {
+		t.Errorf("second tab not shown: active %d, unread %d, signal %v", m.Tabs.Active, m.Tabs.Tabs[1].Unread, m.Filters.Signal)
+	}
+	press(t, &m, "ctrl+w")
+	if len(m.Tabs.Tabs) != 1 || m.Filters.Signal != signalLogs || m.Filters.Service != "gateway" {
+		t.Errorf("after closing: %d tabs, filters %+v", len(m.Tabs.Tabs), m.Filters)
+	}
+	press(t, &m, "ctrl+w")
+	if len(m.Tabs.Tabs) != 1 || m.UI.StatusMessage != "Can't close the last tab" {
+		t.Errorf("last tab closed: %d tabs, status %q", len(m.Tabs.Tabs), m.UI.StatusMessage)
+	}
+	if m.renderTabs(200) != "" {
+		t.Error("expected no tab strip with a single tab")
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elastic/elasticat/internal/config"
+	"github.com/muesli/termenv"
+)
+
+// ThemeAuto picks the no-colour theme when NO_COLOR is set, and otherwise
+// the light or dark theme to match the terminal background.
+const ThemeAuto = "auto"
+
+// Theme is the palette the TUI styles are built from.
+type Theme struct {
+	Name      string
+	Text      lipgloss.TerminalColor
+	Muted     lipgloss.TerminalColor
+	Accent    lipgloss.TerminalColor // Titles, keys and prompts
+	Border    lipgloss.TerminalColor
+	Success   lipgloss.TerminalColor
+	Info      lipgloss.TerminalColor // Service names and span bars
+	StatusBar lipgloss.TerminalColor // Status bar background
+	Overlay   lipgloss.TerminalColor // Query overlay background
+	Selection ColorPair
+	Highlight ColorPair   // Search matches
+	Levels    LevelColors // Level badges and volume bars
+	LevelText LevelColors // Text on the level badges
+	Charts    []lipgloss.TerminalColor
+
+	Reverse bool // The selection uses reverse video instead of colours
+	NoColor bool // Strip all colours, including those outside the palette
+}
+
+// ColorPair is a foreground and background colour.
+type ColorPair struct {
+	Fg, Bg lipgloss.TerminalColor
+}
+
+// LevelColors holds a colour per log level.
+type LevelColors struct {
+	Error, Warn, Info, Debug, Trace, Other lipgloss.TerminalColor
+}
+
+// DarkTheme is tuned for dark terminal backgrounds.
+var DarkTheme = Theme{
+	Name:      "dark",
+	Text:      lipgloss.Color("#E0E0E0"),
+	Muted:     lipgloss.Color("#6C757D"),
+	Accent:    lipgloss.Color("#7D56F4"),
+	Border:    lipgloss.Color("#5A5A5A"),
+	Success:   lipgloss.Color("#04B575"),
+	Info:      lipgloss.Color("#61AFEF"),
+	StatusBar: lipgloss.Color("#333333"),
+	Overlay:   lipgloss.Color("#1a1a2e"),
+	Selection: ColorPair{Fg: lipgloss.Color("#FFFFFF"), Bg: lipgloss.Color("#4A4A7A")},
+	Highlight: ColorPair{Fg: lipgloss.Color("#000000"), Bg: lipgloss.Color("#FFD700")},
+	Levels: LevelColors{
+		Error: lipgloss.Color("#FF5F56"),
+		Warn:  lipgloss.Color("#FFCC00"),
+		Info:  lipgloss.Color("#04B575"),
+		Debug: lipgloss.Color("#6C757D"),
+		Trace: lipgloss.Color("#888888"),
+		Other: lipgloss.Color("#5A5A5A"),
+	},
+	LevelText: LevelColors{
+		Error: lipgloss.Color("#FFFFFF"),
+		Warn:  lipgloss.Color("#000000"),
+		Info:  lipgloss.Color("#FFFFFF"),
+		Debug: lipgloss.Color("#E0E0E0"),
+		Trace: lipgloss.Color("#E0E0E0"),
+		Other: lipgloss.Color("#E0E0E0"),
+	},
+	Charts: []lipgloss.TerminalColor{
+		lipgloss.Color("#61AFEF"),
+		lipgloss.Color("#E5C07B"),
+		lipgloss.Color("#98C379"),
+		lipgloss.Color("#E06C75"),
+		lipgloss.Color("#C678DD"),
+		lipgloss.Color("#56B6C2"),
+	},
+}
+
+// LightTheme is tuned for light terminal backgrounds.
+var LightTheme = Theme{
+	Name:      "light",
+	Text:      lipgloss.Color("#1F2328"),
+	Muted:     lipgloss.Color("#6E7781"),
+	Accent:    lipgloss.Color("#6639BA"),
+	Border:    lipgloss.Color("#AFB8C1"),
+	Success:   lipgloss.Color("#1A7F37"),
+	Info:      lipgloss.Color("#0969DA"),
+	StatusBar: lipgloss.Color("#EAEEF2"),
+	Overlay:   lipgloss.Color("#F6F8FA"),
+	Selection: ColorPair{Fg: lipgloss.Color("#FFFFFF"), Bg: lipgloss.Color("#6639BA")},
+	Highlight: ColorPair{Fg: lipgloss.Color("#1F2328"), Bg: lipgloss.Color("#FFD33D")},
+	Levels: LevelColors{
+		Error: lipgloss.Color("#CF222E"),
+		Warn:  lipgloss.Color("#BF8700"),
+		Info:  lipgloss.Color("#1A7F37"),
+		Debug: lipgloss.Color("#6E7781"),
+		Trace: lipgloss.Color("#8C959F"),
+		Other: lipgloss.Color("#AFB8C1"),
+	},
+	LevelText: LevelColors{
+		Error: lipgloss.Color("#FFFFFF"),
+		Warn:  lipgloss.Color("#FFFFFF"),
+		Info:  lipgloss.Color("#FFFFFF"),
+		Debug: lipgloss.Color("#FFFFFF"),
+		Trace: lipgloss.Color("#FFFFFF"),
+		Other: lipgloss.Color("#1F2328"),
+	},
+	Charts: []lipgloss.TerminalColor{
+		lipgloss.Color("#0969DA"),
+		lipgloss.Color("#9A6700"),
+		lipgloss.Color("#1A7F37"),
+		lipgloss.Color("#CF222E"),
+		lipgloss.Color("#8250DF"),
+		lipgloss.Color("#1B7C83"),
+	},
+}
+
+// HighContrastTheme uses the terminal's own text colour and its 16 basic
+// colours, so it follows the terminal's palette on any background.
+var HighContrastTheme = Theme{
+	Name:      "high-contrast",
+	Text:      lipgloss.NoColor{},
+	Muted:     lipgloss.NoColor{},
+	Accent:    lipgloss.Color("12"),
+	Border:    lipgloss.NoColor{},
+	Success:   lipgloss.Color("10"),
+	Info:      lipgloss.Color("14"),
+	StatusBar: lipgloss.NoColor{},
+	Overlay:   lipgloss.NoColor{},
+	Selection: ColorPair{Fg: lipgloss.NoColor{}, Bg: lipgloss.NoColor{}},
+	Highlight: ColorPair{Fg: lipgloss.Color("0"), Bg: lipgloss.Color("11")},
+	Levels: LevelColors{
+		Error: lipgloss.Color("9"),
+		Warn:  lipgloss.Color("11"),
+		Info:  lipgloss.Color("10"),
+		Debug: lipgloss.Color("7"),
+		Trace: lipgloss.Color("7"),
+		Other: lipgloss.Color("7"),
+	},
+	LevelText: LevelColors{
+		Error: lipgloss.Color("0"),
+		Warn:  lipgloss.Color("0"),
+		Info:  lipgloss.Color("0"),
+		Debug: lipgloss.Color("0"),
+		Trace: lipgloss.Color("0"),
+		Other: lipgloss.Color("0"),
+	},
+	Charts: []lipgloss.TerminalColor{
+		lipgloss.Color("14"),
+		lipgloss.Color("11"),
+		lipgloss.Color("10"),
+		lipgloss.Color("9"),
+		lipgloss.Color("13"),
+		lipgloss.Color("12"),
+	},
+	Reverse: true,
+}
+
+// NoColorTheme renders without colours, marking the selection with reverse
+// video and search matches with underlining.
+var NoColorTheme = Theme{
+	Name:      "no-color",
+	Text:      lipgloss.NoColor{},
+	Muted:     lipgloss.NoColor{},
+	Accent:    lipgloss.NoColor{},
+	Border:    lipgloss.NoColor{},
+	Success:   lipgloss.NoColor{},
+	Info:      lipgloss.NoColor{},
+	StatusBar: lipgloss.NoColor{},
+	Overlay:   lipgloss.NoColor{},
+	Selection: ColorPair{Fg: lipgloss.NoColor{}, Bg: lipgloss.NoColor{}},
+	Highlight: ColorPair{Fg: lipgloss.NoColor{}, Bg: lipgloss.NoColor{}},
+	Levels: LevelColors{
+		Error: lipgloss.NoColor{}, Warn: lipgloss.NoColor{}, Info: lipgloss.NoColor{},
+		Debug: lipgloss.NoColor{}, Trace: lipgloss.NoColor{}, Other: lipgloss.NoColor{},
+	},
+	LevelText: LevelColors{
+		Error: lipgloss.NoColor{}, Warn: lipgloss.NoColor{}, Info: lipgloss.NoColor{},
+		Debug: lipgloss.NoColor{}, Trace: lipgloss.NoColor{}, Other: lipgloss.NoColor{},
+	},
+	Charts:  []lipgloss.TerminalColor{lipgloss.NoColor{}},
+	Reverse: true,
+	NoColor: true,
+}
+
+// builtinThemes are the themes that need no theme file.
+var builtinThemes = map[string]Theme{
+	DarkTheme.Name:         DarkTheme,
+	LightTheme.Name:        LightTheme,
+	HighContrastTheme.Name: HighContrastTheme,
+	NoColorTheme.Name:      NoColorTheme,
+}
+
+// currentTheme is the theme the styles were last built from.
+var currentTheme = DarkTheme
+
+// LoadTheme returns the named theme: auto, a built-in theme or a user theme
+// from the themes directory. An explicitly named theme is used even when
+// NO_COLOR is set.
+func LoadTheme(name string) (Theme, error) {
+	if name == "" || name == ThemeAuto {
+		return DetectTheme(), nil
+	}
+	if t, ok := builtinThemes[name]; ok {
+		return t, nil
+	}
+
+	file, err := config.LoadThemeFile(name)
+	if err != nil {
+		return Theme{}, err
+	}
+	baseName := file.Base
+	if baseName == "" {
+		baseName = DarkTheme.Name
+	}
+	base, ok := builtinThemes[baseName]
+	if !ok {
+		return Theme{}, fmt.Errorf("theme %q: unknown base %q (expected %s)", name, baseName, strings.Join(sortedKeys(builtinThemes), ", "))
+	}
+	return themeFromFile(name, base, file), nil
+}
+
+// DetectTheme picks a built-in theme for the terminal. It must be called
+// before the TUI starts, as it may query the terminal for its background.
+func DetectTheme() Theme {
+	if os.Getenv("NO_COLOR") != "" {
+		return NoColorTheme
+	}
+	if !lipgloss.HasDarkBackground() {
+		return LightTheme
+	}
+	return DarkTheme
+}
+
+// themeFromFile overlays the colours set in a theme file on its base theme.
+func themeFromFile(name string, base Theme, file config.ThemeFile) Theme {
+	t := base
+	t.Name = name
+
+	set := func(dst *lipgloss.TerminalColor, c string) {
+		if c != "" {
+			*dst = lipgloss.Color(c)
+		}
+	}
+	set(&t.Text, file.Text)
+	set(&t.Muted, file.Muted)
+	set(&t.Accent, file.Accent)
+	set(&t.Border, file.Border)
+	set(&t.Success, file.Success)
+	set(&t.Info, file.Info)
+	set(&t.StatusBar, file.StatusBar)
+	set(&t.Overlay, file.Overlay)
+	set(&t.Selection.Fg, file.Selection.Fg)
+	set(&t.Selection.Bg, file.Selection.Bg)
+	set(&t.Highlight.Fg, file.Highlight.Fg)
+	set(&t.Highlight.Bg, file.Highlight.Bg)
+	for _, lv := range []struct {
+		dst    *LevelColors
+		colors config.LevelPalette
+	}{{&t.Levels, file.Levels}, {&t.LevelText, file.LevelText}} {
+		set(&lv.dst.Error, lv.colors.Error)
+		set(&lv.dst.Warn, lv.colors.Warn)
+		set(&lv.dst.Info, lv.colors.Info)
+		set(&lv.dst.Debug, lv.colors.Debug)
+		set(&lv.dst.Trace, lv.colors.Trace)
+		set(&lv.dst.Other, lv.colors.Other)
+	}
+	if len(file.Charts) > 0 {
+		t.Charts = nil
+		for _, c := range file.Charts {
+			t.Charts = append(t.Charts, lipgloss.Color(c))
+		}
+	}
+
+	// Colours in the file take the place of the base's reverse video
+	if file.Selection.Bg != "" {
+		t.Reverse = false
+	}
+	return t
+}
+
+// ThemeNames lists the built-in themes, for help text.
+func ThemeNames() []string {
+	return append([]string{ThemeAuto}, sortedKeys(builtinThemes)...)
+}
+
+// ApplyTheme rebuilds the TUI styles from a theme. Call it before the TUI
+// starts; it is not safe to call while rendering.
+func ApplyTheme(t Theme) {
+	currentTheme = t
+	if t.NoColor {
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+	buildStyles(t)
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Elasticsearch B.V. and contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/elastic/elasticat/internal/config"
+)
+
+func TestApplyThemeColoursChatAndDetail(t *testing.T) {
+	ApplyTheme(LightTheme)
+	defer ApplyTheme(DarkTheme)
+
+	if got := chatUserStyle.GetForeground(); got != LightTheme.Info {
+		t.Errorf("chat user colour = %v, want the theme's info colour", got)
+	}
+	if got := chatInputBorderStyle.GetBorderTopForeground(); got != LightTheme.Accent {
+		t.Errorf("chat input border = %v, want the theme's accent", got)
+	}
+	if got := chatLoadingStyle.GetForeground(); got != LightTheme.Levels.Warn {
+		t.Errorf("chat loading colour = %v, want the theme's warning colour", got)
+	}
+	if got := LogOriginalStyle.GetForeground(); got != LightTheme.Info {
+		t.Errorf("log.record.original colour = %v, want the theme's info colour", got)
+	}
+}
+
+func TestLoadTheme(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("NO_COLOR", "1")
+
+	if theme, err := LoadTheme(ThemeAuto); err != nil || theme.Name != NoColorTheme.Name {
+		t.Errorf("auto with NO_COLOR = %q (%v), want no-color", theme.Name, err)
+	}
+	// An explicitly chosen theme wins over NO_COLOR
+	if theme, err := LoadTheme("light"); err != nil || theme.Name != LightTheme.Name {
+		t.Errorf("light = %q (%v), want light", theme.Name, err)
+	}
+
+	path, err := config.GetThemePath("ocean")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	data := "base: light\naccent: \"#005f87\"\nselection: {bg: \"24\"}\nlevels: {error: \"160\"}\n"
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	theme, err := LoadTheme("ocean")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if theme.Accent != lipgloss.Color("#005f87") || theme.Levels.Error != lipgloss.Color("160") || theme.Selection.Bg != lipgloss.Color("24") {
+		t.Errorf("theme file colours not applied: %+v", theme)
+	}
+	// Colours the file leaves out come from its base
+	if theme.Text != LightTheme.Text || theme.Levels.Warn != LightTheme.Levels.Warn || len(theme.Charts) != len(LightTheme.Charts) {
+		t.Errorf("expected the light theme's other colours, got %+v", theme)
+	}
+
+	if err := os.WriteFile(path, []byte("base: sepia\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadTheme("ocean"); err == nil || !strings.Contains(err.Error(), "unknown base") {
+		t.Errorf("expected an unknown base error, got %v", err)
+	}
+}
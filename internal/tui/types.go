@@ -10,6 +10,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/elastic/elasticat/internal/es"
 	"github.com/elastic/elasticat/internal/es/metrics"
+	"github.com/elastic/elasticat/internal/es/patterns"
 	"github.com/elastic/elasticat/internal/es/traces"
 	"github.com/elastic/elasticat/internal/fields"
 )
@@ -19,7 +20,18 @@ type viewMode int
 
 // ViewContext captures state needed to restore a view when navigating back
 type ViewContext struct {
-	Mode viewMode
+	Mode   viewMode
+	Signal *signalContext // Set when the navigation switched signals (log <-> trace jumps)
+}
+
+// signalContext is the signal-specific state saved before jumping to another signal
+type signalContext struct {
+	Filters FilterState
+	Index   string
+	Display []DisplayField
+	Logs    LogsState
+	Traces  TracesState
+	Query   QueryState
 }
 
 const (
@@ -42,6 +54,16 @@ const (
 	viewOtelConfigExplain     // OTel config explanation (before opening editor)
 	viewOtelConfigModal       // OTel config editing/watching modal
 	viewOtelConfigUnavailable // OTel config unavailable (non-local profile)
+	viewLogPatterns           // Log message patterns (ES|QL CATEGORIZE)
+	viewServiceMap            // Service dependencies derived from traces
+	viewLatency               // Latency distribution of a transaction name
+	viewMetricCompare         // Marked metrics on a shared time axis
+	viewFieldFilter           // Filter-by-value picker over the fields of a document
+	viewESQL                  // Editable ES|QL query with a result table
+	viewSavedViews            // Saved views list
+	viewBookmarks             // Bookmarked documents
+	viewExceptions            // Exceptions grouped by type and message or top frame
+	viewPalette               // Command palette over the previous view
 )
 
 // MetricsViewMode toggles between aggregated and document views for metrics
@@ -294,6 +316,10 @@ type (
 		queryJSON string
 		index     string
 	}
+	liveTailMsg struct {
+		logs []es.LogEntry // Oldest first, possibly repeating entries already shown
+		err  error
+	}
 	fieldCapsMsg struct {
 		fields []es.FieldInfo
 		err    error
@@ -301,16 +327,39 @@ type (
 	autoDetectMsg struct {
 		lookback LookbackDuration
 		total    int64
+		pinned   bool // Lookback kept from a saved view instead of detected
 		err      error
 	}
 	metricsAggMsg struct {
 		result *metrics.MetricsAggResult
+		more   bool // Next page to append to the loaded metrics
+		offset int  // Offset the page was fetched from (when more)
 		err    error
 	}
 	metricDetailDocsMsg struct {
 		docs []es.LogEntry
 		err  error
 	}
+	metricDimensionsMsg struct {
+		metric string
+		dims   []metrics.Dimension
+		err    error
+	}
+	metricCompareMsg struct {
+		result *metrics.MetricsAggResult
+		err    error
+	}
+	metricDistributionMsg struct {
+		metric string
+		dist   *metrics.HistogramDistribution
+		err    error
+	}
+	metricBreakdownMsg struct {
+		metric  string
+		groupBy string
+		result  *metrics.MetricsAggResult
+		err     error
+	}
 	transactionNamesMsg struct {
 		names []traces.TransactionNameAgg
 		query string
@@ -320,6 +369,50 @@ type (
 		spans []es.LogEntry
 		err   error
 	}
+	logVolumeMsg struct {
+		result *es.VolumeHistogram
+		err    error
+	}
+	tabUnreadMsg struct {
+		counts map[int]int64 // New documents by tab ID
+		err    error
+	}
+	bookmarkExportMsg struct {
+		path  string // Markdown file written
+		count int
+		err   error
+	}
+	esqlEditorMsg struct {
+		query  string
+		result *es.ESQLResult
+		err    error
+	}
+	fieldFacetsMsg struct {
+		field  string
+		result *es.FieldFacets
+		err    error
+	}
+	exceptionsMsg struct {
+		result *es.ExceptionGroups
+		query  string
+		err    error
+	}
+	logPatternsMsg struct {
+		result *patterns.PatternsResult
+		err    error
+	}
+	serviceMapMsg struct {
+		result *traces.ServiceMapResult
+		err    error
+	}
+	latencyHistogramMsg struct {
+		result *traces.LatencyHistogram
+		err    error
+	}
+	exampleTraceMsg struct {
+		traceID string
+		err     error
+	}
 	perspectiveDataMsg struct {
 		items []PerspectiveItem
 		err   error
@@ -6,6 +6,7 @@ package tui
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"fmt"
@@ -44,6 +45,11 @@ func (m Model) Init() tea.Cmd {
 		cmds = append(cmds, m.autoDetectLookback())
 	}
 
+	// An ES|QL view opened at startup runs its query straight away
+	if m.UI.Mode == viewESQL && m.Editor.Running {
+		cmds = append(cmds, m.fetchESQLEditor(strings.TrimSpace(m.Editor.Input.Value())))
+	}
+
 	return tea.Batch(cmds...)
 }
 
@@ -62,18 +68,39 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case logsMsg:
 		return m.handleLogsMsg(msg)
 
+	case liveTailMsg:
+		return m.handleLiveTailMsg(msg)
+
 	case tickMsg:
 		return m.handleTickMsg()
 
 	case fieldCapsMsg:
 		return m.handleFieldCapsMsg(msg)
 
+	case fieldFacetsMsg:
+		return m.handleFieldFacetsMsg(msg)
+
+	case esqlEditorMsg:
+		return m.handleESQLEditorMsg(msg)
+
 	case autoDetectMsg:
 		return m.handleAutoDetectMsg(msg)
 
 	case metricsAggMsg:
 		return m.handleMetricsAggMsg(msg)
 
+	case metricDimensionsMsg:
+		return m.handleMetricDimensionsMsg(msg)
+
+	case metricBreakdownMsg:
+		return m.handleMetricBreakdownMsg(msg)
+
+	case metricCompareMsg:
+		return m.handleMetricCompareMsg(msg)
+
+	case metricDistributionMsg:
+		return m.handleMetricDistributionMsg(msg)
+
 	case metricDetailDocsMsg:
 		return m.handleMetricDetailDocsMsg(msg)
 
@@ -86,6 +113,29 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case perspectiveDataMsg:
 		return m.handlePerspectiveDataMsg(msg)
 
+	case logVolumeMsg:
+		return m.handleLogVolumeMsg(msg)
+
+	case tabUnreadMsg:
+		return m.handleTabUnreadMsg(msg)
+
+	case bookmarkExportMsg:
+		return m.handleBookmarkExportMsg(msg)
+
+	case logPatternsMsg:
+		return m.handleLogPatternsMsg(msg)
+	case exceptionsMsg:
+		return m.handleExceptionsMsg(msg)
+
+	case serviceMapMsg:
+		return m.handleServiceMapMsg(msg)
+
+	case latencyHistogramMsg:
+		return m.handleLatencyHistogramMsg(msg)
+
+	case exampleTraceMsg:
+		return m.handleExampleTraceMsg(msg)
+
 	case chatResponseMsg:
 		return m.handleChatResponseMsg(msg)
 
@@ -172,6 +222,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		var cmd tea.Cmd
 		m.Components.ErrorViewport, cmd = m.Components.ErrorViewport.Update(msg)
 		cmds = append(cmds, cmd)
+	case viewESQL:
+		var cmd tea.Cmd
+		if m.Editor.Naming {
+			m.Editor.NameInput, cmd = m.Editor.NameInput.Update(msg)
+		} else {
+			m.Editor.Input, cmd = m.Editor.Input.Update(msg)
+		}
+		cmds = append(cmds, cmd)
 	case viewChat:
 		var cmd tea.Cmd
 		m.Chat.Input, cmd = m.Chat.Input.Update(msg)
@@ -207,6 +265,7 @@ func (m Model) handleWindowSize(msg tea.WindowSizeMsg) (Model, tea.Cmd) {
 	m.Components.Viewport.Height = m.getFullScreenHeight() - 4
 	m.Chat.Viewport.Width = msg.Width - 4
 	m.Chat.Viewport.Height = msg.Height - 12 // Leave room for input and header
+	m.Editor.Input.SetWidth(msg.Width - 8)
 
 	// Re-wrap detail content after resize so long fields wrap correctly.
 	if m.UI.Mode == viewDetail || m.UI.Mode == viewDetailJSON {
@@ -233,23 +292,39 @@ func (m Model) handleLogsMsg(msg logsMsg) (Model, tea.Cmd) {
 		return m, nil
 	}
 
-	m.Logs.Entries = msg.logs
+	if m.inTraceWaterfall() {
+		m.setTraceWaterfall(msg.logs)
+	} else {
+		m.Logs.Entries = msg.logs
+	}
 	m.Logs.Total = msg.total
 	m.UI.Err = nil
 	m.Query.LastJSON = msg.queryJSON
 	m.Query.LastIndex = msg.index
+	m.restartLiveTail()
 
 	m = m.applyTailBehaviorAfterFetch()
 	m = m.clampSelection()
+	m.selectPendingEntry()
 
 	return m.maybeTriggerPostLoadFetches()
 }
 
 func (m Model) handleTickMsg() (Model, tea.Cmd) {
 	var cmds []tea.Cmd
-	if m.UI.AutoRefresh && m.UI.Mode == viewLogs {
+	switch {
+	case m.Logs.Live != nil && m.UI.Mode == viewLogs:
+		// Live tail polls even with auto refresh off, one poll at a time
+		if !m.requests.inFlight(requestLogs) {
+			cmds = append(cmds, m.fetchLiveTail())
+		}
+	case m.UI.AutoRefresh && m.UI.Mode == viewLogs && m.Logs.Context == nil:
 		cmds = append(cmds, m.fetchLogs())
 	}
+	// Hidden tabs aren't refreshed; they only count what they are missing
+	if m.UI.AutoRefresh && len(m.Tabs.Tabs) > 1 && !m.requests.inFlight(requestTabUnread) {
+		cmds = append(cmds, m.fetchTabUnread())
+	}
 	cmds = append(cmds, m.tickCmd())
 	return m, tea.Batch(cmds...)
 }
@@ -261,7 +336,11 @@ func (m Model) handleFieldCapsMsg(msg fieldCapsMsg) (Model, tea.Cmd) {
 	}
 
 	m.Fields.Available = msg.fields
-	return m, nil
+	if m.UI.Mode != viewFields {
+		// Loaded to complete field names in the command palette
+		return m, nil
+	}
+	return m, m.maybeFetchFieldFacets()
 }
 
 func (m Model) handleAutoDetectMsg(msg autoDetectMsg) (Model, tea.Cmd) {
@@ -275,8 +354,10 @@ func (m Model) handleAutoDetectMsg(msg autoDetectMsg) (Model, tea.Cmd) {
 	}
 
 	m.Filters.Lookback = msg.lookback
-	m.UI.StatusMessage = fmt.Sprintf("Found %d entries in %s", msg.total, msg.lookback.String())
-	m.UI.StatusTime = time.Now()
+	if !msg.pinned {
+		m.UI.StatusMessage = fmt.Sprintf("Found %d entries in %s", msg.total, msg.lookback.String())
+		m.UI.StatusTime = time.Now()
+	}
 
 	return m.startInitialFetch()
 }
@@ -300,7 +381,12 @@ func (m Model) startInitialFetch() (Model, tea.Cmd) {
 }
 
 func (m Model) handleMetricsAggMsg(msg metricsAggMsg) (Model, tea.Cmd) {
+	if msg.more {
+		return m.handleMetricsPageMsg(msg)
+	}
+
 	m.Metrics.Loading = false
+	m.Metrics.LoadingMore = false
 	if m.handleAsyncError(msg.err) {
 		return m, nil
 	}
@@ -315,6 +401,96 @@ func (m Model) handleMetricsAggMsg(msg metricsAggMsg) (Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleMetricsPageMsg appends a lazily fetched page to the loaded metrics
+func (m Model) handleMetricsPageMsg(msg metricsAggMsg) (Model, tea.Cmd) {
+	m.Metrics.LoadingMore = false
+	if m.handleAsyncError(msg.err) {
+		return m, nil
+	}
+	// Drop pages that no longer continue the loaded list (filter changed or reloaded)
+	agg := m.Metrics.Aggregated
+	if agg == nil || msg.result == nil || msg.offset != agg.NextOffset {
+		return m, nil
+	}
+
+	agg.Metrics = append(agg.Metrics, msg.result.Metrics...)
+	agg.Total = msg.result.Total
+	agg.NextOffset = msg.result.NextOffset
+	m.UI.Err = nil
+
+	// Keep going if the cursor is still near the end (e.g. held key or G)
+	return m, m.fetchMoreMetrics()
+}
+
+func (m Model) handleMetricDimensionsMsg(msg metricDimensionsMsg) (Model, tea.Cmd) {
+	m.Metrics.BreakdownLoading = false
+	if m.handleAsyncError(msg.err) {
+		return m, nil
+	}
+	// Ignore results for a metric the user has moved away from
+	if msg.metric != m.Metrics.BreakdownMetric {
+		return m, nil
+	}
+
+	m.Metrics.Dimensions = msg.dims
+	m.UI.Err = nil
+	if len(msg.dims) == 0 {
+		m.UI.StatusMessage = "No dimensions found for this metric"
+		m.UI.StatusTime = time.Now()
+		return m, nil
+	}
+	return m, m.setMetricGroupBy(msg.dims[0].Field)
+}
+
+func (m Model) handleMetricBreakdownMsg(msg metricBreakdownMsg) (Model, tea.Cmd) {
+	m.Metrics.BreakdownLoading = false
+	if m.handleAsyncError(msg.err) {
+		return m, nil
+	}
+	if msg.metric != m.Metrics.BreakdownMetric || msg.groupBy != m.Metrics.GroupBy {
+		return m, nil
+	}
+
+	m.Metrics.Breakdown = nil
+	if msg.result != nil && len(msg.result.Metrics) > 0 {
+		m.Metrics.Breakdown = &msg.result.Metrics[0]
+	}
+	m.UI.Err = nil
+	if m.UI.Mode == viewMetricDetail {
+		m.updateMetricDetailViewport()
+	}
+	return m, nil
+}
+
+func (m Model) handleMetricCompareMsg(msg metricCompareMsg) (Model, tea.Cmd) {
+	m.Compare.Loading = false
+	if m.handleAsyncError(msg.err) {
+		return m, nil
+	}
+
+	m.setCompareResult(msg.result)
+	m.UI.Err = nil
+	return m, nil
+}
+
+func (m Model) handleMetricDistributionMsg(msg metricDistributionMsg) (Model, tea.Cmd) {
+	// Ignore results for a metric the user has moved away from
+	if msg.metric != m.Metrics.DistributionMetric {
+		return m, nil
+	}
+	m.Metrics.DistributionLoading = false
+	if m.handleAsyncError(msg.err) {
+		return m, nil
+	}
+
+	m.Metrics.Distribution = msg.dist
+	m.UI.Err = nil
+	if m.UI.Mode == viewMetricDetail {
+		m.updateMetricDetailViewport()
+	}
+	return m, nil
+}
+
 func (m Model) handleMetricDetailDocsMsg(msg metricDetailDocsMsg) (Model, tea.Cmd) {
 	m.Metrics.DetailDocsLoading = false
 	if m.handleAsyncError(msg.err) {
@@ -372,6 +548,74 @@ func (m Model) handlePerspectiveDataMsg(msg perspectiveDataMsg) (Model, tea.Cmd)
 	return m, nil
 }
 
+func (m Model) handleLogPatternsMsg(msg logPatternsMsg) (Model, tea.Cmd) {
+	m.Patterns.Loading = false
+	if m.handleAsyncError(msg.err) {
+		return m, nil
+	}
+
+	m.Patterns.Items = msg.result.Patterns
+	if m.Patterns.Cursor >= len(m.Patterns.Items) {
+		m.Patterns.Cursor = 0
+	}
+	// Store the ES|QL query for display and Kibana integration
+	if msg.result.Query != "" {
+		m.Query.LastJSON = msg.result.Query
+		m.Query.LastIndex = m.client.GetIndex()
+	}
+	m.UI.Err = nil
+	return m, nil
+}
+
+func (m Model) handleServiceMapMsg(msg serviceMapMsg) (Model, tea.Cmd) {
+	m.ServiceMap.Loading = false
+	if m.handleAsyncError(msg.err) {
+		return m, nil
+	}
+
+	m.ServiceMap.Result = msg.result
+	if m.ServiceMap.Cursor >= len(msg.result.Edges) {
+		m.ServiceMap.Cursor = 0
+	}
+	if msg.result.Query != "" {
+		m.Query.LastJSON = msg.result.Query
+		m.Query.LastIndex = m.client.GetIndex()
+	}
+	m.UI.Err = nil
+	return m, nil
+}
+
+func (m Model) handleLatencyHistogramMsg(msg latencyHistogramMsg) (Model, tea.Cmd) {
+	m.Latency.Loading = false
+	if m.handleAsyncError(msg.err) {
+		return m, nil
+	}
+
+	m.Latency.Histogram = msg.result
+	if m.Latency.Cursor >= len(msg.result.Buckets) {
+		m.Latency.Cursor = 0
+	}
+	if msg.result.Query != "" {
+		m.Query.LastJSON = msg.result.Query
+		m.Query.LastIndex = m.client.GetIndex()
+	}
+	m.UI.Err = nil
+	return m, nil
+}
+
+func (m Model) handleExampleTraceMsg(msg exampleTraceMsg) (Model, tea.Cmd) {
+	m.Latency.Loading = false
+	if m.handleAsyncError(msg.err) {
+		return m, nil
+	}
+	if msg.traceID == "" {
+		m.UI.StatusMessage = "No transactions in this bucket"
+		m.UI.StatusTime = time.Now()
+		return m, nil
+	}
+	return m, m.openTrace(msg.traceID)
+}
+
 func (m Model) handleErrMsg(msg errMsg) (Model, tea.Cmd) {
 	m.UI.Err = msg
 	m.UI.Loading = false
@@ -385,7 +629,8 @@ func (m Model) applyTailBehaviorAfterFetch() Model {
 		return m
 	}
 
-	if m.UI.SortAscending {
+	// The waterfall is always in tree order, so start at the root span
+	if m.UI.SortAscending && !m.inTraceWaterfall() {
 		m.Logs.SelectedIndex = len(m.Logs.Entries) - 1
 	} else {
 		m.Logs.SelectedIndex = 0
@@ -412,6 +657,15 @@ func (m Model) clampSelection() Model {
 func (m Model) maybeTriggerPostLoadFetches() (Model, tea.Cmd) {
 	if m.Filters.Signal != signalTraces {
 		m.Traces.LastFetchedTraceID = ""
+		// Auto-refresh reloads the same window, so only refetch on a change
+		if !m.showLogVolume() {
+			return m, nil
+		}
+		if key := m.logVolumeKey(); key != m.Volume.Key {
+			m.Volume.Key = key
+			m.Volume.Loading = true
+			return m, m.fetchLogVolume()
+		}
 		return m, nil
 	}
 	return m, m.maybeFetchSpansForSelection()